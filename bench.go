@@ -0,0 +1,75 @@
+package main
+
+import (
+	"go-playground/protobuf/codec"
+	"go-playground/protobuf/compress"
+	"runtime"
+	"time"
+)
+
+type row struct {
+	size           int
+	compressedSize int
+	marshal        measurement
+	unmarshal      measurement
+	compress       measurement
+	decompress     measurement
+}
+
+type measurement struct {
+	nsPerOp     int64
+	allocsPerOp int64
+}
+
+// measure encodes payload with codec and compressor, then times the codec and the compressor apart in both directions
+func measure(payload codec.Payload, c codec.Codec, compressor compress.Compressor, benchTime time.Duration) (row, error) {
+	value := payload.For(c)
+	if value == nil {
+		return row{}, codec.ErrUnsupportedType
+	}
+	encoded, err := c.Marshal(value)
+	if err != nil {
+		return row{}, err
+	}
+	compressed, err := compressor.Compress(encoded)
+	if err != nil {
+		return row{}, err
+	}
+
+	return row{
+		size:           len(encoded),
+		compressedSize: len(compressed),
+		marshal: timeIt(benchTime, func() {
+			c.Marshal(value)
+		}),
+		unmarshal: timeIt(benchTime, func() {
+			c.Unmarshal(encoded, payload.NewFor(c))
+		}),
+		compress: timeIt(benchTime, func() {
+			compressor.Compress(encoded)
+		}),
+		decompress: timeIt(benchTime, func() {
+			compressor.Decompress(compressed)
+		}),
+	}, nil
+}
+
+// timeIt calls fn for at least benchTime, at least once after a warm-up call, and averages time and allocations per call
+func timeIt(benchTime time.Duration, fn func()) measurement {
+	fn()
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	start := time.Now()
+	var n int64
+	for n == 0 || time.Since(start) < benchTime {
+		fn()
+		n++
+	}
+	elapsed := time.Since(start)
+	runtime.ReadMemStats(&after)
+	return measurement{
+		nsPerOp:     elapsed.Nanoseconds() / n,
+		allocsPerOp: int64(after.Mallocs-before.Mallocs) / n,
+	}
+}
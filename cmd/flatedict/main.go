@@ -0,0 +1,201 @@
+// Command flatedict trains versioned DEFLATE preset dictionaries on JSON and vtproto search results
+// and reports their gain over CompressGZIP and plain DEFLATE
+package main
+
+import (
+	"compress/gzip"
+	"flag"
+	"fmt"
+	v3 "github.com/KosyanMedia/delta/search/cmd/results-api/api/v3"
+	"github.com/pkg/errors"
+	"go-playground/protobuf/codec"
+	"go-playground/protobuf/compress"
+	"go-playground/protobuf/conv"
+	search_v3 "go-playground/protobuf/search-v3"
+	"go-playground/protobuf/utils"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+)
+
+func main() {
+	formats := flag.String("format", "json,vtproto", "comma-separated codecs of the payloads: json, jsoniter, proto or vtproto")
+	size := flag.Int("size", compress.MaxDictionarySize, "dictionary size in bytes")
+	version := flag.Uint("version", 1, "dictionary version written into every compressed frame")
+	train := flag.Int("train", 8, "number of generated search results to train on when no corpus files are given")
+	level := flag.Int("level", gzip.DefaultCompression, "compression level of gzip, plain and dictionary DEFLATE")
+	out := flag.String("out", "", "directory to write the dictionaries to as <format>.v<version>.dict")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] [corpus files...]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if err := run(strings.Split(*formats, ","), *size, *version, *train, *level, *out, flag.Args(), os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "%+v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(formats []string, size int, version uint, train, level int, out string, files []string, w io.Writer) error {
+	codecs := make([]codec.Codec, 0, len(formats))
+	for _, format := range formats {
+		c, ok := codec.Get(format)
+		if !ok {
+			return errors.Errorf("unknown codec %q", format)
+		}
+		codecs = append(codecs, c)
+	}
+	if len(files) > 0 && len(codecs) != 1 {
+		return errors.Errorf("corpus files are payloads of a single codec, pick it with -format")
+	}
+	dictionaryVersion, err := conv.Int[uint, uint32](version)
+	if err != nil {
+		return errors.Wrap(err, "-version")
+	}
+	corpus, err := readCorpus(files)
+	if err != nil {
+		return err
+	}
+	dump, err := search_v3.Results(search_v3.Dump())
+	if err != nil {
+		return err
+	}
+	results := map[string]v3.SearchResults{
+		"dump":      dump,
+		"generated": generated(int64(train + 1)),
+	}
+
+	var gains []gain
+	for _, c := range codecs {
+		samples := corpus
+		if len(samples) == 0 {
+			for seed := 1; seed <= train; seed++ {
+				encoded, err := encodeChunks(c, generated(int64(seed)))
+				if err != nil {
+					return err
+				}
+				samples = append(samples, encoded...)
+			}
+		}
+
+		dictionary := compress.Dictionary{
+			Version: dictionaryVersion,
+			Data:    compress.BuildDictionary(samples, size),
+		}
+		fmt.Fprintf(w, "%s dictionary v%d: %d bytes from %d samples\n", c.Name(), dictionary.Version, len(dictionary.Data), len(samples))
+		if out != "" {
+			path := filepath.Join(out, fmt.Sprintf("%s.v%d.dict", c.Name(), dictionary.Version))
+			if err := os.WriteFile(path, utils.Must2(dictionary.MarshalBinary()), 0o644); err != nil {
+				return errors.WithStack(err)
+			}
+		}
+
+		measured, err := measureGains(c, dictionary.Compressor(level), level, results)
+		if err != nil {
+			return err
+		}
+		gains = append(gains, measured...)
+	}
+	return writeGains(w, gains)
+}
+
+// gain is the size of a chunk compressed with and without the dictionary
+type gain struct {
+	format     string
+	payload    string
+	chunk      int
+	raw        int
+	gzip       int
+	deflate    int
+	dictionary int
+}
+
+// measureGains compresses every chunk with CompressGZIP, plain DEFLATE and dictionary DEFLATE at the same level,
+// as chunks are transferred one by one
+func measureGains(c codec.Codec, compressor compress.Compressor, level int, results map[string]v3.SearchResults) ([]gain, error) {
+	plain := compress.Deflate.Level(level)
+	var gains []gain
+	for _, name := range []string{"dump", "generated"} {
+		for i, chunk := range results[name] {
+			encoded, err := encode(c, v3.SearchResults{chunk})
+			if err != nil {
+				return nil, err
+			}
+			gzipped, err := utils.CompressGZIP(encoded, level)
+			if err != nil {
+				return nil, err
+			}
+			deflated, err := plain.Compress(encoded)
+			if err != nil {
+				return nil, err
+			}
+			compressed, err := compressor.Compress(encoded)
+			if err != nil {
+				return nil, err
+			}
+			gains = append(gains, gain{
+				format:     c.Name(),
+				payload:    name,
+				chunk:      i,
+				raw:        len(encoded),
+				gzip:       len(gzipped),
+				deflate:    len(deflated),
+				dictionary: len(compressed),
+			})
+		}
+	}
+	return gains, nil
+}
+
+func writeGains(w io.Writer, gains []gain) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "format\tpayload\tchunk\traw\tgzip\tdeflate\tdictionary\tgain vs gzip\tgain vs deflate\t")
+	for _, g := range gains {
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%d\t%d\t%d\t%d\t%s\t%s\t\n", g.format, g.payload, g.chunk, g.raw, g.gzip, g.deflate, g.dictionary,
+			percentGain(g.dictionary, g.gzip), percentGain(g.dictionary, g.deflate))
+	}
+	return errors.WithStack(tw.Flush())
+}
+
+func percentGain(size, baseline int) string {
+	return fmt.Sprintf("%.1f%%", 100*(1-float64(size)/float64(baseline)))
+}
+
+func generated(seed int64) v3.SearchResults {
+	config := search_v3.DefaultGeneratorConfig()
+	config.Seed = seed
+	return search_v3.GenerateResults(config)
+}
+
+func encodeChunks(c codec.Codec, results v3.SearchResults) ([][]byte, error) {
+	samples := make([][]byte, 0, len(results))
+	for _, chunk := range results {
+		encoded, err := encode(c, v3.SearchResults{chunk})
+		if err != nil {
+			return nil, err
+		}
+		samples = append(samples, encoded)
+	}
+	return samples, nil
+}
+
+func encode(c codec.Codec, results v3.SearchResults) ([]byte, error) {
+	value := search_v3.NewResultsPayload(results).For(c)
+	encoded, err := c.Marshal(value)
+	return encoded, errors.WithStack(err)
+}
+
+func readCorpus(files []string) ([][]byte, error) {
+	corpus := make([][]byte, 0, len(files))
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		corpus = append(corpus, data)
+	}
+	return corpus, nil
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	v3 "github.com/KosyanMedia/delta/search/cmd/results-api/api/v3"
+	"github.com/stretchr/testify/require"
+	"go-playground/protobuf/codec"
+	"go-playground/protobuf/compress"
+	"go-playground/protobuf/conv"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRunVersion(t *testing.T) {
+	err := run([]string{"vtproto"}, compress.MaxDictionarySize, math.MaxUint32+1, 1, 6, "", nil, &bytes.Buffer{})
+	require.ErrorIs(t, err, conv.ErrOverflow)
+	require.NoError(t, run([]string{"vtproto"}, 1024, math.MaxUint32, 1, 6, "", nil, &bytes.Buffer{}))
+}
+
+func TestRunReport(t *testing.T) {
+	var out bytes.Buffer
+	dir := t.TempDir()
+	require.NoError(t, run([]string{"json", "vtproto"}, 4096, 2, 1, gzip.DefaultCompression, dir, nil, &out))
+	report := out.String()
+	require.Contains(t, report, "gain vs gzip")
+	for _, format := range []string{"json", "vtproto"} {
+		require.Contains(t, report, format+" dictionary v2: 4096 bytes")
+		data, err := os.ReadFile(filepath.Join(dir, format+".v2.dict"))
+		require.NoError(t, err)
+		var dictionary compress.Dictionary
+		require.NoError(t, dictionary.UnmarshalBinary(data))
+		require.Equal(t, uint32(2), dictionary.Version)
+	}
+	require.Equal(t, 2+1+2*4, strings.Count(report, "\n"), "dictionary lines, header and a row per chunk")
+
+	require.Error(t, run([]string{"json", "vtproto"}, 4096, 1, 1, 6, "", []string{"corpus.json"}, &out))
+	require.Error(t, run([]string{"yaml"}, 4096, 1, 1, 6, "", nil, &out))
+}
+
+func TestMeasureGains(t *testing.T) {
+	results := map[string]v3.SearchResults{"generated": generated(100)}
+	for _, c := range []codec.Codec{codec.JSON, codec.VTProto} {
+		samples, err := encodeChunks(c, generated(1))
+		require.NoError(t, err)
+		dictionary := compress.Dictionary{Version: 1, Data: compress.BuildDictionary(samples, compress.MaxDictionarySize)}
+		gains, err := measureGains(c, dictionary.Compressor(gzip.DefaultCompression), gzip.DefaultCompression, results)
+		require.NoError(t, err)
+		require.Len(t, gains, len(results["generated"]))
+		for _, g := range gains {
+			require.Equal(t, c.Name(), g.format)
+			require.Less(t, g.deflate, g.gzip, "gzip adds a header and a trailer to DEFLATE")
+			require.Less(t, g.dictionary, g.deflate, "%s chunk %d", g.format, g.chunk)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+// Command protosize reports which field paths of search results take the encoded bytes, before and after compression
+package main
+
+import (
+	"flag"
+	"fmt"
+	v3 "github.com/KosyanMedia/delta/search/cmd/results-api/api/v3"
+	"github.com/pkg/errors"
+	"go-playground/protobuf/compress"
+	"go-playground/protobuf/projection"
+	"go-playground/protobuf/protosize"
+	search_v3 "go-playground/protobuf/search-v3"
+	"google.golang.org/protobuf/proto"
+	"os"
+	"text/tabwriter"
+)
+
+func main() {
+	dumpPath := flag.String("dump", "", "path to JSON dump of search results, embedded results.json by default")
+	generate := flag.Bool("generate", false, "use generated search results instead of the dump")
+	seed := flag.Int64("seed", search_v3.DefaultGeneratorConfig().Seed, "seed of generated search results")
+	chunk := flag.Int("chunk", -1, "analyze a single chunk by its index instead of the whole results")
+	compression := flag.String("compress", "gzip-default", "compressor of the compressed sizes, see compress.All, empty to skip")
+	depth := flag.Int("depth", 0, "depth of the report tree, zero for unlimited")
+	minShare := flag.Float64("min-share", 0.001, "skip fields smaller than this share of the message in the report")
+	profileName := flag.String("profile", projection.Debug.Name, "projection profile applied before the analysis, see projection.All")
+	asJSON := flag.Bool("json", false, "write the whole tree as JSON instead of the report")
+	flag.Parse()
+
+	if err := run(*dumpPath, *generate, *seed, *chunk, *compression, *profileName, *depth, *minShare, *asJSON); err != nil {
+		fmt.Fprintf(os.Stderr, "%+v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(dumpPath string, generate bool, seed int64, chunk int, compression, profileName string, depth int, minShare float64, asJSON bool) error {
+	profile, ok := projection.Get(profileName)
+	if !ok {
+		return errors.Errorf("unknown profile %q", profileName)
+	}
+	var compressor compress.Compressor
+	if compression != "" {
+		if compressor, ok = compress.Get(compression); !ok {
+			return errors.Errorf("unknown compressor %q", compression)
+		}
+	}
+
+	data, err := readResults(dumpPath, generate, seed)
+	if err != nil {
+		return err
+	}
+	results := search_v3.ToProto(data)
+	var message proto.Message = results
+	profiles := projection.All()
+	if chunk >= 0 {
+		if chunk >= len(results.Chunks) {
+			return errors.Errorf("chunk %d of %d", chunk, len(results.Chunks))
+		}
+		message = results.Chunks[chunk]
+		// Profile paths start at the results, a chunk is found at "chunks"
+		profile = profile.Rebase("chunks")
+		for i := range profiles {
+			profiles[i] = profiles[i].Rebase("chunks")
+		}
+	}
+
+	projected, err := profile.Project(message)
+	if err != nil {
+		return err
+	}
+	root, err := protosize.Analyze(projected, compressor)
+	if err != nil {
+		return err
+	}
+	if asJSON {
+		return root.WriteJSON(os.Stdout)
+	}
+	if err := root.WriteReport(os.Stdout, depth, minShare); err != nil {
+		return err
+	}
+
+	savings, err := projection.Savings(message, compressor, profiles...)
+	if err != nil {
+		return err
+	}
+	return reportSavings(savings)
+}
+
+// reportSavings compares every profile with the first one, which keeps everything
+func reportSavings(savings []projection.Saving) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "\nprofile\tbytes\tsaved\tcompressed\tsaved\t")
+	for _, saving := range savings {
+		fmt.Fprintf(w, "%s\t%d\t%s\t%d\t%s\t\n", saving.Profile,
+			saving.Bytes, share(savings[0].Bytes-saving.Bytes, savings[0].Bytes),
+			saving.Compressed, share(savings[0].Compressed-saving.Compressed, savings[0].Compressed))
+	}
+	return errors.WithStack(w.Flush())
+}
+
+func share(part, total int64) string {
+	if total == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f%%", 100*float64(part)/float64(total))
+}
+
+func readResults(path string, generate bool, seed int64) (v3.SearchResults, error) {
+	if generate {
+		config := search_v3.DefaultGeneratorConfig()
+		config.Seed = seed
+		return search_v3.GenerateResults(config), nil
+	}
+	dump := search_v3.Dump()
+	if path != "" {
+		var err error
+		if dump, err = os.ReadFile(path); err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+	return search_v3.Results(dump)
+}
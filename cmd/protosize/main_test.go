@@ -0,0 +1,13 @@
+package main
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestRunChunkProfile(t *testing.T) {
+	for _, profile := range []string{"debug", "public", "mobile-lite"} {
+		require.NoError(t, run("", true, 1, 0, "", profile, 2, 0.01, false), profile)
+	}
+	require.Error(t, run("", true, 1, 1000, "", "public", 2, 0.01, false))
+}
@@ -1,5 +1,81 @@
 package main
 
+import (
+	"flag"
+	"fmt"
+	"github.com/pkg/errors"
+	"go-playground/protobuf/codec"
+	"go-playground/protobuf/compress"
+	search_v3 "go-playground/protobuf/search-v3"
+	"go-playground/protobuf/utils"
+	"os"
+	"regexp"
+	"text/tabwriter"
+	"time"
+)
+
 func main() {
-	// Write something
+	dumpPath := flag.String("dump", "", "path to JSON dump of search results, embedded results.json by default")
+	benchTime := flag.Duration("benchtime", time.Second, "run time of each measurement")
+	generate := flag.Bool("generate", false, "use generated search results instead of the dump")
+	compressions := flag.String("compress", "^(none|gzip-(1|default|9)|deflate-default|zlib-default|lzw-lsb)$", "regexp of compressors to measure, see compress.All")
+	seed := flag.Int64("seed", search_v3.DefaultGeneratorConfig().Seed, "seed of generated search results")
+	flag.Parse()
+
+	payload, err := readPayload(*dumpPath, *generate, *seed)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%+v\n", err)
+		os.Exit(1)
+	}
+	filter, err := regexp.Compile(*compressions)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%+v\n", errors.WithStack(err))
+		os.Exit(1)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "codec\tcompression\tsize\tcompressed\tmarshal ns/op\tmarshal allocs\tunmarshal ns/op\tunmarshal allocs\tcompress ns/op\tdecompress ns/op\t")
+	for _, c := range codec.All() {
+		for _, compressor := range compress.All() {
+			if !filter.MatchString(compressor.Name()) {
+				continue
+			}
+			row, err := measure(payload, c, compressor, *benchTime)
+			if errors.Is(err, codec.ErrUnsupportedType) {
+				continue
+			}
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "%s/%s: %+v\n", c.Name(), compressor.Name(), err)
+				os.Exit(1)
+			}
+			fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t\n",
+				c.Name(), compressor.Name(), row.size, row.compressedSize,
+				row.marshal.nsPerOp, row.marshal.allocsPerOp,
+				row.unmarshal.nsPerOp, row.unmarshal.allocsPerOp,
+				row.compress.nsPerOp, row.decompress.nsPerOp)
+		}
+	}
+	utils.Must(w.Flush())
+}
+
+func readPayload(path string, generate bool, seed int64) (codec.Payload, error) {
+	if generate {
+		config := search_v3.DefaultGeneratorConfig()
+		config.Seed = seed
+		return search_v3.NewResultsPayload(search_v3.GenerateResults(config)), nil
+	}
+
+	dump, err := readDump(path)
+	if err != nil {
+		return codec.Payload{}, err
+	}
+	return search_v3.NewPayload(dump)
+}
+
+func readDump(path string) ([]byte, error) {
+	if path == "" {
+		return search_v3.Dump(), nil
+	}
+	dump, err := os.ReadFile(path)
+	return dump, errors.WithStack(err)
 }
@@ -0,0 +1,91 @@
+package codec
+
+import (
+	"github.com/pkg/errors"
+)
+
+const (
+	ContentTypeJSON     = "application/json"
+	ContentTypeProtobuf = "application/x-protobuf"
+)
+
+var ErrUnsupportedType = errors.New("type is not supported by codec")
+
+// Codec is a serialization format which can be benchmarked against the others
+type Codec interface {
+	Name() string
+	ContentType() string
+	Marshal(v any) ([]byte, error)
+	Unmarshal(data []byte, v any) error
+}
+
+var registry []Codec
+
+func init() {
+	Register(JSON)
+	Register(EasyJSON)
+	Register(JSONIter)
+	Register(Proto)
+	Register(VTProto)
+}
+
+// Register adds codec to the registry, replacing the one with the same name
+func Register(codec Codec) {
+	for i, registered := range registry {
+		if registered.Name() == codec.Name() {
+			registry[i] = codec
+			return
+		}
+	}
+	registry = append(registry, codec)
+}
+
+// Get returns registered codec by its name
+func Get(name string) (Codec, bool) {
+	for _, codec := range registry {
+		if codec.Name() == name {
+			return codec, true
+		}
+	}
+	return nil, false
+}
+
+// All returns registered codecs in the order of registration
+func All() []Codec {
+	result := make([]Codec, len(registry))
+	copy(result, registry)
+	return result
+}
+
+// Payload is the same data in both representations: JSON codecs work with JSON structs, protobuf codecs - with messages
+type Payload struct {
+	JSON     any
+	Proto    any
+	NewJSON  func() any
+	NewProto func() any
+}
+
+// For returns the representation of the payload suitable for codec
+func (p Payload) For(codec Codec) any {
+	if codec.ContentType() == ContentTypeProtobuf {
+		return p.Proto
+	}
+	return p.JSON
+}
+
+// NewFor returns an empty target to unmarshal data encoded by codec
+func (p Payload) NewFor(codec Codec) any {
+	if codec.ContentType() == ContentTypeProtobuf {
+		return p.NewProto()
+	}
+	return p.NewJSON()
+}
+
+// Supports reports whether payload has a representation for codec
+func (p Payload) Supports(codec Codec) bool {
+	return p.For(codec) != nil
+}
+
+func unsupported(codec Codec, v any) error {
+	return errors.Wrapf(ErrUnsupportedType, "%s: %T", codec.Name(), v)
+}
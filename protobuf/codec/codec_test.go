@@ -0,0 +1,112 @@
+package codec
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+// stringCodec encodes strings as they are, content type tells which representation of a payload it gets
+type stringCodec struct {
+	name        string
+	contentType string
+}
+
+func (c stringCodec) Name() string {
+	return c.name
+}
+
+func (c stringCodec) ContentType() string {
+	return c.contentType
+}
+
+func (c stringCodec) Marshal(v any) ([]byte, error) {
+	s, ok := v.(*string)
+	if !ok {
+		return nil, unsupported(c, v)
+	}
+	return []byte(*s), nil
+}
+
+func (c stringCodec) Unmarshal(data []byte, v any) error {
+	s, ok := v.(*string)
+	if !ok {
+		return unsupported(c, v)
+	}
+	*s = string(data)
+	return nil
+}
+
+// withRegistry restores the registry after a test changes it
+func withRegistry(t *testing.T) {
+	saved := All()
+	t.Cleanup(func() { registry = saved })
+}
+
+func TestRegistry(t *testing.T) {
+	names := make([]string, 0, len(All()))
+	for _, c := range All() {
+		names = append(names, c.Name())
+	}
+	require.Equal(t, []string{"json", "easyjson", "jsoniter", "proto", "vtproto"}, names)
+
+	c, ok := Get("vtproto")
+	require.True(t, ok)
+	require.Equal(t, VTProto, c)
+	_, ok = Get("unknown")
+	require.False(t, ok)
+
+	all := All()
+	all[0] = nil
+	require.Equal(t, JSON, All()[0], "All returns a copy")
+}
+
+func TestRegisterDuplicate(t *testing.T) {
+	withRegistry(t)
+	count := len(All())
+
+	text := stringCodec{name: "text", contentType: "text/plain"}
+	Register(text)
+	require.Len(t, All(), count+1)
+	c, ok := Get("text")
+	require.True(t, ok)
+	require.Equal(t, text, c)
+
+	replacement := stringCodec{name: "text", contentType: "text/csv"}
+	Register(replacement)
+	require.Len(t, All(), count+1, "the same name replaces the codec")
+	c, _ = Get("text")
+	require.Equal(t, "text/csv", c.ContentType())
+	require.Equal(t, "text", All()[count].Name(), "in its place")
+
+	Register(stringCodec{name: "json", contentType: ContentTypeJSON})
+	require.Equal(t, "json", All()[0].Name())
+	require.NotEqual(t, JSON, All()[0])
+}
+
+func TestPayloadRoundTrip(t *testing.T) {
+	jsonValue, protoValue := "json", "proto"
+	payload := Payload{
+		JSON:     &jsonValue,
+		Proto:    &protoValue,
+		NewJSON:  func() any { return new(string) },
+		NewProto: func() any { return new(string) },
+	}
+	for _, c := range []Codec{
+		stringCodec{name: "text-json", contentType: ContentTypeJSON},
+		stringCodec{name: "text-proto", contentType: ContentTypeProtobuf},
+	} {
+		require.True(t, payload.Supports(c), c.Name())
+		encoded, err := c.Marshal(payload.For(c))
+		require.NoError(t, err, c.Name())
+		decoded := payload.NewFor(c)
+		require.NoError(t, c.Unmarshal(encoded, decoded), c.Name())
+		require.Equal(t, payload.For(c), decoded, c.Name())
+	}
+	require.Equal(t, "proto", *payload.For(stringCodec{contentType: ContentTypeProtobuf}).(*string))
+	require.Equal(t, "json", *payload.For(stringCodec{contentType: ContentTypeJSON}).(*string))
+
+	jsonOnly := Payload{JSON: &jsonValue, NewJSON: payload.NewJSON}
+	require.False(t, jsonOnly.Supports(stringCodec{contentType: ContentTypeProtobuf}))
+	_, err := stringCodec{name: "text"}.Marshal(42)
+	require.ErrorIs(t, err, ErrUnsupportedType)
+}
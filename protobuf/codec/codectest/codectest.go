@@ -0,0 +1,148 @@
+// Package codectest runs size checks and benchmarks over every registered codec
+package codectest
+
+import (
+	"fmt"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"go-playground/protobuf/codec"
+	"go-playground/protobuf/compress"
+	"go-playground/protobuf/utils"
+	"testing"
+)
+
+type Result struct {
+	Encoded []byte
+	Decoded any
+}
+
+// RoundTrip encodes and decodes payload with every codec supporting it, printing encoded and compressed sizes
+func RoundTrip(t *testing.T, payload codec.Payload) map[string]Result {
+	result := make(map[string]Result)
+	for _, c := range codec.All() {
+		if !payload.Supports(c) {
+			continue
+		}
+		encoded, err := c.Marshal(payload.For(c))
+		if errors.Is(err, codec.ErrUnsupportedType) {
+			continue
+		}
+		require.NoError(t, err)
+
+		fmt.Printf("%s body length: %d\n", c.Name(), len(encoded))
+		printCompressed(encoded)
+
+		decoded := payload.NewFor(c)
+		require.NoError(t, c.Unmarshal(encoded, decoded), c.Name())
+		result[c.Name()] = Result{
+			Encoded: encoded,
+			Decoded: decoded,
+		}
+	}
+	return result
+}
+
+// RequireSizes checks encoded sizes of round trip results by codec name
+func RequireSizes(t *testing.T, results map[string]Result, sizes map[string]int) {
+	for name, size := range sizes {
+		result, ok := results[name]
+		require.True(t, ok, "no result for codec %s", name)
+		require.Len(t, result.Encoded, size, name)
+	}
+}
+
+func Marshal(b *testing.B, payload codec.Payload) {
+	for _, c := range codec.All() {
+		if !payload.Supports(c) {
+			continue
+		}
+		c := c
+		b.Run(c.Name(), func(b *testing.B) {
+			value := payload.For(c)
+			skipUnsupported(b, c, value)
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				c.Marshal(value)
+			}
+		})
+	}
+}
+
+func Unmarshal(b *testing.B, payload codec.Payload) {
+	for _, c := range codec.All() {
+		if !payload.Supports(c) {
+			continue
+		}
+		c := c
+		b.Run(c.Name(), func(b *testing.B) {
+			bytes := skipUnsupported(b, c, payload.For(c))
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				c.Unmarshal(bytes, payload.NewFor(c))
+			}
+		})
+	}
+}
+
+// Compress benchmarks every compressor over payload encoded by every codec
+func Compress(b *testing.B, payload codec.Payload) {
+	eachCompressed(b, payload, func(b *testing.B, compressor compress.Compressor, encoded, _ []byte) {
+		for i := 0; i < b.N; i++ {
+			compressor.Compress(encoded)
+		}
+	})
+}
+
+// Decompress benchmarks every compressor over payload encoded by every codec
+func Decompress(b *testing.B, payload codec.Payload) {
+	eachCompressed(b, payload, func(b *testing.B, compressor compress.Compressor, _, compressed []byte) {
+		for i := 0; i < b.N; i++ {
+			compressor.Decompress(compressed)
+		}
+	})
+}
+
+func eachCompressed(b *testing.B, payload codec.Payload, bench func(b *testing.B, compressor compress.Compressor, encoded, compressed []byte)) {
+	for _, c := range codec.All() {
+		if !payload.Supports(c) {
+			continue
+		}
+		c := c
+		b.Run(c.Name(), func(b *testing.B) {
+			encoded := skipUnsupported(b, c, payload.For(c))
+			for _, compressor := range compress.All() {
+				compressor := compressor
+				b.Run(compressor.Name(), func(b *testing.B) {
+					compressed := utils.Must2(compressor.Compress(encoded))
+					b.ReportAllocs()
+					b.SetBytes(int64(len(encoded)))
+					b.ResetTimer()
+
+					bench(b, compressor, encoded, compressed)
+				})
+			}
+		})
+	}
+}
+
+func printCompressed(encoded []byte) {
+	for _, compressor := range compress.All() {
+		if compressor == compress.None {
+			continue
+		}
+		fmt.Printf("  %s: %d\n", compressor.Name(), len(utils.Must2(compressor.Compress(encoded))))
+	}
+}
+
+func skipUnsupported(b *testing.B, c codec.Codec, value any) []byte {
+	bytes, err := c.Marshal(value)
+	if errors.Is(err, codec.ErrUnsupportedType) {
+		b.Skip(err)
+	}
+	utils.Must(err)
+	return bytes
+}
@@ -0,0 +1,20 @@
+package codectest
+
+import (
+	"github.com/stretchr/testify/require"
+	"go-playground/protobuf/codec"
+	"testing"
+)
+
+func TestRoundTrip(t *testing.T) {
+	value := "hello"
+	payload := codec.Payload{JSON: &value, NewJSON: func() any { return new(string) }}
+	results := RoundTrip(t, payload)
+
+	// Protobuf codecs have no representation, easyjson doesn't support plain strings
+	require.Len(t, results, 2)
+	for _, name := range []string{"json", "jsoniter"} {
+		require.Equal(t, &value, results[name].Decoded, name)
+	}
+	RequireSizes(t, results, map[string]int{"json": len(`"hello"`), "jsoniter": len(`"hello"`)})
+}
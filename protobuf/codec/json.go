@@ -0,0 +1,81 @@
+package codec
+
+import (
+	"encoding/json"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/mailru/easyjson"
+	"github.com/pkg/errors"
+)
+
+var (
+	JSON     Codec = jsonCodec{}
+	EasyJSON Codec = easyJSONCodec{}
+	JSONIter Codec = jsonIterCodec{api: jsoniter.ConfigFastest}
+)
+
+type jsonCodec struct{}
+
+func (jsonCodec) Name() string {
+	return "json"
+}
+
+func (jsonCodec) ContentType() string {
+	return ContentTypeJSON
+}
+
+func (jsonCodec) Marshal(v any) ([]byte, error) {
+	data, err := json.Marshal(v)
+	return data, errors.WithStack(err)
+}
+
+func (jsonCodec) Unmarshal(data []byte, v any) error {
+	return errors.WithStack(json.Unmarshal(data, v))
+}
+
+type easyJSONCodec struct{}
+
+func (easyJSONCodec) Name() string {
+	return "easyjson"
+}
+
+func (easyJSONCodec) ContentType() string {
+	return ContentTypeJSON
+}
+
+func (c easyJSONCodec) Marshal(v any) ([]byte, error) {
+	marshaler, ok := v.(easyjson.Marshaler)
+	if !ok {
+		return nil, unsupported(c, v)
+	}
+	data, err := easyjson.Marshal(marshaler)
+	return data, errors.WithStack(err)
+}
+
+func (c easyJSONCodec) Unmarshal(data []byte, v any) error {
+	unmarshaler, ok := v.(easyjson.Unmarshaler)
+	if !ok {
+		return unsupported(c, v)
+	}
+	return errors.WithStack(easyjson.Unmarshal(data, unmarshaler))
+}
+
+type jsonIterCodec struct {
+	api jsoniter.API
+}
+
+func (jsonIterCodec) Name() string {
+	return "jsoniter"
+}
+
+func (jsonIterCodec) ContentType() string {
+	return ContentTypeJSON
+}
+
+func (c jsonIterCodec) Marshal(v any) ([]byte, error) {
+	data, err := c.api.Marshal(v)
+	return data, errors.WithStack(err)
+}
+
+func (c jsonIterCodec) Unmarshal(data []byte, v any) error {
+	return errors.WithStack(c.api.Unmarshal(data, v))
+}
@@ -0,0 +1,71 @@
+package codec
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+)
+
+var (
+	Proto   Codec = protoCodec{}
+	VTProto Codec = vtProtoCodec{}
+)
+
+// vtMessage is implemented by messages generated with protoc-gen-go-vtproto
+type vtMessage interface {
+	MarshalVT() ([]byte, error)
+	UnmarshalVT(data []byte) error
+}
+
+type protoCodec struct{}
+
+func (protoCodec) Name() string {
+	return "proto"
+}
+
+func (protoCodec) ContentType() string {
+	return ContentTypeProtobuf
+}
+
+func (c protoCodec) Marshal(v any) ([]byte, error) {
+	message, ok := v.(proto.Message)
+	if !ok {
+		return nil, unsupported(c, v)
+	}
+	data, err := proto.Marshal(message)
+	return data, errors.WithStack(err)
+}
+
+func (c protoCodec) Unmarshal(data []byte, v any) error {
+	message, ok := v.(proto.Message)
+	if !ok {
+		return unsupported(c, v)
+	}
+	return errors.WithStack(proto.Unmarshal(data, message))
+}
+
+type vtProtoCodec struct{}
+
+func (vtProtoCodec) Name() string {
+	return "vtproto"
+}
+
+func (vtProtoCodec) ContentType() string {
+	return ContentTypeProtobuf
+}
+
+func (c vtProtoCodec) Marshal(v any) ([]byte, error) {
+	message, ok := v.(vtMessage)
+	if !ok {
+		return nil, unsupported(c, v)
+	}
+	data, err := message.MarshalVT()
+	return data, errors.WithStack(err)
+}
+
+func (c vtProtoCodec) Unmarshal(data []byte, v any) error {
+	message, ok := v.(vtMessage)
+	if !ok {
+		return unsupported(c, v)
+	}
+	return errors.WithStack(message.UnmarshalVT(data))
+}
@@ -0,0 +1,55 @@
+package compress
+
+import (
+	"go-playground/protobuf/utils"
+)
+
+// Compressor is a compression algorithm at a fixed level which can be benchmarked against the others.
+// Decompress is strict and bounded by utils.DefaultMaxDecompressedSize, DecompressWith configures both
+type Compressor interface {
+	Name() string
+	Compress(data []byte) ([]byte, error)
+	Decompress(data []byte) ([]byte, error)
+	DecompressWith(data []byte, opts utils.DecompressOptions) ([]byte, error)
+}
+
+var registry []Compressor
+
+func init() {
+	Register(None)
+	for _, algorithm := range []Algorithm{Gzip, Deflate, Zlib} {
+		for _, level := range Levels {
+			Register(algorithm.Level(level))
+		}
+	}
+	Register(LZW(LSB))
+	Register(LZW(MSB))
+}
+
+// Register adds compressor to the registry, replacing the one with the same name
+func Register(compressor Compressor) {
+	for i, registered := range registry {
+		if registered.Name() == compressor.Name() {
+			registry[i] = compressor
+			return
+		}
+	}
+	registry = append(registry, compressor)
+}
+
+// Get returns registered compressor by its name
+func Get(name string) (Compressor, bool) {
+	for _, compressor := range registry {
+		if compressor.Name() == name {
+			return compressor, true
+		}
+	}
+	return nil, false
+}
+
+// All returns registered compressors in the order of registration
+func All() []Compressor {
+	result := make([]Compressor, len(registry))
+	copy(result, registry)
+	return result
+}
@@ -0,0 +1,111 @@
+package compress
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"go-playground/protobuf/utils"
+	"strings"
+	"testing"
+)
+
+// sample is a mix of repeated structure and random content, similar to serialized search results
+func sample() []byte {
+	random := utils.NewRandom(1)
+	var sb strings.Builder
+	for i := 0; i < 1000; i++ {
+		fmt.Fprintf(&sb, `{"id":%d,"price":%d,"signature":"%s"},`, i, random.Int(1000, 100000), random.StringOf(32, utils.Hex))
+	}
+	return []byte(sb.String())
+}
+
+func TestRoundTrip(t *testing.T) {
+	data := sample()
+	names := map[string]bool{}
+	for _, compressor := range All() {
+		require.False(t, names[compressor.Name()], compressor.Name())
+		names[compressor.Name()] = true
+
+		compressed, err := compressor.Compress(data)
+		require.NoError(t, err, compressor.Name())
+		decompressed, err := compressor.Decompress(compressed)
+		require.NoError(t, err, compressor.Name())
+		require.Equal(t, data, decompressed, compressor.Name())
+	}
+	// none + 3 algorithms at every level + 2 LZW bit orders
+	require.Len(t, names, 1+3*len(Levels)+2)
+}
+
+func TestDecompressStrict(t *testing.T) {
+	data := sample()
+	for _, compressor := range All() {
+		if compressor == None {
+			continue
+		}
+		compressed := utils.Must2(compressor.Compress(data))
+
+		_, err := compressor.Decompress(compressed[:len(compressed)/2])
+		require.ErrorIs(t, err, utils.ErrTruncated, compressor.Name())
+
+		_, err = compressor.DecompressWith(compressed, utils.DecompressOptions{MaxSize: int64(len(data) / 2)})
+		require.ErrorIs(t, err, utils.ErrTooLarge, compressor.Name())
+	}
+}
+
+func TestDecompressAllowUncompressed(t *testing.T) {
+	data := sample()
+	allowUncompressed := utils.DecompressOptions{AllowUncompressed: true}
+	for _, name := range []string{"gzip-default", "zlib-default"} {
+		compressor, _ := Get(name)
+		_, err := compressor.Decompress(data)
+		require.ErrorIs(t, err, utils.ErrCorrupt, name)
+		require.Equal(t, data, utils.Must2(compressor.DecompressWith(data, allowUncompressed)), name)
+
+		compressed := utils.Must2(compressor.Compress(data))
+		require.Equal(t, data, utils.Must2(compressor.DecompressWith(compressed, allowUncompressed)), name)
+	}
+
+	_, err := None.DecompressWith(data, utils.DecompressOptions{MaxSize: 1})
+	require.ErrorIs(t, err, utils.ErrTooLarge)
+}
+
+func TestGet(t *testing.T) {
+	compressor, ok := Get("gzip-9")
+	require.True(t, ok)
+	require.Equal(t, "gzip-9", compressor.Name())
+
+	_, ok = Get("brotli")
+	require.False(t, ok)
+}
+
+func BenchmarkCompress(b *testing.B) {
+	data := sample()
+	for _, compressor := range All() {
+		compressor := compressor
+		b.Run(compressor.Name(), func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(data)))
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				compressor.Compress(data)
+			}
+		})
+	}
+}
+
+func BenchmarkDecompress(b *testing.B) {
+	data := sample()
+	for _, compressor := range All() {
+		compressor := compressor
+		b.Run(compressor.Name(), func(b *testing.B) {
+			compressed := utils.Must2(compressor.Compress(data))
+			b.ReportAllocs()
+			b.SetBytes(int64(len(data)))
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				compressor.Decompress(compressed)
+			}
+		})
+	}
+}
@@ -0,0 +1,208 @@
+package compress
+
+import (
+	"bytes"
+	"compress/flate"
+	"container/heap"
+	"encoding/binary"
+	"fmt"
+	"github.com/pkg/errors"
+	"go-playground/protobuf/utils"
+	"io"
+)
+
+// MaxDictionarySize is the DEFLATE window, bytes of a longer dictionary are never referenced
+const MaxDictionarySize = 32 * 1024
+
+var (
+	ErrDictionaryVersion = errors.New("data is compressed with another dictionary version")
+	ErrDictionaryFormat  = errors.New("malformed dictionary")
+)
+
+var dictionaryMagic = []byte("FLDICT")
+
+// Dictionary is a versioned DEFLATE preset dictionary. Every compressed frame starts with the version,
+// so data compressed with one dictionary is never silently decoded with another
+type Dictionary struct {
+	Version uint32
+	Data    []byte
+}
+
+func (d Dictionary) MarshalBinary() ([]byte, error) {
+	result := make([]byte, 0, len(dictionaryMagic)+binary.MaxVarintLen32+len(d.Data))
+	result = append(result, dictionaryMagic...)
+	result = binary.AppendUvarint(result, uint64(d.Version))
+	return append(result, d.Data...), nil
+}
+
+func (d *Dictionary) UnmarshalBinary(data []byte) error {
+	if !bytes.HasPrefix(data, dictionaryMagic) {
+		return errors.WithStack(ErrDictionaryFormat)
+	}
+	data = data[len(dictionaryMagic):]
+	version, n := binary.Uvarint(data)
+	if n <= 0 || version > uint64(^uint32(0)) {
+		return errors.WithStack(ErrDictionaryFormat)
+	}
+	d.Version = uint32(version)
+	d.Data = append([]byte(nil), data[n:]...)
+	return nil
+}
+
+// Compressor returns raw DEFLATE compressor at level primed with the dictionary
+func (d Dictionary) Compressor(level int) Compressor {
+	return &dictCompressor{
+		name:  fmt.Sprintf("deflate-dict-v%d-%s", d.Version, levelName(level)),
+		dict:  d,
+		level: level,
+	}
+}
+
+type dictCompressor struct {
+	name  string
+	dict  Dictionary
+	level int
+}
+
+func (c *dictCompressor) Name() string {
+	return c.name
+}
+
+func (c *dictCompressor) Compress(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.Write(binary.AppendUvarint(nil, uint64(c.dict.Version)))
+	w, err := flate.NewWriterDict(&buf, c.level, c.dict.Data)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if _, err := w.Write(data); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if err := w.Close(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return buf.Bytes(), nil
+}
+
+func (c *dictCompressor) Decompress(data []byte) ([]byte, error) {
+	return c.DecompressWith(data, utils.DecompressOptions{})
+}
+
+// DecompressWith ignores AllowUncompressed, raw DEFLATE has no header to detect
+func (c *dictCompressor) DecompressWith(data []byte, opts utils.DecompressOptions) ([]byte, error) {
+	version, n := binary.Uvarint(data)
+	if n <= 0 {
+		return nil, utils.DecompressionError(io.ErrUnexpectedEOF)
+	}
+	if version != uint64(c.dict.Version) {
+		return nil, errors.Wrapf(ErrDictionaryVersion, "got v%d, expected v%d", version, c.dict.Version)
+	}
+	return utils.Decompress(data[n:], opts, func(r io.Reader) (io.ReadCloser, error) {
+		return flate.NewReaderDict(r, c.dict.Data), nil
+	})
+}
+
+const (
+	dictionaryKmer    = 8
+	dictionarySegment = 64
+)
+
+// BuildDictionary picks the most frequent content of corpus samples into a dictionary of at most size bytes.
+// Samples are split into segments, a segment is scored by the corpus frequency of its k-mers not covered yet,
+// and the best segments are taken greedily. The best segment goes last, as DEFLATE encodes closer matches cheaper
+func BuildDictionary(corpus [][]byte, size int) []byte {
+	if size > MaxDictionarySize {
+		size = MaxDictionarySize
+	}
+
+	frequency := make(map[uint64]int)
+	for _, sample := range corpus {
+		for i := 0; i+dictionaryKmer <= len(sample); i++ {
+			frequency[kmer(sample[i:])]++
+		}
+	}
+
+	var candidates segmentHeap
+	for _, sample := range corpus {
+		for start := 0; start+dictionaryKmer <= len(sample); start += dictionarySegment {
+			end := start + dictionarySegment
+			if end > len(sample) {
+				end = len(sample)
+			}
+			segment := segmentCandidate{data: sample[start:end]}
+			segment.score = segment.rescore(frequency)
+			if segment.score > 0 {
+				candidates = append(candidates, segment)
+			}
+		}
+	}
+	heap.Init(&candidates)
+
+	var selected [][]byte
+	total := 0
+	for candidates.Len() > 0 && total < size {
+		best := heap.Pop(&candidates).(segmentCandidate)
+		// Scores only decrease, so a stale score is an upper bound: recompute and retry unless it is still the best
+		if score := best.rescore(frequency); score != best.score {
+			best.score = score
+			if score > 0 {
+				heap.Push(&candidates, best)
+			}
+			continue
+		}
+		data := best.data
+		if total+len(data) > size {
+			data = data[:size-total]
+		}
+		selected = append(selected, data)
+		total += len(data)
+		for i := 0; i+dictionaryKmer <= len(best.data); i++ {
+			delete(frequency, kmer(best.data[i:]))
+		}
+	}
+
+	result := make([]byte, 0, total)
+	for i := len(selected) - 1; i >= 0; i-- {
+		result = append(result, selected[i]...)
+	}
+	return result
+}
+
+func kmer(data []byte) uint64 {
+	return binary.LittleEndian.Uint64(data[:dictionaryKmer])
+}
+
+type segmentCandidate struct {
+	data  []byte
+	score int
+}
+
+// rescore sums frequencies of distinct k-mers of the segment, the ones seen only once can't be matched
+func (s segmentCandidate) rescore(frequency map[uint64]int) int {
+	seen := make(map[uint64]struct{}, len(s.data))
+	score := 0
+	for i := 0; i+dictionaryKmer <= len(s.data); i++ {
+		key := kmer(s.data[i:])
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		if count := frequency[key]; count > 1 {
+			score += count
+		}
+	}
+	return score
+}
+
+type segmentHeap []segmentCandidate
+
+func (h segmentHeap) Len() int           { return len(h) }
+func (h segmentHeap) Less(i, j int) bool { return h[i].score > h[j].score }
+func (h segmentHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *segmentHeap) Push(x any)        { *h = append(*h, x.(segmentCandidate)) }
+func (h *segmentHeap) Pop() any {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
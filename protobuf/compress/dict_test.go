@@ -0,0 +1,48 @@
+package compress
+
+import (
+	"github.com/stretchr/testify/require"
+	"go-playground/protobuf/utils"
+	"testing"
+)
+
+func TestDictionaryRoundTrip(t *testing.T) {
+	data := sample()
+	dictionary := Dictionary{Version: 3, Data: BuildDictionary([][]byte{data}, 4096)}
+	require.LessOrEqual(t, len(dictionary.Data), 4096)
+	require.NotEmpty(t, dictionary.Data)
+
+	compressor := dictionary.Compressor(6)
+	require.Equal(t, "deflate-dict-v3-6", compressor.Name())
+	compressed := utils.Must2(compressor.Compress(data))
+	require.Equal(t, data, utils.Must2(compressor.Decompress(compressed)))
+	_, err := compressor.Decompress(compressed[:len(compressed)/2])
+	require.ErrorIs(t, err, utils.ErrTruncated)
+
+	encoded := utils.Must2(dictionary.MarshalBinary())
+	var decoded Dictionary
+	require.NoError(t, decoded.UnmarshalBinary(encoded))
+	require.Equal(t, dictionary, decoded)
+	require.ErrorIs(t, decoded.UnmarshalBinary(dictionary.Data), ErrDictionaryFormat)
+}
+
+func TestDictionaryVersion(t *testing.T) {
+	data := sample()
+	v1 := Dictionary{Version: 1, Data: BuildDictionary([][]byte{data}, 1024)}
+	v2 := Dictionary{Version: 2, Data: v1.Data}
+
+	compressed := utils.Must2(v1.Compressor(6).Compress(data))
+	_, err := v2.Compressor(6).Decompress(compressed)
+	require.ErrorIs(t, err, ErrDictionaryVersion)
+}
+
+// Dictionary pays off on small payloads, which have no history of their own to match against
+func TestDictionaryGain(t *testing.T) {
+	data := sample()
+	train, test := data[:len(data)/2], data[len(data)/2:][:500]
+	dictionary := Dictionary{Version: 1, Data: BuildDictionary([][]byte{train}, MaxDictionarySize)}
+
+	plain := utils.Must2(Deflate.Level(6).Compress(test))
+	withDictionary := utils.Must2(dictionary.Compressor(6).Compress(test))
+	require.Less(t, len(withDictionary), len(plain))
+}
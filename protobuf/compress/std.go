@@ -0,0 +1,166 @@
+package compress
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/lzw"
+	"compress/zlib"
+	"fmt"
+	"github.com/pkg/errors"
+	"go-playground/protobuf/utils"
+	"io"
+)
+
+// Levels are all compression levels shared by gzip, deflate and zlib
+var Levels = []int{
+	flate.HuffmanOnly,
+	flate.DefaultCompression,
+	flate.NoCompression,
+	1, 2, 3, 4, 5, 6, 7, 8,
+	flate.BestCompression,
+}
+
+// LZW bit orders, literals are always 8 bits wide
+const (
+	LSB = lzw.LSB
+	MSB = lzw.MSB
+)
+
+var None Compressor = noneCompressor{}
+
+var (
+	Gzip = Algorithm{
+		name: "gzip",
+		newWriter: func(w io.Writer, level int) (io.WriteCloser, error) {
+			return gzip.NewWriterLevel(w, level)
+		},
+		newReader: func(r io.Reader) (io.ReadCloser, error) {
+			return gzip.NewReader(r)
+		},
+		detect: utils.IsGZIP,
+	}
+	Deflate = Algorithm{
+		name: "deflate",
+		newWriter: func(w io.Writer, level int) (io.WriteCloser, error) {
+			return flate.NewWriter(w, level)
+		},
+		newReader: func(r io.Reader) (io.ReadCloser, error) {
+			return flate.NewReader(r), nil
+		},
+	}
+	Zlib = Algorithm{
+		name: "zlib",
+		newWriter: func(w io.Writer, level int) (io.WriteCloser, error) {
+			return zlib.NewWriterLevel(w, level)
+		},
+		newReader: func(r io.Reader) (io.ReadCloser, error) {
+			return zlib.NewReader(r)
+		},
+		detect: isZlib,
+	}
+)
+
+type noneCompressor struct{}
+
+func (noneCompressor) Name() string { return "none" }
+
+func (noneCompressor) Compress(data []byte) ([]byte, error) { return data, nil }
+
+func (c noneCompressor) Decompress(data []byte) ([]byte, error) {
+	return c.DecompressWith(data, utils.DecompressOptions{})
+}
+
+func (noneCompressor) DecompressWith(data []byte, opts utils.DecompressOptions) ([]byte, error) {
+	return utils.ReadAllDecompressed(bytes.NewReader(data), opts)
+}
+
+// Algorithm is a family of compressors that differ only by level
+type Algorithm struct {
+	name      string
+	newWriter func(w io.Writer, level int) (io.WriteCloser, error)
+	newReader func(r io.Reader) (io.ReadCloser, error)
+	// detect checks the header of compressed data, nil for headerless formats
+	detect func(data []byte) bool
+}
+
+// Level returns the compressor of the algorithm at level
+func (a Algorithm) Level(level int) Compressor {
+	return &streamCompressor{
+		name: a.name + "-" + levelName(level),
+		newWriter: func(w io.Writer) (io.WriteCloser, error) {
+			return a.newWriter(w, level)
+		},
+		newReader: a.newReader,
+		detect:    a.detect,
+	}
+}
+
+func LZW(order lzw.Order) Compressor {
+	name := "lzw-lsb"
+	if order == MSB {
+		name = "lzw-msb"
+	}
+	return &streamCompressor{
+		name: name,
+		newWriter: func(w io.Writer) (io.WriteCloser, error) {
+			return lzw.NewWriter(w, order, 8), nil
+		},
+		newReader: func(r io.Reader) (io.ReadCloser, error) {
+			return lzw.NewReader(r, order, 8), nil
+		},
+	}
+}
+
+func levelName(level int) string {
+	switch level {
+	case flate.HuffmanOnly:
+		return "huffman"
+	case flate.DefaultCompression:
+		return "default"
+	default:
+		return fmt.Sprint(level)
+	}
+}
+
+// isZlib checks zlib header: DEFLATE method and a valid header checksum
+func isZlib(data []byte) bool {
+	return len(data) >= 2 && data[0]&0x0f == 8 && (uint16(data[0])<<8|uint16(data[1]))%31 == 0
+}
+
+type streamCompressor struct {
+	name      string
+	newWriter func(w io.Writer) (io.WriteCloser, error)
+	newReader func(r io.Reader) (io.ReadCloser, error)
+	detect    func(data []byte) bool
+}
+
+func (c *streamCompressor) Name() string {
+	return c.name
+}
+
+func (c *streamCompressor) Compress(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w, err := c.newWriter(&buf)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if _, err := w.Write(data); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if err := w.Close(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return buf.Bytes(), nil
+}
+
+func (c *streamCompressor) Decompress(data []byte) ([]byte, error) {
+	return c.DecompressWith(data, utils.DecompressOptions{})
+}
+
+func (c *streamCompressor) DecompressWith(data []byte, opts utils.DecompressOptions) ([]byte, error) {
+	if opts.AllowUncompressed && c.detect != nil && !c.detect(data) {
+		return data, nil
+	}
+	return utils.Decompress(data, opts, c.newReader)
+}
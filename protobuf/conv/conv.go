@@ -0,0 +1,147 @@
+// Package conv converts slices, maps, optional values and numbers between generated and domain types
+package conv
+
+import (
+	"fmt"
+	"github.com/pkg/errors"
+	"golang.org/x/exp/constraints"
+)
+
+var ErrOverflow = errors.New("integer overflow")
+
+// Map converts keys and values of from, nil stays nil
+func Map[K1 comparable, K2 comparable, V1 any, V2 any](from map[K1]V1, k func(K1) K2, v func(V1) V2) map[K2]V2 {
+	if from == nil {
+		return nil
+	}
+	result := make(map[K2]V2, len(from))
+	for k1, v1 := range from {
+		result[k(k1)] = v(v1)
+	}
+	return result
+}
+
+// MapE is Map stopping at the first failed key or value, the error names the key
+func MapE[K1 comparable, K2 comparable, V1 any, V2 any](from map[K1]V1, k func(K1) (K2, error), v func(V1) (V2, error)) (map[K2]V2, error) {
+	if from == nil {
+		return nil, nil
+	}
+	result := make(map[K2]V2, len(from))
+	for k1, v1 := range from {
+		k2, err := k(k1)
+		if err != nil {
+			return nil, errors.Wrapf(err, "key %v", k1)
+		}
+		if result[k2], err = v(v1); err != nil {
+			return nil, errors.Wrapf(err, "[%v]", k1)
+		}
+	}
+	return result, nil
+}
+
+// Array converts elements of from, nil stays nil
+func Array[V1 any, V2 any](from []V1, conv func(V1) V2) []V2 {
+	if from == nil {
+		return nil
+	}
+	result := make([]V2, len(from))
+	for i, v1 := range from {
+		result[i] = conv(v1)
+	}
+	return result
+}
+
+// ArrayE is Array stopping at the first failed element, the error names its index
+func ArrayE[V1 any, V2 any](from []V1, conv func(V1) (V2, error)) ([]V2, error) {
+	if from == nil {
+		return nil, nil
+	}
+	result := make([]V2, len(from))
+	for i, v1 := range from {
+		var err error
+		if result[i], err = conv(v1); err != nil {
+			return nil, errors.Wrapf(err, "[%d]", i)
+		}
+	}
+	return result, nil
+}
+
+// Opt converts the value from points to, nil becomes the zero value, e.g. a nil message
+func Opt[A any, B any](from *A, conv func(A) B) B {
+	if from == nil {
+		var zero B
+		return zero
+	}
+	return conv(*from)
+}
+
+// OptPtr converts the value from points to, nil stays nil
+func OptPtr[A any, B any](from *A, conv func(A) B) *B {
+	if from == nil {
+		return nil
+	}
+	result := conv(*from)
+	return &result
+}
+
+// OptRef is OptPtr for values which must not be copied like proto messages, conv gets from itself
+func OptRef[A any, B any](from *A, conv func(*A) B) *B {
+	if from == nil {
+		return nil
+	}
+	result := conv(from)
+	return &result
+}
+
+// Deref is the value p points to or the zero value when p is nil
+func Deref[T any](p *T) T {
+	var zero T
+	return DerefOr(p, zero)
+}
+
+// DerefOr is the value p points to or fallback when p is nil
+func DerefOr[T any](p *T, fallback T) T {
+	if p == nil {
+		return fallback
+	}
+	return *p
+}
+
+// NonZero points to val unless it's the zero value
+func NonZero[T comparable](val T) *T {
+	var zero T
+	if val == zero {
+		return nil
+	}
+	return &val
+}
+
+func Same[A any](val A) A {
+	return val
+}
+
+func String[V ~string](val V) string {
+	return string(val)
+}
+
+func FromString[V ~string](val string) V {
+	return V(val)
+}
+
+func Stringer[V fmt.Stringer](val V) string {
+	return val.String()
+}
+
+// Int converts integers of any size and sign, ErrOverflow when b doesn't hold a
+func Int[A constraints.Integer, B constraints.Integer](a A) (B, error) {
+	b := B(a)
+	if A(b) != a || (a < 0) != (b < 0) {
+		return b, errors.Wrapf(ErrOverflow, "%d doesn't fit %T", a, b)
+	}
+	return b, nil
+}
+
+// Cast converts integers unchecked, for widening which can't overflow like int to int64, see Int otherwise
+func Cast[A constraints.Integer, B constraints.Integer](a A) B {
+	return B(a)
+}
@@ -0,0 +1,83 @@
+package conv
+
+import (
+	"github.com/stretchr/testify/require"
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestMapArray(t *testing.T) {
+	require.Nil(t, Map(map[int]string(nil), strconv.Itoa, Same[string]))
+	require.Equal(t, map[string]int64{"1": 10}, Map(map[int]int{1: 10}, strconv.Itoa, Cast[int, int64]))
+	require.Nil(t, Array([]int(nil), strconv.Itoa))
+	require.Equal(t, []string{}, Array([]int{}, strconv.Itoa))
+	require.Equal(t, []string{"1", "2"}, Array([]int{1, 2}, strconv.Itoa))
+}
+
+func TestMapArrayE(t *testing.T) {
+	result, err := ArrayE([]string{"1", "2"}, strconv.Atoi)
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2}, result)
+	result, err = ArrayE([]string{"1", "x"}, strconv.Atoi)
+	require.Nil(t, result)
+	require.ErrorIs(t, err, strconv.ErrSyntax)
+	require.Contains(t, err.Error(), "[1]")
+	result, err = ArrayE([]string(nil), strconv.Atoi)
+	require.NoError(t, err)
+	require.Nil(t, result)
+
+	converted, err := MapE(map[string]string{"1": "10"}, strconv.Atoi, strconv.Atoi)
+	require.NoError(t, err)
+	require.Equal(t, map[int]int{1: 10}, converted)
+	converted, err = MapE(map[string]string{"x": "10"}, strconv.Atoi, strconv.Atoi)
+	require.Nil(t, converted)
+	require.ErrorIs(t, err, strconv.ErrSyntax)
+	require.Contains(t, err.Error(), "key x")
+	converted, err = MapE(map[string]string{"1": "y"}, strconv.Atoi, strconv.Atoi)
+	require.Nil(t, converted)
+	require.Contains(t, err.Error(), "[1]")
+	converted, err = MapE(map[string]string(nil), strconv.Atoi, strconv.Atoi)
+	require.NoError(t, err)
+	require.Nil(t, converted)
+}
+
+func TestOptional(t *testing.T) {
+	zero, seven := 0, 7
+	require.Nil(t, Opt((*int)(nil), func(v int) *string { return NonZero(strconv.Itoa(v)) }))
+	require.Equal(t, "7", Opt(&seven, strconv.Itoa))
+	require.Nil(t, OptPtr((*int)(nil), strconv.Itoa))
+	require.Equal(t, "0", *OptPtr(&zero, strconv.Itoa), "zero isn't nil")
+	require.Nil(t, OptRef((*int)(nil), func(v *int) int { return *v }))
+	require.Equal(t, 7, *OptRef(&seven, func(v *int) int { return *v }))
+
+	require.Equal(t, 0, Deref((*int)(nil)))
+	require.Equal(t, 7, Deref(&seven))
+	require.Equal(t, 7, DerefOr(nil, seven))
+	require.Equal(t, 0, DerefOr(&zero, seven))
+	require.Nil(t, NonZero(""))
+	require.Equal(t, "a", *NonZero("a"))
+}
+
+func TestInt(t *testing.T) {
+	for name, check := range map[string]func() error{
+		"int64 to int32 max": func() error { _, err := Int[int64, int32](math.MaxInt32 + 1); return err },
+		"int64 to int32 min": func() error { _, err := Int[int64, int32](math.MinInt32 - 1); return err },
+		"negative to uint":   func() error { _, err := Int[int, uint64](-1); return err },
+		"uint64 to int64":    func() error { _, err := Int[uint64, int64](math.MaxUint64); return err },
+		"uint32 to int32":    func() error { _, err := Int[uint32, int32](math.MaxInt32 + 1); return err },
+		"int8 to uint8":      func() error { _, err := Int[int8, uint8](-128); return err },
+	} {
+		require.ErrorIs(t, check(), ErrOverflow, name)
+	}
+
+	value, err := Int[int64, int32](math.MinInt32)
+	require.NoError(t, err)
+	require.Equal(t, int32(math.MinInt32), value)
+	unsigned, err := Int[int64, uint8](255)
+	require.NoError(t, err)
+	require.Equal(t, uint8(255), unsigned)
+	_, err = Int[int, uint8](300)
+	require.EqualError(t, err, "300 doesn't fit uint8: integer overflow")
+	require.Equal(t, int64(math.MinInt64), Cast[int, int64](math.MinInt64))
+}
@@ -0,0 +1,110 @@
+// Package httpapi serves codec payloads over HTTP, negotiating the codec by Accept and the compression by Accept-Encoding
+package httpapi
+
+import (
+	"github.com/pkg/errors"
+	"go-playground/protobuf/codec"
+	"log"
+	"net/http"
+	"strconv"
+)
+
+// Source returns the payload to serve for the request
+type Source func(r *http.Request) (codec.Payload, error)
+
+type Handler struct {
+	Source Source
+	// Codecs in the order of preference, DefaultCodecs when empty
+	Codecs []codec.Codec
+	// Encodings in the order of preference, DefaultEncodings when empty
+	Encodings []Encoding
+	// ErrorLog logs errors answered with 500 Internal Server Error, the standard logger when nil
+	ErrorLog *log.Logger
+}
+
+func NewHandler(source Source) *Handler {
+	return &Handler{Source: source}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Add("Vary", "Accept")
+	w.Header().Add("Vary", "Accept-Encoding")
+
+	codecs := h.codecs()
+	payload, err := h.Source(r)
+	if err != nil {
+		h.internalError(w, r, err)
+		return
+	}
+	supported := make([]codec.Codec, 0, len(codecs))
+	for _, c := range codecs {
+		if payload.Supports(c) {
+			supported = append(supported, c)
+		}
+	}
+
+	c, ok := NegotiateCodec(r.Header.Get("Accept"), supported)
+	if !ok {
+		http.Error(w, http.StatusText(http.StatusNotAcceptable), http.StatusNotAcceptable)
+		return
+	}
+	encoding, ok := NegotiateEncoding(r.Header.Get("Accept-Encoding"), h.encodings())
+	if !ok {
+		http.Error(w, http.StatusText(http.StatusNotAcceptable), http.StatusNotAcceptable)
+		return
+	}
+
+	body, err := c.Marshal(payload.For(c))
+	if errors.Is(err, codec.ErrUnsupportedType) {
+		http.Error(w, http.StatusText(http.StatusNotAcceptable), http.StatusNotAcceptable)
+		return
+	}
+	if err != nil {
+		h.internalError(w, r, err)
+		return
+	}
+	body, err = encoding.Compressor.Compress(body)
+	if err != nil {
+		h.internalError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType(c, codecs))
+	if encoding.Name != Identity {
+		w.Header().Set("Content-Encoding", encoding.Name)
+	}
+	w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+	w.WriteHeader(http.StatusOK)
+	if r.Method != http.MethodHead {
+		w.Write(body)
+	}
+}
+
+// internalError logs err and answers with the bare status text, so details of the error don't reach the client
+func (h *Handler) internalError(w http.ResponseWriter, r *http.Request, err error) {
+	if h.ErrorLog != nil {
+		h.ErrorLog.Printf("httpapi: %s %s: %v", r.Method, r.URL, err)
+	} else {
+		log.Printf("httpapi: %s %s: %v", r.Method, r.URL, err)
+	}
+	http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+}
+
+func (h *Handler) codecs() []codec.Codec {
+	if len(h.Codecs) == 0 {
+		return DefaultCodecs
+	}
+	return h.Codecs
+}
+
+func (h *Handler) encodings() []Encoding {
+	if len(h.Encodings) == 0 {
+		return DefaultEncodings
+	}
+	return h.Encodings
+}
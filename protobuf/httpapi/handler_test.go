@@ -0,0 +1,170 @@
+package httpapi_test
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"go-playground/protobuf/codec"
+	"go-playground/protobuf/httpapi"
+	search_v3 "go-playground/protobuf/search-v3"
+	"go-playground/protobuf/utils"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+var payload = search_v3.NewResultsPayload(search_v3.GenerateResults(search_v3.DefaultGeneratorConfig()))
+
+func newServer() *httptest.Server {
+	return httptest.NewServer(httpapi.NewHandler(func(r *http.Request) (codec.Payload, error) {
+		return payload, nil
+	}))
+}
+
+func get(t *testing.T, url, accept, acceptEncoding string) (*http.Response, []byte) {
+	req := utils.Must2(http.NewRequest(http.MethodGet, url, nil))
+	req.Header.Set("Accept", accept)
+	// Explicit Accept-Encoding turns off transparent decompression of the transport
+	req.Header.Set("Accept-Encoding", acceptEncoding)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	return resp, utils.Must2(io.ReadAll(resp.Body))
+}
+
+func TestHandler(t *testing.T) {
+	server := newServer()
+	defer server.Close()
+
+	expectedJSON := utils.Must2(codec.JSON.Marshal(payload.JSON))
+	for _, c := range httpapi.DefaultCodecs {
+		for _, encoding := range httpapi.DefaultEncodings {
+			name := fmt.Sprintf("%s/%s", c.Name(), encoding.Name)
+			accept := fmt.Sprintf("%s; codec=%s", c.ContentType(), c.Name())
+			resp, body := get(t, server.URL, accept, encoding.Name)
+			require.Equal(t, http.StatusOK, resp.StatusCode, name)
+			require.Equal(t, []string{"Accept", "Accept-Encoding"}, resp.Header.Values("Vary"), name)
+
+			if encoding.Name == httpapi.Identity {
+				require.Empty(t, resp.Header.Get("Content-Encoding"), name)
+			} else {
+				require.Equal(t, encoding.Name, resp.Header.Get("Content-Encoding"), name)
+			}
+			decoded := payload.NewFor(c)
+			require.NoError(t, c.Unmarshal(utils.Must2(encoding.Compressor.Decompress(body)), decoded), name)
+
+			if c.ContentType() == codec.ContentTypeProtobuf {
+				require.True(t, proto.Equal(payload.Proto.(proto.Message), decoded.(proto.Message)), name)
+			} else {
+				require.JSONEq(t, string(expectedJSON), string(utils.Must2(codec.JSON.Marshal(decoded))), name)
+			}
+			t.Logf("%s: %d bytes on wire", name, len(body))
+		}
+	}
+}
+
+func TestHandlerDefaults(t *testing.T) {
+	server := newServer()
+	defer server.Close()
+
+	resp, _ := get(t, server.URL, "application/x-protobuf", "gzip, deflate")
+	require.Equal(t, "application/x-protobuf", resp.Header.Get("Content-Type"))
+	require.Equal(t, "gzip", resp.Header.Get("Content-Encoding"))
+
+	resp, _ = get(t, server.URL, "", "")
+	require.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+	require.Empty(t, resp.Header.Get("Content-Encoding"))
+
+	resp, _ = get(t, server.URL, "application/json; codec=json", "")
+	require.Equal(t, "application/json; codec=json", resp.Header.Get("Content-Type"))
+}
+
+func TestHandlerNotAcceptable(t *testing.T) {
+	server := newServer()
+	defer server.Close()
+
+	resp, _ := get(t, server.URL, "text/html", "")
+	require.Equal(t, http.StatusNotAcceptable, resp.StatusCode)
+	resp, _ = get(t, server.URL, "application/json", "identity;q=0")
+	require.Equal(t, http.StatusNotAcceptable, resp.StatusCode)
+	// easyjson doesn't support search results
+	resp, _ = get(t, server.URL, "application/json; codec=easyjson", "")
+	require.Equal(t, http.StatusNotAcceptable, resp.StatusCode)
+
+	resp = utils.Must2(http.Post(server.URL, "application/json", nil))
+	resp.Body.Close()
+	require.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+}
+
+// failingCodec fails to marshal anything with err
+type failingCodec struct {
+	err error
+}
+
+func (c failingCodec) Name() string {
+	return "failing"
+}
+
+func (c failingCodec) ContentType() string {
+	return codec.ContentTypeJSON
+}
+
+func (c failingCodec) Marshal(v any) ([]byte, error) {
+	return nil, c.err
+}
+
+func (c failingCodec) Unmarshal(data []byte, v any) error {
+	return c.err
+}
+
+func TestHandlerInternalError(t *testing.T) {
+	secret := errors.New("connection to db-1.internal refused")
+	var logged bytes.Buffer
+	for name, handler := range map[string]*httpapi.Handler{
+		"source": {
+			Source:   func(r *http.Request) (codec.Payload, error) { return codec.Payload{}, secret },
+			ErrorLog: log.New(&logged, "", 0),
+		},
+		"codec": {
+			Source:   func(r *http.Request) (codec.Payload, error) { return payload, nil },
+			Codecs:   []codec.Codec{failingCodec{err: secret}},
+			ErrorLog: log.New(&logged, "", 0),
+		},
+	} {
+		logged.Reset()
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/results", nil))
+		require.Equal(t, http.StatusInternalServerError, w.Code, name)
+		require.NotContains(t, w.Body.String(), secret.Error(), name)
+		require.Equal(t, http.StatusText(http.StatusInternalServerError)+"\n", w.Body.String(), name)
+		require.Equal(t, "httpapi: GET /results: "+secret.Error()+"\n", logged.String(), name)
+	}
+}
+
+// Reports bytes on wire next to the time of a full response
+func BenchmarkHandler(b *testing.B) {
+	handler := httpapi.NewHandler(func(r *http.Request) (codec.Payload, error) {
+		return payload, nil
+	})
+	for _, c := range httpapi.DefaultCodecs {
+		for _, encoding := range httpapi.DefaultEncodings {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set("Accept", fmt.Sprintf("%s; codec=%s", c.ContentType(), c.Name()))
+			req.Header.Set("Accept-Encoding", encoding.Name)
+			b.Run(fmt.Sprintf("%s/%s", c.Name(), encoding.Name), func(b *testing.B) {
+				var size int
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					w := httptest.NewRecorder()
+					handler.ServeHTTP(w, req)
+					size = w.Body.Len()
+				}
+				b.ReportMetric(float64(size), "wire-bytes")
+			})
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package httpapi
+
+import (
+	"compress/flate"
+	"go-playground/protobuf/codec"
+	"go-playground/protobuf/compress"
+	"mime"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// CodecParam picks a particular codec among the ones sharing a content type,
+// e.g. "application/json; codec=json" asks for encoding/json instead of the default jsoniter
+const CodecParam = "codec"
+
+// Encoding is a compressor behind a Content-Encoding token
+type Encoding struct {
+	Name       string
+	Compressor compress.Compressor
+}
+
+const Identity = "identity"
+
+// DefaultCodecs are in the order of preference, the first codec of a content type is its default
+var DefaultCodecs = []codec.Codec{codec.JSONIter, codec.JSON, codec.VTProto, codec.Proto}
+
+// DefaultEncodings follow HTTP tokens: "deflate" is zlib-wrapped DEFLATE
+var DefaultEncodings = []Encoding{
+	{Name: "gzip", Compressor: compress.Gzip.Level(flate.DefaultCompression)},
+	{Name: "deflate", Compressor: compress.Zlib.Level(flate.DefaultCompression)},
+	{Name: Identity, Compressor: compress.None},
+}
+
+type acceptItem struct {
+	value  string
+	params map[string]string
+	q      float64
+}
+
+// specificity ranks "type/subtype" over "type/*" over "*/*"
+func (a acceptItem) specificity() int {
+	switch {
+	case a.value == "*/*" || a.value == "*":
+		return 0
+	case strings.HasSuffix(a.value, "/*"):
+		return 1
+	default:
+		return 2
+	}
+}
+
+// parseAccept parses Accept or Accept-Encoding header, ordering items by quality and specificity
+func parseAccept(header string) []acceptItem {
+	var result []acceptItem
+	for _, part := range strings.Split(header, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		value, params, err := mime.ParseMediaType(part)
+		if err != nil {
+			// Accept-Encoding tokens aren't media types, fall back to a plain split
+			value, params = parseToken(part)
+		}
+		item := acceptItem{value: strings.ToLower(value), params: params, q: 1}
+		if q, ok := params["q"]; ok {
+			if parsed, err := strconv.ParseFloat(q, 64); err == nil {
+				item.q = parsed
+			}
+			delete(params, "q")
+		}
+		result = append(result, item)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].q != result[j].q {
+			return result[i].q > result[j].q
+		}
+		return result[i].specificity() > result[j].specificity()
+	})
+	return result
+}
+
+func parseToken(part string) (string, map[string]string) {
+	params := map[string]string{}
+	fields := strings.Split(part, ";")
+	for _, field := range fields[1:] {
+		if key, value, ok := strings.Cut(strings.TrimSpace(field), "="); ok {
+			params[strings.ToLower(key)] = value
+		}
+	}
+	return strings.TrimSpace(fields[0]), params
+}
+
+func matchMediaType(pattern, contentType string) bool {
+	if pattern == "*/*" || pattern == contentType {
+		return true
+	}
+	return strings.HasSuffix(pattern, "/*") && strings.HasPrefix(contentType, strings.TrimSuffix(pattern, "*"))
+}
+
+func matchCodec(item acceptItem, c codec.Codec) bool {
+	name := item.params[CodecParam]
+	return matchMediaType(item.value, c.ContentType()) && (name == "" || name == c.Name())
+}
+
+// excludedCodec reports whether the most specific item matching c has q=0, which overrides wildcards (RFC 9110 §12.5.1)
+func excludedCodec(items []acceptItem, c codec.Codec) bool {
+	rank := func(item acceptItem) int {
+		if item.params[CodecParam] != "" {
+			return item.specificity()*2 + 1
+		}
+		return item.specificity() * 2
+	}
+	var best *acceptItem
+	for i, item := range items {
+		if matchCodec(item, c) && (best == nil || rank(item) > rank(*best)) {
+			best = &items[i]
+		}
+	}
+	return best != nil && best.q <= 0
+}
+
+// NegotiateCodec picks codec by Accept header, an empty header accepts the first codec
+func NegotiateCodec(accept string, codecs []codec.Codec) (codec.Codec, bool) {
+	if len(codecs) == 0 {
+		return nil, false
+	}
+	if strings.TrimSpace(accept) == "" {
+		return codecs[0], true
+	}
+	items := parseAccept(accept)
+	for _, item := range items {
+		if item.q <= 0 {
+			continue
+		}
+		for _, c := range codecs {
+			if matchCodec(item, c) && !excludedCodec(items, c) {
+				return c, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// NegotiateEncoding picks encoding by Accept-Encoding header. Identity is acceptable unless excluded explicitly
+func NegotiateEncoding(acceptEncoding string, encodings []Encoding) (Encoding, bool) {
+	items := parseAccept(acceptEncoding)
+	excluded := map[string]bool{}
+	for _, item := range items {
+		if item.q <= 0 {
+			excluded[item.value] = true
+		}
+	}
+
+	for _, item := range items {
+		if item.q <= 0 {
+			continue
+		}
+		for _, encoding := range encodings {
+			if item.value == encoding.Name || item.value == "*" && !excluded[encoding.Name] {
+				return encoding, true
+			}
+		}
+	}
+
+	if excluded[Identity] || excluded["*"] && !hasItem(items, Identity) {
+		return Encoding{}, false
+	}
+	return Encoding{Name: Identity, Compressor: compress.None}, true
+}
+
+func hasItem(items []acceptItem, value string) bool {
+	for _, item := range items {
+		if item.value == value {
+			return true
+		}
+	}
+	return false
+}
+
+// contentType is the codec content type, qualified with the codec name when it isn't the default one
+func contentType(c codec.Codec, codecs []codec.Codec) string {
+	for _, other := range codecs {
+		if other.ContentType() == c.ContentType() {
+			if other.Name() == c.Name() {
+				return c.ContentType()
+			}
+			break
+		}
+	}
+	return mime.FormatMediaType(c.ContentType(), map[string]string{CodecParam: c.Name()})
+}
@@ -0,0 +1,70 @@
+package httpapi
+
+import (
+	"github.com/stretchr/testify/require"
+	"go-playground/protobuf/codec"
+	"testing"
+)
+
+func TestNegotiateCodec(t *testing.T) {
+	for accept, expected := range map[string]string{
+		"":                                   "jsoniter",
+		"*/*":                                "jsoniter",
+		"application/json":                   "jsoniter",
+		"application/json; codec=json":       "json",
+		"application/x-protobuf":             "vtproto",
+		"application/x-protobuf;codec=proto": "proto",
+		"application/*":                      "jsoniter",
+		"text/html, application/x-protobuf":  "vtproto",
+		"application/json;q=0.5, application/x-protobuf":    "vtproto",
+		"application/json, application/x-protobuf;q=0.5":    "jsoniter",
+		"*/*;q=0.1, application/x-protobuf;q=0.2":           "vtproto",
+		"application/x-protobuf;q=0, */*":                   "jsoniter",
+		"*/*, application/json;q=0":                         "vtproto",
+		"application/*, application/json;q=0":               "vtproto",
+		"*/*, application/json;codec=jsoniter;q=0":          "json",
+		"application/json;q=0, application/json;codec=json": "json",
+	} {
+		c, ok := NegotiateCodec(accept, DefaultCodecs)
+		require.True(t, ok, accept)
+		require.Equal(t, expected, c.Name(), accept)
+	}
+
+	for _, accept := range []string{"text/html", "application/json;q=0", "application/json; codec=easyjson", "*/*, application/*;q=0"} {
+		_, ok := NegotiateCodec(accept, DefaultCodecs)
+		require.False(t, ok, accept)
+	}
+	_, ok := NegotiateCodec("", nil)
+	require.False(t, ok)
+}
+
+func TestNegotiateEncoding(t *testing.T) {
+	for acceptEncoding, expected := range map[string]string{
+		"":                               Identity,
+		"gzip":                           "gzip",
+		"deflate, gzip":                  "deflate",
+		"gzip;q=0.5, deflate":            "deflate",
+		"br":                             Identity,
+		"*":                              "gzip",
+		"gzip;q=0, *":                    "deflate",
+		"identity;q=1, gzip;q=0.5":       Identity,
+		"br, gzip;q=0.8, identity;q=0.1": "gzip",
+		"*;q=0, identity":                Identity,
+	} {
+		encoding, ok := NegotiateEncoding(acceptEncoding, DefaultEncodings)
+		require.True(t, ok, acceptEncoding)
+		require.Equal(t, expected, encoding.Name, acceptEncoding)
+	}
+
+	for _, acceptEncoding := range []string{"identity;q=0", "br, *;q=0"} {
+		_, ok := NegotiateEncoding(acceptEncoding, DefaultEncodings)
+		require.False(t, ok, acceptEncoding)
+	}
+}
+
+func TestContentType(t *testing.T) {
+	require.Equal(t, "application/json", contentType(codec.JSONIter, DefaultCodecs))
+	require.Equal(t, "application/json; codec=json", contentType(codec.JSON, DefaultCodecs))
+	require.Equal(t, "application/x-protobuf", contentType(codec.VTProto, DefaultCodecs))
+	require.Equal(t, "application/x-protobuf; codec=proto", contentType(codec.Proto, DefaultCodecs))
+}
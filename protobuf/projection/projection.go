@@ -0,0 +1,285 @@
+// Package projection strips subtrees of proto messages by field path before marshaling
+package projection
+
+import (
+	"github.com/pkg/errors"
+	"go-playground/protobuf/compress"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"strings"
+)
+
+var ErrUnknownPath = errors.New("unknown field path")
+
+// Profile drops and keeps subtrees by dot-separated field paths, the same ones protosize reports:
+// map values are under "value", e.g. "chunks.places.airports.value.name".
+// The longest matching path wins, so Keep brings back parts of a dropped subtree
+type Profile struct {
+	Name string
+	Drop []string
+	Keep []string
+}
+
+var (
+	Debug = Profile{Name: "debug"}
+	// Public strips diagnostics of the search and of proposal merging
+	Public = Profile{
+		Name: "public",
+		Drop: []string{
+			"chunks.debug_info",
+			"chunks.tickets.proposals.flight_terms.value.merged_terms_info",
+			"chunks.tickets.proposals.flight_terms.value.merged_from_other_proposals",
+		},
+	}
+	// MobileLite strips boundaries of all filters but price on top of Public
+	MobileLite = Profile{
+		Name: "mobile-lite",
+		Drop: append([]string{
+			"chunks.filter_boundaries",
+			"chunks.degraded_filter_boundaries",
+		}, Public.Drop...),
+		Keep: []string{
+			"chunks.filter_boundaries.price",
+		},
+	}
+)
+
+// Rebase returns the profile for the message found at prefix of the original root, e.g. Public.Rebase("chunks")
+// for a single Chunk. Paths under prefix lose it, the other paths are left out
+func (p Profile) Rebase(prefix string) Profile {
+	rebase := func(paths []string) []string {
+		var result []string
+		for _, path := range paths {
+			if rest := strings.TrimPrefix(path, prefix+"."); rest != path {
+				result = append(result, rest)
+			}
+		}
+		return result
+	}
+	return Profile{Name: p.Name, Drop: rebase(p.Drop), Keep: rebase(p.Keep)}
+}
+
+var registry []Profile
+
+func init() {
+	Register(Debug)
+	Register(Public)
+	Register(MobileLite)
+}
+
+// Register adds profile to the registry, replacing the one with the same name
+func Register(profile Profile) {
+	for i, registered := range registry {
+		if registered.Name == profile.Name {
+			registry[i] = profile
+			return
+		}
+	}
+	registry = append(registry, profile)
+}
+
+// Get returns registered profile by its name
+func Get(name string) (Profile, bool) {
+	for _, profile := range registry {
+		if profile.Name == name {
+			return profile, true
+		}
+	}
+	return Profile{}, false
+}
+
+// All returns registered profiles in the order of registration
+func All() []Profile {
+	result := make([]Profile, len(registry))
+	copy(result, registry)
+	return result
+}
+
+type action byte
+
+const (
+	inherit action = iota
+	drop
+	keep
+)
+
+// rule is a trie of field paths
+type rule struct {
+	action   action
+	children map[string]*rule
+}
+
+func (p Profile) compile(descriptor protoreflect.MessageDescriptor) (*rule, error) {
+	root := &rule{}
+	add := func(path string, a action) error {
+		node := root
+		fields := descriptor
+		for _, name := range strings.Split(path, ".") {
+			if fields == nil {
+				return errors.Wrapf(ErrUnknownPath, "%s: %q", p.Name, path)
+			}
+			fd := fields.Fields().ByName(protoreflect.Name(name))
+			if fd == nil {
+				return errors.Wrapf(ErrUnknownPath, "%s: %q", p.Name, path)
+			}
+			fields = fieldMessage(fd)
+			if node.children == nil {
+				node.children = map[string]*rule{}
+			}
+			child, ok := node.children[name]
+			if !ok {
+				child = &rule{}
+				node.children[name] = child
+			}
+			node = child
+		}
+		node.action = a
+		return nil
+	}
+	for _, path := range p.Drop {
+		if err := add(path, drop); err != nil {
+			return nil, err
+		}
+	}
+	for _, path := range p.Keep {
+		if err := add(path, keep); err != nil {
+			return nil, err
+		}
+	}
+	return root, nil
+}
+
+// fieldMessage is the message of the field, of a map field it is the map entry, so values are under "value"
+func fieldMessage(fd protoreflect.FieldDescriptor) protoreflect.MessageDescriptor {
+	if fd.Kind() != protoreflect.MessageKind && fd.Kind() != protoreflect.GroupKind {
+		return nil
+	}
+	return fd.Message()
+}
+
+// Apply strips message in place, clone it with proto.Clone to keep the original
+func (p Profile) Apply(message proto.Message) error {
+	root, err := p.compile(message.ProtoReflect().Descriptor())
+	if err != nil {
+		return err
+	}
+	apply(message.ProtoReflect(), root, false)
+	return nil
+}
+
+// Project returns stripped clone of message
+func (p Profile) Project(message proto.Message) (proto.Message, error) {
+	result := proto.Clone(message)
+	if err := p.Apply(result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// apply clears dropped fields of message and returns whether anything is left of it
+func apply(message protoreflect.Message, node *rule, dropping bool) bool {
+	var cleared []protoreflect.FieldDescriptor
+	message.Range(func(fd protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		child := node.children[string(fd.Name())]
+		childDropping := dropping
+		if child != nil && child.action != inherit {
+			childDropping = child.action == drop
+		}
+		if child == nil || len(child.children) == 0 || fieldMessage(fd) == nil {
+			if childDropping {
+				cleared = append(cleared, fd)
+			}
+			return true
+		}
+		if !applyValue(fd, value, child, childDropping) {
+			cleared = append(cleared, fd)
+		}
+		return true
+	})
+	for _, fd := range cleared {
+		message.Clear(fd)
+	}
+	return !dropping || hasFields(message)
+}
+
+// applyValue goes down into a message, list of messages or map entries, dropping empty elements of a dropped subtree
+func applyValue(fd protoreflect.FieldDescriptor, value protoreflect.Value, node *rule, dropping bool) bool {
+	switch {
+	case fd.IsMap():
+		valueNode := node.children["value"]
+		valueDropping := dropping
+		if valueNode != nil && valueNode.action != inherit {
+			valueDropping = valueNode.action == drop
+		}
+		m := value.Map()
+		var removed []protoreflect.MapKey
+		m.Range(func(key protoreflect.MapKey, v protoreflect.Value) bool {
+			left := !valueDropping
+			if valueNode != nil && len(valueNode.children) > 0 && fd.MapValue().Message() != nil {
+				left = apply(v.Message(), valueNode, valueDropping)
+			}
+			if !left {
+				removed = append(removed, key)
+			}
+			return true
+		})
+		for _, key := range removed {
+			m.Clear(key)
+		}
+		return m.Len() > 0
+	case fd.IsList():
+		list := value.List()
+		n := 0
+		for i := 0; i < list.Len(); i++ {
+			if apply(list.Get(i).Message(), node, dropping) {
+				list.Set(n, list.Get(i))
+				n++
+			}
+		}
+		list.Truncate(n)
+		return n > 0
+	default:
+		return apply(value.Message(), node, dropping)
+	}
+}
+
+func hasFields(message protoreflect.Message) bool {
+	result := false
+	message.Range(func(protoreflect.FieldDescriptor, protoreflect.Value) bool {
+		result = true
+		return false
+	})
+	return result
+}
+
+// Saving is the size of a message projected by the profile
+type Saving struct {
+	Profile    string
+	Bytes      int64
+	Compressed int64
+}
+
+// Savings projects message by every profile and measures it, compressed sizes are skipped when compressor is nil
+func Savings(message proto.Message, compressor compress.Compressor, profiles ...Profile) ([]Saving, error) {
+	result := make([]Saving, 0, len(profiles))
+	for _, profile := range profiles {
+		projected, err := profile.Project(message)
+		if err != nil {
+			return nil, err
+		}
+		data, err := proto.MarshalOptions{Deterministic: true}.Marshal(projected)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		saving := Saving{Profile: profile.Name, Bytes: int64(len(data))}
+		if compressor != nil {
+			compressed, err := compressor.Compress(data)
+			if err != nil {
+				return nil, err
+			}
+			saving.Compressed = int64(len(compressed))
+		}
+		result = append(result, saving)
+	}
+	return result, nil
+}
@@ -0,0 +1,128 @@
+package projection
+
+import (
+	"compress/flate"
+	"github.com/stretchr/testify/require"
+	"go-playground/protobuf/compress"
+	search_v3 "go-playground/protobuf/search-v3"
+	"go-playground/protobuf/utils"
+	"google.golang.org/protobuf/proto"
+	"testing"
+)
+
+var results = search_v3.ToProto(search_v3.GenerateResults(search_v3.DefaultGeneratorConfig()))
+
+func project(t *testing.T, profile Profile) *search_v3.SearchResults {
+	projected, err := profile.Project(results)
+	require.NoError(t, err)
+	return projected.(*search_v3.SearchResults)
+}
+
+func TestDebug(t *testing.T) {
+	require.True(t, proto.Equal(results, project(t, Debug)))
+}
+
+func TestPublic(t *testing.T) {
+	projected := project(t, Public)
+	require.Len(t, projected.Chunks, len(results.Chunks))
+	for i, chunk := range projected.Chunks {
+		require.Nil(t, chunk.DebugInfo)
+		require.NotNil(t, results.Chunks[i].DebugInfo, "original is left intact")
+		require.True(t, proto.Equal(results.Chunks[i].FilterBoundaries, chunk.FilterBoundaries))
+		require.Len(t, chunk.Tickets, len(results.Chunks[i].Tickets))
+		for _, ticket := range chunk.Tickets {
+			for _, proposal := range ticket.Proposals {
+				for _, term := range proposal.FlightTerms {
+					require.Nil(t, term.MergedTermsInfo)
+					require.Empty(t, term.MergedFromOtherProposals)
+					require.NotEmpty(t, term.FareCode)
+				}
+			}
+		}
+	}
+}
+
+func TestMobileLite(t *testing.T) {
+	projected := project(t, MobileLite)
+	for i, chunk := range projected.Chunks {
+		require.Nil(t, chunk.DebugInfo)
+		require.Nil(t, chunk.DegradedFilterBoundaries)
+		if results.Chunks[i].FilterBoundaries == nil {
+			require.Nil(t, chunk.FilterBoundaries)
+			continue
+		}
+		expected := &search_v3.Boundaries{Price: results.Chunks[i].FilterBoundaries.Price}
+		require.True(t, proto.Equal(expected, chunk.FilterBoundaries))
+	}
+}
+
+func TestKeepInsideDroppedMap(t *testing.T) {
+	projected := project(t, Profile{
+		Name: "codes",
+		Drop: []string{"chunks.places"},
+		Keep: []string{"chunks.places.airports.value.code"},
+	})
+	for i, chunk := range projected.Chunks {
+		require.Empty(t, chunk.Places.Cities)
+		require.Empty(t, chunk.Places.Countries)
+		require.Len(t, chunk.Places.Airports, len(results.Chunks[i].Places.Airports))
+		for code, airport := range chunk.Places.Airports {
+			require.True(t, proto.Equal(&search_v3.AirportInfo{Code: results.Chunks[i].Places.Airports[code].Code}, airport))
+		}
+	}
+}
+
+func TestUnknownPath(t *testing.T) {
+	for _, path := range []string{"chunks.debug", "chunks.chunk_id.value", "chunks.places.airports.name"} {
+		err := Profile{Name: "broken", Drop: []string{path}}.Apply(proto.Clone(results))
+		require.ErrorIs(t, err, ErrUnknownPath, path)
+	}
+}
+
+func TestSavings(t *testing.T) {
+	savings, err := Savings(results, compress.Gzip.Level(flate.DefaultCompression), All()...)
+	require.NoError(t, err)
+	require.Len(t, savings, 3)
+	require.Equal(t, []string{"debug", "public", "mobile-lite"}, []string{savings[0].Profile, savings[1].Profile, savings[2].Profile})
+	require.Equal(t, int64(proto.Size(results)), savings[0].Bytes)
+	for i := 1; i < len(savings); i++ {
+		require.Less(t, savings[i].Bytes, savings[i-1].Bytes, savings[i].Profile)
+		require.Less(t, savings[i].Compressed, savings[i-1].Compressed, savings[i].Profile)
+	}
+}
+
+func TestRebase(t *testing.T) {
+	rebased := MobileLite.Rebase("chunks")
+	require.Equal(t, MobileLite.Name, rebased.Name)
+	require.Contains(t, rebased.Drop, "debug_info")
+	require.Equal(t, []string{"filter_boundaries.price"}, rebased.Keep)
+	require.Empty(t, Public.Rebase("chunks.debug_info").Drop)
+
+	projected, err := Public.Rebase("chunks").Project(results.Chunks[0])
+	require.NoError(t, err)
+	require.Nil(t, projected.(*search_v3.Chunk).DebugInfo)
+	require.NotNil(t, results.Chunks[0].DebugInfo, "original is left intact")
+
+	_, err = Public.Project(results.Chunks[0])
+	require.ErrorIs(t, err, ErrUnknownPath, "paths start at the results")
+}
+
+func TestGet(t *testing.T) {
+	profile, ok := Get("mobile-lite")
+	require.True(t, ok)
+	require.Equal(t, MobileLite.Name, profile.Name)
+	_, ok = Get("unknown")
+	require.False(t, ok)
+}
+
+func BenchmarkProject(b *testing.B) {
+	for _, profile := range All() {
+		b.Run(profile.Name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				utils.Must2(profile.Project(results))
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+// Package protosize attributes encoded bytes of a proto message to its field paths
+package protosize
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/pkg/errors"
+	"go-playground/protobuf/compress"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"io"
+	"sort"
+	"strings"
+)
+
+// Node is a field path of the message. Repeated fields and map entries are merged into a single node,
+// so Count is the number of occurrences and Bytes is their total size including tags and length prefixes.
+//
+// Compressed is the size of all occurrences of the field concatenated and compressed on their own.
+// It shows how well the field compresses, but unlike Bytes it doesn't sum up to the parent:
+// the compressor finds matches between different fields of the whole message too
+type Node struct {
+	Name       string  `json:"name"`
+	Path       string  `json:"path"`
+	Count      int     `json:"count"`
+	Bytes      int64   `json:"bytes"`
+	Compressed int64   `json:"compressed"`
+	Children   []*Node `json:"children,omitempty"`
+
+	data     []byte
+	children map[string]*Node
+}
+
+func (n *Node) child(name string) *Node {
+	if result, ok := n.children[name]; ok {
+		return result
+	}
+	path := name
+	if n.Path != "" {
+		path = n.Path + "." + name
+	}
+	result := &Node{Name: name, Path: path}
+	if n.children == nil {
+		n.children = map[string]*Node{}
+	}
+	n.children[name] = result
+	n.Children = append(n.Children, result)
+	return result
+}
+
+// Find returns the node of the dot-separated path, e.g. "chunks.places.value.name"
+func (n *Node) Find(path string) (*Node, bool) {
+	result := n
+	for _, name := range strings.Split(path, ".") {
+		var ok bool
+		if result, ok = result.children[name]; !ok {
+			return nil, false
+		}
+	}
+	return result, true
+}
+
+// Analyze marshals message deterministically and attributes its bytes to field paths.
+// Compressed sizes are computed when compressor is not nil
+func Analyze(message proto.Message, compressor compress.Compressor) (*Node, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(message)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	descriptor := message.ProtoReflect().Descriptor()
+	root := &Node{Name: string(descriptor.Name()), Count: 1, Bytes: int64(len(data)), data: data}
+	if err := walk(root, descriptor, data); err != nil {
+		return nil, err
+	}
+	if err := root.finish(compressor); err != nil {
+		return nil, err
+	}
+	return root, nil
+}
+
+// walk attributes every field of the encoded message to a child of node, going down into nested messages
+func walk(node *Node, descriptor protoreflect.MessageDescriptor, data []byte) error {
+	for len(data) > 0 {
+		number, wireType, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return errors.Wrapf(protowire.ParseError(n), "%s", node.Path)
+		}
+		m := protowire.ConsumeFieldValue(number, wireType, data[n:])
+		if m < 0 {
+			return errors.Wrapf(protowire.ParseError(m), "%s", node.Path)
+		}
+		field := data[:n+m]
+		value := data[n : n+m]
+		data = data[n+m:]
+
+		fd := descriptor.Fields().ByNumber(number)
+		name := fmt.Sprintf("?%d", number)
+		if fd != nil {
+			name = string(fd.Name())
+		}
+		child := node.child(name)
+		child.Count++
+		child.Bytes += int64(len(field))
+		child.data = append(child.data, field...)
+
+		if fd == nil || wireType != protowire.BytesType || fd.Kind() != protoreflect.MessageKind {
+			continue
+		}
+		payload, _ := protowire.ConsumeBytes(value)
+		if err := walk(child, fd.Message(), payload); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// finish compresses collected bytes and orders children by size, the largest first
+func (n *Node) finish(compressor compress.Compressor) error {
+	if compressor != nil {
+		compressed, err := compressor.Compress(n.data)
+		if err != nil {
+			return err
+		}
+		n.Compressed = int64(len(compressed))
+	}
+	n.data = nil
+	sort.SliceStable(n.Children, func(i, j int) bool {
+		return n.Children[i].Bytes > n.Children[j].Bytes
+	})
+	for _, child := range n.Children {
+		if err := child.finish(compressor); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteReport writes the tree down to maxDepth levels below the root, zero means unlimited.
+// Children smaller than minShare of the root are skipped
+func (n *Node) WriteReport(w io.Writer, maxDepth int, minShare float64) error {
+	if _, err := fmt.Fprintf(w, "%-60s %6s %10s %7s %10s %6s\n", "path", "count", "bytes", "share", "compressed", "ratio"); err != nil {
+		return errors.WithStack(err)
+	}
+	return n.writeReport(w, n.Bytes, 0, maxDepth, minShare)
+}
+
+func (n *Node) writeReport(w io.Writer, total int64, depth, maxDepth int, minShare float64) error {
+	ratio := "-"
+	if n.Compressed > 0 {
+		ratio = fmt.Sprintf("%.2f", float64(n.Bytes)/float64(n.Compressed))
+	}
+	_, err := fmt.Fprintf(w, "%-60s %6d %10d %6.2f%% %10d %6s\n",
+		strings.Repeat("  ", depth)+n.Name, n.Count, n.Bytes, 100*float64(n.Bytes)/float64(total), n.Compressed, ratio)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if maxDepth > 0 && depth >= maxDepth {
+		return nil
+	}
+	for _, child := range n.Children {
+		if float64(child.Bytes) < minShare*float64(total) {
+			continue
+		}
+		if err := child.writeReport(w, total, depth+1, maxDepth, minShare); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteJSON writes the whole tree as indented JSON
+func (n *Node) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return errors.WithStack(encoder.Encode(n))
+}
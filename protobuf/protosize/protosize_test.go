@@ -0,0 +1,101 @@
+package protosize
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/json"
+	"github.com/stretchr/testify/require"
+	"go-playground/protobuf/compress"
+	search_v3 "go-playground/protobuf/search-v3"
+	"go-playground/protobuf/utils"
+	"google.golang.org/protobuf/proto"
+	"testing"
+)
+
+var results = search_v3.ToProto(search_v3.GenerateResults(search_v3.DefaultGeneratorConfig()))
+
+func TestAnalyze(t *testing.T) {
+	root, err := Analyze(results, compress.Gzip.Level(flate.DefaultCompression))
+	require.NoError(t, err)
+	require.Equal(t, int64(proto.Size(results)), root.Bytes)
+	require.Positive(t, root.Compressed)
+	require.Less(t, root.Compressed, root.Bytes)
+
+	chunks, ok := root.Find("chunks")
+	require.True(t, ok)
+	require.Equal(t, len(results.Chunks), chunks.Count)
+	require.Equal(t, root.Bytes, chunks.Bytes)
+
+	for _, path := range []string{
+		"chunks.debug_info",
+		"chunks.places.airports.value.name",
+		"chunks.filter_boundaries",
+		"chunks.tickets.proposals.flight_terms",
+	} {
+		node, ok := root.Find(path)
+		require.True(t, ok, path)
+		require.Equal(t, path, node.Path)
+		require.Positive(t, node.Bytes, path)
+		require.Positive(t, node.Compressed, path)
+	}
+
+	tickets, _ := root.Find("chunks.tickets")
+	count := 0
+	for _, chunk := range results.Chunks {
+		count += len(chunk.Tickets)
+	}
+	require.Equal(t, count, tickets.Count)
+	requireSorted(t, root)
+}
+
+// requireSorted checks children go from the largest and fields of a message take no more bytes than the message
+func requireSorted(t *testing.T, node *Node) {
+	var total int64
+	for i, child := range node.Children {
+		if i > 0 {
+			require.LessOrEqual(t, child.Bytes, node.Children[i-1].Bytes, child.Path)
+		}
+		total += child.Bytes
+		requireSorted(t, child)
+	}
+	require.LessOrEqual(t, total, node.Bytes, node.Path)
+}
+
+func TestAnalyzeUncompressed(t *testing.T) {
+	root, err := Analyze(results.Chunks[0], nil)
+	require.NoError(t, err)
+	require.Equal(t, "Chunk", root.Name)
+	require.Zero(t, root.Compressed)
+
+	meta, ok := root.Find("meta")
+	require.True(t, ok)
+	require.Equal(t, "meta", meta.Path)
+}
+
+func TestWrite(t *testing.T) {
+	root := utils.Must2(Analyze(results, nil))
+
+	var report bytes.Buffer
+	require.NoError(t, root.WriteReport(&report, 2, 0))
+	require.Contains(t, report.String(), "\n  chunks ")
+	require.Contains(t, report.String(), "\n    tickets ")
+	require.NotContains(t, report.String(), "\n      proposals ")
+
+	var encoded bytes.Buffer
+	require.NoError(t, root.WriteJSON(&encoded))
+	var decoded Node
+	require.NoError(t, json.Unmarshal(encoded.Bytes(), &decoded))
+	require.Equal(t, root.Bytes, decoded.Bytes)
+	require.Equal(t, "chunks", decoded.Children[0].Path)
+}
+
+func BenchmarkAnalyze(b *testing.B) {
+	compressor := compress.Gzip.Level(flate.DefaultCompression)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := Analyze(results, compressor); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,349 @@
+package search_v3
+
+import (
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ChunkDelta of chunk_delta.proto turns one version of a chunk into the next one, so polling clients download only
+// the changes. Tickets are matched by Signature and proposals of a ticket by Id, the other fields are replaced when they differ
+
+var (
+	ErrDeltaChunk = errors.New("delta doesn't match the chunk")
+	ErrDeltaBase  = errors.New("delta is made against another version of the chunk")
+)
+
+const (
+	chunkTicketsField    = 4
+	ticketProposalsField = 2
+)
+
+// Diff returns the delta turning a into b, both are versions of the same chunk
+func Diff(a, b *Chunk) (*ChunkDelta, error) {
+	if a.GetChunkId() != b.GetChunkId() {
+		return nil, errors.Wrapf(ErrDeltaChunk, "chunk %q, delta of %q", a.GetChunkId(), b.GetChunkId())
+	}
+	result := &ChunkDelta{
+		ChunkId:       b.GetChunkId(),
+		BaseTimestamp: a.GetLastUpdateTimestamp(),
+		Fields:        &Chunk{},
+	}
+	result.Patched = diffFields(a, b, result.Fields, chunkTicketsField)
+
+	diff, ok := diffList(a.GetTickets(), b.GetTickets(), (*Ticket).GetSignature, func(a, b *Ticket) (bool, *TicketDelta) {
+		delta := diffTicket(a, b)
+		return delta == nil, delta
+	})
+	if !ok {
+		// Signatures aren't unique, the tickets are replaced in full
+		if !equalField(a.ProtoReflect(), b.ProtoReflect(), a.ProtoReflect().Descriptor().Fields().ByNumber(chunkTicketsField)) {
+			result.Fields.Tickets = b.GetTickets()
+			result.Patched = append(result.Patched, chunkTicketsField)
+		}
+		return result, nil
+	}
+	result.RemovedTickets = diff.removed
+	result.TicketOrder = diff.order
+	for _, ticket := range diff.upserted {
+		if diff.changed[ticket.GetSignature()] == nil {
+			result.AddedTickets = append(result.AddedTickets, ticket)
+		}
+	}
+	for _, ticket := range b.GetTickets() {
+		if delta := diff.changed[ticket.GetSignature()]; delta != nil {
+			result.ChangedTickets = append(result.ChangedTickets, delta)
+		}
+	}
+	return result, nil
+}
+
+// diffTicket returns nil when the tickets are equal
+func diffTicket(a, b *Ticket) *TicketDelta {
+	result := &TicketDelta{Signature: b.GetSignature(), Fields: &Ticket{}}
+	result.Patched = diffFields(a, b, result.Fields, ticketProposalsField)
+
+	diff, ok := diffList(a.GetProposals(), b.GetProposals(), (*Proposal).GetId, func(a, b *Proposal) (bool, *Proposal) {
+		return proto.Equal(a, b), b
+	})
+	if !ok {
+		if !equalField(a.ProtoReflect(), b.ProtoReflect(), a.ProtoReflect().Descriptor().Fields().ByNumber(ticketProposalsField)) {
+			result.Fields.Proposals = b.GetProposals()
+			result.Patched = append(result.Patched, ticketProposalsField)
+		}
+	} else {
+		result.RemovedProposals = diff.removed
+		result.UpsertedProposals = diff.upserted
+		result.ProposalOrder = diff.order
+	}
+
+	if len(result.Patched) == 0 && len(result.RemovedProposals) == 0 && len(result.UpsertedProposals) == 0 && result.ProposalOrder == nil {
+		return nil
+	}
+	return result
+}
+
+// diffFields copies fields of b differing from a into fields and returns their numbers, skipping the one diffed by key
+func diffFields(a, b, fields proto.Message, skip protoreflect.FieldNumber) []int32 {
+	left, right, target := a.ProtoReflect(), b.ProtoReflect(), fields.ProtoReflect()
+	var result []int32
+	descriptors := right.Descriptor().Fields()
+	for i := 0; i < descriptors.Len(); i++ {
+		fd := descriptors.Get(i)
+		if fd.Number() == skip || equalField(left, right, fd) {
+			continue
+		}
+		if right.Has(fd) {
+			target.Set(fd, right.Get(fd))
+		}
+		result = append(result, int32(fd.Number()))
+	}
+	return result
+}
+
+// equalField compares a single field with proto.Equal semantics
+func equalField(a, b protoreflect.Message, fd protoreflect.FieldDescriptor) bool {
+	if a.Has(fd) != b.Has(fd) {
+		return false
+	}
+	if !a.Has(fd) {
+		return true
+	}
+	left, right := a.New(), b.New()
+	left.Set(fd, a.Get(fd))
+	right.Set(fd, b.Get(fd))
+	return proto.Equal(left.Interface(), right.Interface())
+}
+
+type listDiff[T any, D any] struct {
+	removed  []string
+	upserted []T
+	changed  map[string]D
+	order    []string
+}
+
+// diffList matches elements of the lists by key, it fails when the keys aren't unique.
+// equal reports whether a matched element is unchanged along with its change
+func diffList[T any, D any](a, b []T, key func(T) string, equal func(a, b T) (bool, D)) (listDiff[T, D], bool) {
+	result := listDiff[T, D]{changed: map[string]D{}}
+	previous, ok := indexByKey(a, key)
+	if !ok {
+		return result, false
+	}
+	current, ok := indexByKey(b, key)
+	if !ok {
+		return result, false
+	}
+
+	for _, element := range a {
+		if _, ok := current[key(element)]; !ok {
+			result.removed = append(result.removed, key(element))
+		}
+	}
+	var added []string
+	for _, element := range b {
+		k := key(element)
+		i, ok := previous[k]
+		if !ok {
+			added = append(added, k)
+			result.upserted = append(result.upserted, element)
+			continue
+		}
+		if same, change := equal(a[i], element); !same {
+			result.changed[k] = change
+			result.upserted = append(result.upserted, element)
+		}
+	}
+
+	// The default order is the previous one without the removed elements followed by the added ones
+	expected := make([]string, 0, len(b))
+	for _, element := range a {
+		if _, ok := current[key(element)]; ok {
+			expected = append(expected, key(element))
+		}
+	}
+	expected = append(expected, added...)
+	for i, element := range b {
+		if expected[i] != key(element) {
+			result.order = make([]string, len(b))
+			for j, element := range b {
+				result.order[j] = key(element)
+			}
+			break
+		}
+	}
+	return result, true
+}
+
+func indexByKey[T any](list []T, key func(T) string) (map[string]int, bool) {
+	result := make(map[string]int, len(list))
+	for i, element := range list {
+		k := key(element)
+		if _, ok := result[k]; ok {
+			return nil, false
+		}
+		result[k] = i
+	}
+	return result, true
+}
+
+// Apply returns the next version of base, base is left intact and shares unchanged values with the result
+func (d *ChunkDelta) Apply(base *Chunk) (*Chunk, error) {
+	if base.GetChunkId() != d.ChunkId {
+		return nil, errors.Wrapf(ErrDeltaChunk, "chunk %q, delta of %q", base.GetChunkId(), d.ChunkId)
+	}
+	if base.GetLastUpdateTimestamp() != d.BaseTimestamp {
+		return nil, errors.Wrapf(ErrDeltaBase, "chunk of %d, delta against %d", base.GetLastUpdateTimestamp(), d.BaseTimestamp)
+	}
+	result := shallowCopy(base)
+	if err := applyFields(result, d.Fields, d.Patched); err != nil {
+		return nil, err
+	}
+	if containsField(d.Patched, chunkTicketsField) {
+		return result, nil
+	}
+
+	changed := make(map[string]*TicketDelta, len(d.ChangedTickets))
+	for _, delta := range d.ChangedTickets {
+		changed[delta.Signature] = delta
+	}
+	var err error
+	result.Tickets, err = applyList(base.GetTickets(), d.RemovedTickets, d.AddedTickets, d.TicketOrder, (*Ticket).GetSignature,
+		func(ticket *Ticket) (*Ticket, error) {
+			delta, ok := changed[ticket.GetSignature()]
+			if !ok {
+				return ticket, nil
+			}
+			return delta.apply(ticket)
+		})
+	if err != nil {
+		return nil, errors.WithMessage(err, "tickets")
+	}
+	return result, nil
+}
+
+func (d *TicketDelta) apply(base *Ticket) (*Ticket, error) {
+	result := &Ticket{}
+	target := result.ProtoReflect()
+	base.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		target.Set(fd, value)
+		return true
+	})
+	if err := applyFields(result, d.Fields, d.Patched); err != nil {
+		return nil, err
+	}
+	if containsField(d.Patched, ticketProposalsField) {
+		return result, nil
+	}
+
+	var err error
+	result.Proposals, err = applyList(base.GetProposals(), d.RemovedProposals, d.UpsertedProposals, d.ProposalOrder, (*Proposal).GetId,
+		func(proposal *Proposal) (*Proposal, error) {
+			return proposal, nil
+		})
+	return result, errors.WithMessagef(err, "ticket %q proposals", d.Signature)
+}
+
+func applyFields(target, fields proto.Message, patched []int32) error {
+	message := target.ProtoReflect()
+	descriptors := message.Descriptor().Fields()
+	var values protoreflect.Message
+	if fields != nil {
+		values = fields.ProtoReflect()
+	}
+	for _, number := range patched {
+		fd := descriptors.ByNumber(protoreflect.FieldNumber(number))
+		if fd == nil {
+			return errors.Wrapf(ErrDeltaChunk, "unknown field %d of %s", number, message.Descriptor().Name())
+		}
+		if values != nil && values.Has(fd) {
+			message.Set(fd, values.Get(fd))
+		} else {
+			message.Clear(fd)
+		}
+	}
+	return nil
+}
+
+func containsField(patched []int32, number int32) bool {
+	for _, n := range patched {
+		if n == number {
+			return true
+		}
+	}
+	return false
+}
+
+// applyList removes elements of base, replaces the changed ones and appends the added ones, then reorders the list.
+// Upserted elements replace the elements of base with the same key, the others are added
+func applyList[T any](base []T, removed []string, upserted []T, order []string, key func(T) string, update func(T) (T, error)) ([]T, error) {
+	if len(removed) == 0 && len(upserted) == 0 && order == nil {
+		return mapList(base, update)
+	}
+	skip := make(map[string]bool, len(removed))
+	for _, k := range removed {
+		skip[k] = true
+	}
+	replaced := make(map[string]T, len(upserted))
+	for _, element := range upserted {
+		replaced[key(element)] = element
+	}
+
+	result := make([]T, 0, len(base)+len(upserted))
+	present := make(map[string]bool, len(base))
+	for _, element := range base {
+		k := key(element)
+		if skip[k] {
+			continue
+		}
+		present[k] = true
+		if replacement, ok := replaced[k]; ok {
+			result = append(result, replacement)
+			continue
+		}
+		updated, err := update(element)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, updated)
+	}
+	for _, element := range upserted {
+		if !present[key(element)] {
+			result = append(result, element)
+		}
+	}
+	if order == nil {
+		return result, nil
+	}
+
+	byKey := make(map[string]T, len(result))
+	for _, element := range result {
+		byKey[key(element)] = element
+	}
+	if len(order) != len(byKey) {
+		return nil, errors.Wrapf(ErrDeltaBase, "order of %d elements, got %d", len(order), len(byKey))
+	}
+	ordered := make([]T, len(order))
+	for i, k := range order {
+		element, ok := byKey[k]
+		if !ok {
+			return nil, errors.Wrapf(ErrDeltaBase, "unknown element %q", k)
+		}
+		ordered[i] = element
+	}
+	return ordered, nil
+}
+
+func mapList[T any](list []T, update func(T) (T, error)) ([]T, error) {
+	if list == nil {
+		return nil, nil
+	}
+	result := make([]T, len(list))
+	for i, element := range list {
+		var err error
+		if result[i], err = update(element); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,351 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.12
+// source: protobuf/search-v3/chunk_delta.proto
+
+package search_v3
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Turns one version of a chunk into the next one, see Diff
+type ChunkDelta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChunkId       string  `protobuf:"bytes,1,opt,name=chunk_id,json=chunkId,proto3" json:"chunk_id,omitempty"`
+	BaseTimestamp int64   `protobuf:"varint,2,opt,name=base_timestamp,json=baseTimestamp,proto3" json:"base_timestamp,omitempty"` // last_update_timestamp of the chunk the delta applies to
+	Fields        *Chunk  `protobuf:"bytes,3,opt,name=fields,proto3" json:"fields,omitempty"`                                     // new values of the patched fields but tickets, a patched field unset here is cleared
+	Patched       []int32 `protobuf:"varint,4,rep,packed,name=patched,proto3" json:"patched,omitempty"`
+	// Tickets by signature, a changed ticket is either in changed_tickets or added in full
+	RemovedTickets []string       `protobuf:"bytes,5,rep,name=removed_tickets,json=removedTickets,proto3" json:"removed_tickets,omitempty"`
+	AddedTickets   []*Ticket      `protobuf:"bytes,6,rep,name=added_tickets,json=addedTickets,proto3" json:"added_tickets,omitempty"`
+	ChangedTickets []*TicketDelta `protobuf:"bytes,7,rep,name=changed_tickets,json=changedTickets,proto3" json:"changed_tickets,omitempty"`
+	// Signatures of all the tickets, set only when the tickets are not in the order
+	// of the previous version with the added tickets in the end
+	TicketOrder []string `protobuf:"bytes,8,rep,name=ticket_order,json=ticketOrder,proto3" json:"ticket_order,omitempty"`
+}
+
+func (x *ChunkDelta) Reset() {
+	*x = ChunkDelta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_search_v3_chunk_delta_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChunkDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChunkDelta) ProtoMessage() {}
+
+func (x *ChunkDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_search_v3_chunk_delta_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChunkDelta.ProtoReflect.Descriptor instead.
+func (*ChunkDelta) Descriptor() ([]byte, []int) {
+	return file_protobuf_search_v3_chunk_delta_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ChunkDelta) GetChunkId() string {
+	if x != nil {
+		return x.ChunkId
+	}
+	return ""
+}
+
+func (x *ChunkDelta) GetBaseTimestamp() int64 {
+	if x != nil {
+		return x.BaseTimestamp
+	}
+	return 0
+}
+
+func (x *ChunkDelta) GetFields() *Chunk {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *ChunkDelta) GetPatched() []int32 {
+	if x != nil {
+		return x.Patched
+	}
+	return nil
+}
+
+func (x *ChunkDelta) GetRemovedTickets() []string {
+	if x != nil {
+		return x.RemovedTickets
+	}
+	return nil
+}
+
+func (x *ChunkDelta) GetAddedTickets() []*Ticket {
+	if x != nil {
+		return x.AddedTickets
+	}
+	return nil
+}
+
+func (x *ChunkDelta) GetChangedTickets() []*TicketDelta {
+	if x != nil {
+		return x.ChangedTickets
+	}
+	return nil
+}
+
+func (x *ChunkDelta) GetTicketOrder() []string {
+	if x != nil {
+		return x.TicketOrder
+	}
+	return nil
+}
+
+type TicketDelta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signature         string      `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	Fields            *Ticket     `protobuf:"bytes,2,opt,name=fields,proto3" json:"fields,omitempty"` // new values of the patched fields but proposals
+	Patched           []int32     `protobuf:"varint,3,rep,packed,name=patched,proto3" json:"patched,omitempty"`
+	RemovedProposals  []string    `protobuf:"bytes,4,rep,name=removed_proposals,json=removedProposals,proto3" json:"removed_proposals,omitempty"`
+	UpsertedProposals []*Proposal `protobuf:"bytes,5,rep,name=upserted_proposals,json=upsertedProposals,proto3" json:"upserted_proposals,omitempty"`
+	// Set only when proposals are not in the order of the previous version with the new ones in the end
+	ProposalOrder []string `protobuf:"bytes,6,rep,name=proposal_order,json=proposalOrder,proto3" json:"proposal_order,omitempty"`
+}
+
+func (x *TicketDelta) Reset() {
+	*x = TicketDelta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_search_v3_chunk_delta_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TicketDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TicketDelta) ProtoMessage() {}
+
+func (x *TicketDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_search_v3_chunk_delta_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TicketDelta.ProtoReflect.Descriptor instead.
+func (*TicketDelta) Descriptor() ([]byte, []int) {
+	return file_protobuf_search_v3_chunk_delta_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TicketDelta) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *TicketDelta) GetFields() *Ticket {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *TicketDelta) GetPatched() []int32 {
+	if x != nil {
+		return x.Patched
+	}
+	return nil
+}
+
+func (x *TicketDelta) GetRemovedProposals() []string {
+	if x != nil {
+		return x.RemovedProposals
+	}
+	return nil
+}
+
+func (x *TicketDelta) GetUpsertedProposals() []*Proposal {
+	if x != nil {
+		return x.UpsertedProposals
+	}
+	return nil
+}
+
+func (x *TicketDelta) GetProposalOrder() []string {
+	if x != nil {
+		return x.ProposalOrder
+	}
+	return nil
+}
+
+var File_protobuf_search_v3_chunk_delta_proto protoreflect.FileDescriptor
+
+var file_protobuf_search_v3_chunk_delta_proto_rawDesc = []byte{
+	0x0a, 0x24, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x2d, 0x76, 0x33, 0x2f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76,
+	0x33, 0x1a, 0x20, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x2d, 0x76, 0x33, 0x2f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xd7, 0x02, 0x0a, 0x0a, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x44, 0x65, 0x6c,
+	0x74, 0x61, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x49, 0x64, 0x12, 0x25, 0x0a,
+	0x0e, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x28, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33,
+	0x2e, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52,
+	0x07, 0x70, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x12, 0x36, 0x0a, 0x0d, 0x61, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x0c, 0x61, 0x64, 0x64,
+	0x65, 0x64, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x3f, 0x0a, 0x0f, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x52, 0x0e, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x64, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0b, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x88, 0x02,
+	0x0a, 0x0b, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x06,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x70, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64,
+	0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x12, 0x42, 0x0a,
+	0x12, 0x75, 0x70, 0x73, 0x65, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x11,
+	0x75, 0x70, 0x73, 0x65, 0x72, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x0e, 0x5a, 0x0c, 0x2e, 0x2e, 0x2f, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x2d, 0x76, 0x33, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_protobuf_search_v3_chunk_delta_proto_rawDescOnce sync.Once
+	file_protobuf_search_v3_chunk_delta_proto_rawDescData = file_protobuf_search_v3_chunk_delta_proto_rawDesc
+)
+
+func file_protobuf_search_v3_chunk_delta_proto_rawDescGZIP() []byte {
+	file_protobuf_search_v3_chunk_delta_proto_rawDescOnce.Do(func() {
+		file_protobuf_search_v3_chunk_delta_proto_rawDescData = protoimpl.X.CompressGZIP(file_protobuf_search_v3_chunk_delta_proto_rawDescData)
+	})
+	return file_protobuf_search_v3_chunk_delta_proto_rawDescData
+}
+
+var file_protobuf_search_v3_chunk_delta_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_protobuf_search_v3_chunk_delta_proto_goTypes = []interface{}{
+	(*ChunkDelta)(nil),  // 0: search_v3.ChunkDelta
+	(*TicketDelta)(nil), // 1: search_v3.TicketDelta
+	(*Chunk)(nil),       // 2: search_v3.Chunk
+	(*Ticket)(nil),      // 3: search_v3.Ticket
+	(*Proposal)(nil),    // 4: search_v3.Proposal
+}
+var file_protobuf_search_v3_chunk_delta_proto_depIdxs = []int32{
+	2, // 0: search_v3.ChunkDelta.fields:type_name -> search_v3.Chunk
+	3, // 1: search_v3.ChunkDelta.added_tickets:type_name -> search_v3.Ticket
+	1, // 2: search_v3.ChunkDelta.changed_tickets:type_name -> search_v3.TicketDelta
+	3, // 3: search_v3.TicketDelta.fields:type_name -> search_v3.Ticket
+	4, // 4: search_v3.TicketDelta.upserted_proposals:type_name -> search_v3.Proposal
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_protobuf_search_v3_chunk_delta_proto_init() }
+func file_protobuf_search_v3_chunk_delta_proto_init() {
+	if File_protobuf_search_v3_chunk_delta_proto != nil {
+		return
+	}
+	file_protobuf_search_v3_results_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_protobuf_search_v3_chunk_delta_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChunkDelta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_search_v3_chunk_delta_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TicketDelta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_protobuf_search_v3_chunk_delta_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_protobuf_search_v3_chunk_delta_proto_goTypes,
+		DependencyIndexes: file_protobuf_search_v3_chunk_delta_proto_depIdxs,
+		MessageInfos:      file_protobuf_search_v3_chunk_delta_proto_msgTypes,
+	}.Build()
+	File_protobuf_search_v3_chunk_delta_proto = out.File
+	file_protobuf_search_v3_chunk_delta_proto_rawDesc = nil
+	file_protobuf_search_v3_chunk_delta_proto_goTypes = nil
+	file_protobuf_search_v3_chunk_delta_proto_depIdxs = nil
+}
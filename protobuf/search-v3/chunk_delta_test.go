@@ -0,0 +1,140 @@
+package search_v3
+
+import (
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	"go-playground/protobuf/utils"
+	"testing"
+)
+
+// deltaBase is a chunk with unique proposal ids, the generator picks them at random
+func deltaBase() (*Chunk, *Chunk) {
+	results := resultsToProto(GenerateResults(generatorConfig))
+	for _, chunk := range results.Chunks {
+		for i, ticket := range chunk.Tickets {
+			for j, proposal := range ticket.Proposals {
+				proposal.Id = fmt.Sprintf("%s-%d-%d", chunk.ChunkId, i, j)
+			}
+		}
+	}
+	return results.Chunks[0], results.Chunks[1]
+}
+
+// requireDeltaRoundTrip checks apply(diff(a, b), a) == b with the delta passed through the wire, returning its size
+func requireDeltaRoundTrip(t *testing.T, a, b *Chunk) int {
+	original := proto.Clone(a)
+	delta, err := Diff(a, b)
+	require.NoError(t, err)
+	encoded, err := delta.MarshalVT()
+	require.NoError(t, err)
+
+	received := &ChunkDelta{}
+	require.NoError(t, received.UnmarshalVT(encoded))
+	applied, err := received.Apply(a)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(b, applied))
+	require.True(t, proto.Equal(original, a), "base is left intact")
+	return len(encoded)
+}
+
+func TestChunkDeltaUnchanged(t *testing.T) {
+	a, _ := deltaBase()
+	delta, err := Diff(a, proto.Clone(a).(*Chunk))
+	require.NoError(t, err)
+	require.Empty(t, delta.Patched)
+	require.Empty(t, delta.RemovedTickets)
+	require.Empty(t, delta.AddedTickets)
+	require.Empty(t, delta.ChangedTickets)
+	require.Nil(t, delta.TicketOrder)
+	require.Less(t, requireDeltaRoundTrip(t, a, proto.Clone(a).(*Chunk)), 20)
+}
+
+func TestChunkDeltaPoll(t *testing.T) {
+	a, other := deltaBase()
+	b := proto.Clone(a).(*Chunk)
+	b.LastUpdateTimestamp++
+	b.Meta.FilteredTicketsCount++
+	b.FilterBoundaries.Price.Max++
+	b.DegradedFilterBoundaries = nil
+
+	// Two tickets are gone, two are found, and one of the old ones is found cheaper elsewhere
+	b.Tickets = append(b.Tickets[2:], other.Tickets[0], other.Tickets[1])
+	changed := b.Tickets[0]
+	changed.Score++
+	changed.Proposals[0].Price.Value--
+	changed.Proposals = append(changed.Proposals[1:], changed.Proposals[0], proto.Clone(other.Tickets[2].Proposals[0]).(*Proposal))
+	b.Tickets[1], b.Tickets[2] = b.Tickets[2], b.Tickets[1]
+
+	delta, err := Diff(a, b)
+	require.NoError(t, err)
+	require.Equal(t, []string{a.Tickets[0].Signature, a.Tickets[1].Signature}, delta.RemovedTickets)
+	require.Len(t, delta.AddedTickets, 2)
+	require.Len(t, delta.ChangedTickets, 1)
+	require.Equal(t, changed.Signature, delta.ChangedTickets[0].Signature)
+	require.Equal(t, []int32{5}, delta.ChangedTickets[0].Patched)
+	require.Len(t, delta.ChangedTickets[0].UpsertedProposals, 2)
+	require.NotNil(t, delta.ChangedTickets[0].ProposalOrder)
+	require.NotNil(t, delta.TicketOrder)
+	require.ElementsMatch(t, []int32{2, 19, 20, 21}, delta.Patched)
+
+	size := requireDeltaRoundTrip(t, a, b)
+	require.Less(t, size*4, b.SizeVT())
+}
+
+func TestChunkDeltaDuplicateKeys(t *testing.T) {
+	a, _ := deltaBase()
+	a.Tickets[0].Proposals[1].Id = a.Tickets[0].Proposals[0].Id
+	b := proto.Clone(a).(*Chunk)
+	b.Tickets[0].Proposals[1].Weight++
+
+	delta, err := Diff(a, b)
+	require.NoError(t, err)
+	require.Equal(t, []int32{ticketProposalsField}, delta.ChangedTickets[0].Patched)
+	requireDeltaRoundTrip(t, a, b)
+
+	b.Tickets = append(b.Tickets, b.Tickets[1])
+	delta, err = Diff(a, b)
+	require.NoError(t, err)
+	require.Equal(t, []int32{chunkTicketsField}, delta.Patched)
+	requireDeltaRoundTrip(t, a, b)
+}
+
+func TestChunkDeltaEmptyChunks(t *testing.T) {
+	a := &Chunk{ChunkId: "chunk"}
+	b := &Chunk{ChunkId: "chunk", Tickets: []*Ticket{{Signature: "ticket"}}, Places: &Places{}}
+	requireDeltaRoundTrip(t, a, b)
+	requireDeltaRoundTrip(t, b, a)
+}
+
+func TestChunkDeltaErrors(t *testing.T) {
+	a, other := deltaBase()
+	_, err := Diff(a, other)
+	require.ErrorIs(t, err, ErrDeltaChunk)
+
+	b := proto.Clone(a).(*Chunk)
+	b.LastUpdateTimestamp++
+	delta := utils.Must2(Diff(a, b))
+	_, err = delta.Apply(b)
+	require.ErrorIs(t, err, ErrDeltaBase)
+	_, err = delta.Apply(other)
+	require.ErrorIs(t, err, ErrDeltaChunk)
+}
+
+func BenchmarkChunkDelta(b *testing.B) {
+	base, other := deltaBase()
+	next := proto.Clone(base).(*Chunk)
+	next.LastUpdateTimestamp++
+	next.Tickets = append(next.Tickets[1:], other.Tickets[0])
+	next.Tickets[0].Proposals[0].Price.Value--
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	var encoded []byte
+	for i := 0; i < b.N; i++ {
+		delta := utils.Must2(Diff(base, next))
+		encoded = utils.Must2(delta.MarshalVT())
+	}
+	b.ReportMetric(float64(len(encoded)), "delta-bytes")
+	b.ReportMetric(float64(next.SizeVT()), "chunk-bytes")
+}
@@ -0,0 +1,241 @@
+// Package client fetches search results from httpapi.Handler in any negotiated codec and compression
+package client
+
+import (
+	"compress/zlib"
+	"context"
+	"fmt"
+	v3 "github.com/KosyanMedia/delta/search/cmd/results-api/api/v3"
+	"github.com/pkg/errors"
+	"go-playground/protobuf/codec"
+	"go-playground/protobuf/httpapi"
+	search_v3 "go-playground/protobuf/search-v3"
+	"go-playground/protobuf/utils"
+	"io"
+	"mime"
+	"net/http"
+	"time"
+)
+
+var (
+	ErrStatus               = errors.New("unexpected response status")
+	ErrUnsupportedEncoding  = errors.New("unsupported content encoding")
+	ErrUnsupportedMediaType = errors.New("unsupported content type")
+)
+
+type Client struct {
+	HTTP *http.Client
+	URL  string
+	// Codec to request, codec.VTProto when nil. The response is decoded by its Content-Type whatever is requested
+	Codec codec.Codec
+	// Encoding is Accept-Encoding of requests, e.g. "gzip", "deflate" or "identity"
+	Encoding string
+	// Decompress bounds the decompressed body
+	Decompress utils.DecompressOptions
+}
+
+func New(url string) *Client {
+	return &Client{
+		HTTP:     http.DefaultClient,
+		URL:      url,
+		Codec:    codec.VTProto,
+		Encoding: "gzip",
+	}
+}
+
+// Timings of a single request. Decompression runs while the body is received,
+// so it is measured as time spent in the decompressor except waiting for the network
+type Timings struct {
+	Codec           string
+	ContentEncoding string
+	WireBytes       int64
+	BodyBytes       int64
+	Transfer        time.Duration
+	Decompress      time.Duration
+	Unmarshal       time.Duration
+	// Convert is the time of conversion between v3 and proto when the wire format differs from the requested type
+	Convert time.Duration
+}
+
+func (t Timings) String() string {
+	return fmt.Sprintf("%s/%s: %d bytes on wire, %d bytes of body, transfer %s, decompress %s, unmarshal %s, convert %s",
+		t.Codec, t.ContentEncoding, t.WireBytes, t.BodyBytes, t.Transfer, t.Decompress, t.Unmarshal, t.Convert)
+}
+
+// Proto fetches search results as proto message
+func (c *Client) Proto(ctx context.Context) (*search_v3.SearchResults, Timings, error) {
+	body, responseCodec, timings, err := c.fetch(ctx)
+	if err != nil {
+		return nil, timings, err
+	}
+
+	start := time.Now()
+	if responseCodec.ContentType() == codec.ContentTypeProtobuf {
+		result := &search_v3.SearchResults{}
+		err = responseCodec.Unmarshal(body, result)
+		timings.Unmarshal = time.Since(start)
+		return result, timings, errors.WithStack(err)
+	}
+
+	var data v3.SearchResults
+	err = responseCodec.Unmarshal(body, &data)
+	timings.Unmarshal = time.Since(start)
+	if err != nil {
+		return nil, timings, errors.WithStack(err)
+	}
+	start = time.Now()
+	result := search_v3.ToProto(data)
+	timings.Convert = time.Since(start)
+	return result, timings, nil
+}
+
+// Results fetches search results as v3 structs
+func (c *Client) Results(ctx context.Context) (v3.SearchResults, Timings, error) {
+	body, responseCodec, timings, err := c.fetch(ctx)
+	if err != nil {
+		return nil, timings, err
+	}
+
+	start := time.Now()
+	if responseCodec.ContentType() != codec.ContentTypeProtobuf {
+		var data v3.SearchResults
+		err = responseCodec.Unmarshal(body, &data)
+		timings.Unmarshal = time.Since(start)
+		return data, timings, errors.WithStack(err)
+	}
+
+	message := &search_v3.SearchResults{}
+	err = responseCodec.Unmarshal(body, message)
+	timings.Unmarshal = time.Since(start)
+	if err != nil {
+		return nil, timings, errors.WithStack(err)
+	}
+	start = time.Now()
+	result, err := search_v3.FromProtoE(message)
+	timings.Convert = time.Since(start)
+	if err != nil {
+		return nil, timings, err
+	}
+	return result, timings, nil
+}
+
+func (c *Client) fetch(ctx context.Context) ([]byte, codec.Codec, Timings, error) {
+	var timings Timings
+	requested := c.Codec
+	if requested == nil {
+		requested = codec.VTProto
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.URL, nil)
+	if err != nil {
+		return nil, nil, timings, errors.WithStack(err)
+	}
+	req.Header.Set("Accept", mime.FormatMediaType(requested.ContentType(), map[string]string{httpapi.CodecParam: requested.Name()}))
+	encoding := c.Encoding
+	if encoding == "" {
+		encoding = httpapi.Identity
+	}
+	// Explicit Accept-Encoding also turns off transparent gzip of http.Transport, so the client sees the wire bytes
+	req.Header.Set("Accept-Encoding", encoding)
+
+	httpClient := c.HTTP
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	start := time.Now()
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, nil, timings, errors.WithStack(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+		return nil, nil, timings, errors.Wrapf(ErrStatus, "%s", resp.Status)
+	}
+
+	responseCodec, err := codecFor(resp.Header.Get("Content-Type"), requested)
+	if err != nil {
+		return nil, nil, timings, err
+	}
+	timings.Codec = responseCodec.Name()
+	timings.ContentEncoding = resp.Header.Get("Content-Encoding")
+
+	wire := &timedReader{r: resp.Body}
+	body, err := c.readBody(wire, timings.ContentEncoding)
+	timings.Transfer = time.Since(start)
+	timings.WireBytes = wire.n
+	timings.BodyBytes = int64(len(body))
+	timings.Decompress = wire.total - wire.elapsed
+	if timings.ContentEncoding == "" || timings.ContentEncoding == httpapi.Identity {
+		timings.Decompress = 0
+	}
+	return body, responseCodec, timings, err
+}
+
+// readBody streams wire through the decompressor of the content encoding
+func (c *Client) readBody(wire *timedReader, contentEncoding string) ([]byte, error) {
+	var r io.ReadCloser
+	var err error
+	switch contentEncoding {
+	case "", httpapi.Identity:
+		return utils.ReadAllDecompressed(wire, c.Decompress)
+	case "gzip":
+		r, err = utils.NewGZIPReader(wire, c.Decompress)
+	case "deflate":
+		r, err = zlib.NewReader(wire)
+		err = utils.DecompressionError(err)
+	default:
+		return nil, errors.Wrapf(ErrUnsupportedEncoding, "%q", contentEncoding)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	timed := &timedReader{r: r}
+	body, err := utils.ReadAllDecompressed(timed, c.Decompress)
+	wire.total = timed.elapsed
+	if err != nil {
+		r.Close()
+		return nil, err
+	}
+	return body, utils.DecompressionError(r.Close())
+}
+
+// codecFor picks codec decoding the response: the requested one if the content type matches,
+// the one named by the codec parameter, or the default codec of the content type
+func codecFor(contentType string, requested codec.Codec) (codec.Codec, error) {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil, errors.Wrapf(ErrUnsupportedMediaType, "%q: %s", contentType, err)
+	}
+	if name := params[httpapi.CodecParam]; name != "" {
+		if c, ok := codec.Get(name); ok && c.ContentType() == mediaType {
+			return c, nil
+		}
+	}
+	if requested.ContentType() == mediaType {
+		return requested, nil
+	}
+	for _, c := range httpapi.DefaultCodecs {
+		if c.ContentType() == mediaType {
+			return c, nil
+		}
+	}
+	return nil, errors.Wrapf(ErrUnsupportedMediaType, "%q", contentType)
+}
+
+// timedReader counts bytes and time spent in reads of r. total is the time of the whole consumer of the reader
+type timedReader struct {
+	r       io.Reader
+	n       int64
+	elapsed time.Duration
+	total   time.Duration
+}
+
+func (r *timedReader) Read(p []byte) (int, error) {
+	start := time.Now()
+	n, err := r.r.Read(p)
+	r.elapsed += time.Since(start)
+	r.n += int64(n)
+	return n, err
+}
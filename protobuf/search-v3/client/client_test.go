@@ -0,0 +1,163 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	"go-playground/protobuf/codec"
+	"go-playground/protobuf/httpapi"
+	search_v3 "go-playground/protobuf/search-v3"
+	"go-playground/protobuf/search-v3/client"
+	"go-playground/protobuf/utils"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+var payload = search_v3.NewResultsPayload(search_v3.GenerateResults(search_v3.DefaultGeneratorConfig()))
+
+func newServer() *httptest.Server {
+	return httptest.NewServer(httpapi.NewHandler(func(r *http.Request) (codec.Payload, error) {
+		return payload, nil
+	}))
+}
+
+func TestClient(t *testing.T) {
+	server := newServer()
+	defer server.Close()
+
+	expectedJSON := utils.Must2(codec.JSON.Marshal(payload.JSON))
+	// Proto on the wire drops the difference between nil and empty slices
+	decoded := &search_v3.SearchResults{}
+	require.NoError(t, codec.VTProto.Unmarshal(utils.Must2(codec.VTProto.Marshal(payload.Proto)), decoded))
+	expectedProtoJSON := utils.Must2(codec.JSON.Marshal(search_v3.FromProto(decoded)))
+	for _, c := range httpapi.DefaultCodecs {
+		for _, encoding := range httpapi.DefaultEncodings {
+			name := fmt.Sprintf("%s/%s", c.Name(), encoding.Name)
+			cl := client.New(server.URL)
+			cl.Codec = c
+			cl.Encoding = encoding.Name
+
+			message, timings, err := cl.Proto(context.Background())
+			require.NoError(t, err, name)
+			require.True(t, proto.Equal(payload.Proto.(proto.Message), message), name)
+			require.Equal(t, c.Name(), timings.Codec, name)
+			require.Positive(t, timings.WireBytes, name)
+			require.Positive(t, timings.BodyBytes, name)
+			if encoding.Name == httpapi.Identity {
+				require.Equal(t, timings.BodyBytes, timings.WireBytes, name)
+				require.Zero(t, timings.Decompress, name)
+			} else {
+				require.Equal(t, encoding.Name, timings.ContentEncoding, name)
+				require.Less(t, timings.WireBytes, timings.BodyBytes, name)
+			}
+
+			results, timings, err := cl.Results(context.Background())
+			require.NoError(t, err, name)
+			expected := expectedJSON
+			if c.ContentType() == codec.ContentTypeProtobuf {
+				expected = expectedProtoJSON
+			}
+			require.JSONEq(t, string(expected), string(utils.Must2(codec.JSON.Marshal(results))), name)
+			require.Equal(t, c.Name(), timings.Codec, name)
+			require.Positive(t, timings.Unmarshal, name)
+			if c.ContentType() == codec.ContentTypeProtobuf {
+				require.Positive(t, timings.Convert, name)
+			} else {
+				require.Zero(t, timings.Convert, name)
+			}
+			t.Log(timings)
+		}
+	}
+}
+
+func TestClientWireBytes(t *testing.T) {
+	server := newServer()
+	defer server.Close()
+
+	// encoding/json sorts map keys, so unlike proto the body is the same on every request
+	cl := client.New(server.URL)
+	cl.Codec = codec.JSON
+	_, timings, err := cl.Results(context.Background())
+	require.NoError(t, err)
+
+	req := utils.Must2(http.NewRequest(http.MethodHead, server.URL, nil))
+	req.Header.Set("Accept", "application/json; codec=json")
+	req.Header.Set("Accept-Encoding", "gzip")
+	resp := utils.Must2(http.DefaultClient.Do(req))
+	resp.Body.Close()
+	require.Equal(t, resp.ContentLength, timings.WireBytes)
+	require.Equal(t, int64(len(utils.Must2(codec.JSON.Marshal(payload.JSON)))), timings.BodyBytes)
+}
+
+func TestClientErrors(t *testing.T) {
+	server := newServer()
+	defer server.Close()
+
+	cl := client.New(server.URL)
+	cl.Encoding = "br;q=1, identity;q=0"
+	_, _, err := cl.Proto(context.Background())
+	require.ErrorIs(t, err, client.ErrStatus)
+
+	cl = client.New(server.URL)
+	cl.Decompress.MaxSize = 1024
+	_, _, err = cl.Results(context.Background())
+	require.ErrorIs(t, err, utils.ErrTooLarge)
+
+	unknown := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", codec.ContentTypeProtobuf)
+		w.Header().Set("Content-Encoding", "br")
+		w.Write([]byte{0})
+	}))
+	defer unknown.Close()
+	_, _, err = client.New(unknown.URL).Proto(context.Background())
+	require.ErrorIs(t, err, client.ErrUnsupportedEncoding)
+}
+
+func TestClientMalformedResults(t *testing.T) {
+	message := &search_v3.SearchResults{Chunks: []*search_v3.Chunk{{
+		FilterState: &search_v3.FilterState{Segments: map[int64]*search_v3.SegmentFilter{0: {ArrivalDate: []string{"2023-02-30"}}}},
+	}}}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", codec.ContentTypeProtobuf)
+		w.Write(utils.Must2(codec.VTProto.Marshal(message)))
+	}))
+	defer server.Close()
+
+	_, _, err := client.New(server.URL).Proto(context.Background())
+	require.NoError(t, err)
+	results, _, err := client.New(server.URL).Results(context.Background())
+	require.Nil(t, results)
+	require.ErrorContains(t, err, "2023-02-30")
+}
+
+func BenchmarkClient(b *testing.B) {
+	server := newServer()
+	defer server.Close()
+
+	for _, c := range []codec.Codec{codec.JSONIter, codec.VTProto} {
+		for _, encoding := range []string{"gzip", httpapi.Identity} {
+			b.Run(fmt.Sprintf("%s/%s", c.Name(), encoding), func(b *testing.B) {
+				cl := client.New(server.URL)
+				cl.Codec = c
+				cl.Encoding = encoding
+				var total client.Timings
+				b.ReportAllocs()
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					_, timings, err := cl.Proto(context.Background())
+					if err != nil {
+						b.Fatal(err)
+					}
+					total.WireBytes += timings.WireBytes
+					total.Decompress += timings.Decompress
+					total.Unmarshal += timings.Unmarshal
+				}
+				b.ReportMetric(float64(total.WireBytes)/float64(b.N), "wire-bytes/op")
+				b.ReportMetric(float64(total.Decompress.Nanoseconds())/float64(b.N), "decompress-ns/op")
+				b.ReportMetric(float64(total.Unmarshal.Nanoseconds())/float64(b.N), "unmarshal-ns/op")
+			})
+		}
+	}
+}
@@ -0,0 +1,318 @@
+package search_v3
+
+import (
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Experimental columnar container for repeated messages like flight legs and tickets: every field of the
+// message becomes its own array, so similar values stay next to each other and compress better. Values of
+// message fields are columns again, one row per value. Columns and ColumnarChunk are defined in columnar.proto
+
+// DeltaFields are stored as deltas to the value of the previous row
+var DeltaFields = []protoreflect.FullName{
+	"search_v3.FlightLeg.departure_unix_timestamp",
+	"search_v3.FlightLeg.arrival_unix_timestamp",
+}
+
+var ErrColumns = errors.New("malformed columns")
+
+type columnarMessage interface {
+	proto.Message
+	MarshalVT() ([]byte, error)
+	UnmarshalVT(data []byte) error
+}
+
+var deltaFields = func() map[protoreflect.FullName]bool {
+	result := make(map[protoreflect.FullName]bool, len(DeltaFields))
+	for _, name := range DeltaFields {
+		result[name] = true
+	}
+	return result
+}()
+
+func FlightLegsToColumns(legs []*FlightLeg) (*Columns, error) {
+	return ToColumns(legs)
+}
+
+func ColumnsToFlightLegs(columns *Columns) ([]*FlightLeg, error) {
+	return FromColumns(columns, func() *FlightLeg { return &FlightLeg{} })
+}
+
+func TicketsToColumns(tickets []*Ticket) (*Columns, error) {
+	return ToColumns(tickets)
+}
+
+func ColumnsToTickets(columns *Columns) ([]*Ticket, error) {
+	return FromColumns(columns, func() *Ticket { return &Ticket{} })
+}
+
+// ToColumns converts messages to columns
+func ToColumns[M columnarMessage](rows []M) (*Columns, error) {
+	var zero M
+	encoded := make([][]byte, len(rows))
+	for i, row := range rows {
+		data, err := row.MarshalVT()
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		encoded[i] = data
+	}
+	return encodeColumns(zero.ProtoReflect().Descriptor(), encoded)
+}
+
+// FromColumns converts columns back to messages created by newRow
+func FromColumns[M columnarMessage](columns *Columns, newRow func() M) ([]M, error) {
+	if columns.GetRows() == 0 {
+		return nil, nil
+	}
+	encoded, err := decodeColumns(newRow().ProtoReflect().Descriptor(), columns)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]M, len(encoded))
+	for i, data := range encoded {
+		result[i] = newRow()
+		if err := result[i].UnmarshalVT(data); err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+	return result, nil
+}
+
+// MarshalColumnar encodes chunk with columnar tickets and flight legs
+func MarshalColumnar(chunk *Chunk) ([]byte, error) {
+	rest := shallowCopy(chunk)
+	rest.Tickets = nil
+	rest.FlightLegs = nil
+	tickets, err := TicketsToColumns(chunk.Tickets)
+	if err != nil {
+		return nil, err
+	}
+	legs, err := FlightLegsToColumns(chunk.FlightLegs)
+	if err != nil {
+		return nil, err
+	}
+	data, err := (&ColumnarChunk{Chunk: rest, Tickets: tickets, FlightLegs: legs}).MarshalVT()
+	return data, errors.WithStack(err)
+}
+
+// UnmarshalColumnar decodes chunk encoded by MarshalColumnar
+func UnmarshalColumnar(data []byte, chunk *Chunk) error {
+	// The generated code merges the chunk field into the given chunk
+	columnar := &ColumnarChunk{Chunk: chunk}
+	if err := columnar.UnmarshalVT(data); err != nil {
+		return errors.WithStack(err)
+	}
+	var err error
+	if chunk.Tickets, err = ColumnsToTickets(columnar.Tickets); err != nil {
+		return err
+	}
+	chunk.FlightLegs, err = ColumnsToFlightLegs(columnar.FlightLegs)
+	return err
+}
+
+func encodeColumns(descriptor protoreflect.MessageDescriptor, rows [][]byte) (*Columns, error) {
+	fields := descriptor.Fields()
+	columns := make([]*Column, fields.Len())
+	nested := make([][][]byte, fields.Len())
+	previous := make([]int64, fields.Len())
+	for i := range columns {
+		fd := fields.Get(i)
+		columns[i] = &Column{Field: int32(fd.Number()), Counts: make([]int64, len(rows)), Delta: deltaFields[fd.FullName()]}
+	}
+
+	for r, row := range rows {
+		err := consumeFields(row, func(number protowire.Number, wireType protowire.Type, value []byte) error {
+			fd := fields.ByNumber(number)
+			if fd == nil {
+				return errors.Wrapf(ErrColumns, "%s: unknown field %d", descriptor.FullName(), number)
+			}
+			i, column := fd.Index(), columns[fd.Index()]
+			if fd.Message() != nil {
+				nested[i] = append(nested[i], value)
+				column.Counts[r]++
+				return nil
+			}
+			scalarType := kindWireType(fd.Kind())
+			if wireType == protowire.BytesType && scalarType != protowire.BytesType {
+				// Packed repeated scalars
+				for len(value) > 0 {
+					n := protowire.ConsumeFieldValue(number, scalarType, value)
+					if n < 0 {
+						return errors.WithStack(protowire.ParseError(n))
+					}
+					column.appendValue(scalarType, value[:n], &previous[i])
+					column.Counts[r]++
+					value = value[n:]
+				}
+				return nil
+			}
+			column.appendValue(wireType, value, &previous[i])
+			column.Counts[r]++
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result := &Columns{Rows: uint64(len(rows))}
+	for i, column := range columns {
+		total, single := int64(0), true
+		for _, count := range column.Counts {
+			total += count
+			single = single && count == 1
+		}
+		if total == 0 {
+			continue
+		}
+		if single {
+			column.Counts = nil
+		}
+		if fd := fields.Get(i); fd.Message() != nil {
+			var err error
+			if column.Nested, err = encodeColumns(fd.Message(), nested[i]); err != nil {
+				return nil, err
+			}
+		}
+		result.Columns = append(result.Columns, column)
+	}
+	return result, nil
+}
+
+func (c *Column) appendValue(wireType protowire.Type, value []byte, previous *int64) {
+	switch {
+	case wireType == protowire.BytesType:
+		c.Values = protowire.AppendBytes(c.Values, value)
+	case wireType == protowire.VarintType && c.Delta:
+		v, _ := protowire.ConsumeVarint(value)
+		c.Values = protowire.AppendVarint(c.Values, protowire.EncodeZigZag(int64(v)-*previous))
+		*previous = int64(v)
+	default:
+		c.Values = append(c.Values, value...)
+	}
+}
+
+func decodeColumns(descriptor protoreflect.MessageDescriptor, columns *Columns) ([][]byte, error) {
+	fields := descriptor.Fields()
+	if err := checkRows(descriptor, columns); err != nil {
+		return nil, err
+	}
+	rows := make([][]byte, columns.Rows)
+	for _, column := range columns.Columns {
+		fd := fields.ByNumber(protoreflect.FieldNumber(column.Field))
+		count := func(r int) int {
+			if column.Counts == nil {
+				return 1
+			}
+			return int(column.Counts[r])
+		}
+
+		if fd.Message() != nil {
+			if column.Nested == nil {
+				return nil, errors.Wrapf(ErrColumns, "%s: no nested columns", fd.FullName())
+			}
+			values, err := decodeColumns(fd.Message(), column.Nested)
+			if err != nil {
+				return nil, err
+			}
+			for r := range rows {
+				for j := count(r); j > 0; j-- {
+					if len(values) == 0 {
+						return nil, errors.Wrapf(ErrColumns, "%s: not enough values", fd.FullName())
+					}
+					rows[r] = protowire.AppendTag(rows[r], fd.Number(), protowire.BytesType)
+					rows[r] = protowire.AppendBytes(rows[r], values[0])
+					values = values[1:]
+				}
+			}
+			continue
+		}
+
+		wireType := kindWireType(fd.Kind())
+		packed := fd.IsPacked() && wireType != protowire.BytesType
+		values, previous := column.Values, int64(0)
+		for r := range rows {
+			var packedValues []byte
+			for j := count(r); j > 0; j-- {
+				n := protowire.ConsumeFieldValue(fd.Number(), wireType, values)
+				if n < 0 {
+					return nil, errors.Wrapf(ErrColumns, "%s: %v", fd.FullName(), protowire.ParseError(n))
+				}
+				value := values[:n]
+				values = values[n:]
+				if wireType == protowire.VarintType && column.Delta {
+					v, _ := protowire.ConsumeVarint(value)
+					previous += protowire.DecodeZigZag(v)
+					value = protowire.AppendVarint(nil, uint64(previous))
+				}
+				if packed {
+					packedValues = append(packedValues, value...)
+					continue
+				}
+				rows[r] = protowire.AppendTag(rows[r], fd.Number(), wireType)
+				rows[r] = append(rows[r], value...)
+			}
+			if packedValues != nil {
+				rows[r] = protowire.AppendTag(rows[r], fd.Number(), protowire.BytesType)
+				rows[r] = protowire.AppendBytes(rows[r], packedValues)
+			}
+		}
+	}
+	return rows, nil
+}
+
+// maxRows bounds rows of every columns, far above the rows of a chunk. Empty messages take no bytes,
+// so rows can't be bounded by the values and corrupt rows or counts would exhaust memory otherwise
+const maxRows = 1 << 20
+
+// checkRows rejects rows that the columns can't hold before they are allocated:
+// every value takes at least a byte, so a column without counts has at least a byte per row
+func checkRows(descriptor protoreflect.MessageDescriptor, columns *Columns) error {
+	if columns.Rows > maxRows {
+		return errors.Wrapf(ErrColumns, "%s: %d rows", descriptor.FullName(), columns.Rows)
+	}
+	for _, column := range columns.Columns {
+		fd := descriptor.Fields().ByNumber(protoreflect.FieldNumber(column.Field))
+		if fd == nil {
+			return errors.Wrapf(ErrColumns, "%s: unknown field %d", descriptor.FullName(), column.Field)
+		}
+		switch {
+		case column.Counts != nil:
+			if uint64(len(column.Counts)) != columns.Rows {
+				return errors.Wrapf(ErrColumns, "%s: %d counts for %d rows", fd.FullName(), len(column.Counts), columns.Rows)
+			}
+			for r, count := range column.Counts {
+				if count < 0 {
+					return errors.Wrapf(ErrColumns, "%s: %d values in row %d", fd.FullName(), count, r)
+				}
+			}
+		case fd.Message() != nil:
+			if column.Nested != nil && column.Nested.Rows < columns.Rows {
+				return errors.Wrapf(ErrColumns, "%s: %d nested rows for %d rows", fd.FullName(), column.Nested.Rows, columns.Rows)
+			}
+		case uint64(len(column.Values)) < columns.Rows:
+			return errors.Wrapf(ErrColumns, "%s: %d bytes of values for %d rows", fd.FullName(), len(column.Values), columns.Rows)
+		}
+	}
+	return nil
+}
+
+// kindWireType is the wire type of a single value of the kind
+func kindWireType(kind protoreflect.Kind) protowire.Type {
+	switch kind {
+	case protoreflect.Fixed32Kind, protoreflect.Sfixed32Kind, protoreflect.FloatKind:
+		return protowire.Fixed32Type
+	case protoreflect.Fixed64Kind, protoreflect.Sfixed64Kind, protoreflect.DoubleKind:
+		return protowire.Fixed64Type
+	case protoreflect.StringKind, protoreflect.BytesKind, protoreflect.MessageKind:
+		return protowire.BytesType
+	case protoreflect.GroupKind:
+		return protowire.StartGroupType
+	default:
+		return protowire.VarintType
+	}
+}
@@ -0,0 +1,352 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.12
+// source: protobuf/search-v3/columnar.proto
+
+package search_v3
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Repeated messages with every field stored as its own array, one row per message, see ToColumns
+type Columns struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rows    uint64    `protobuf:"varint,1,opt,name=rows,proto3" json:"rows,omitempty"`
+	Columns []*Column `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty"`
+}
+
+func (x *Columns) Reset() {
+	*x = Columns{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_search_v3_columnar_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Columns) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Columns) ProtoMessage() {}
+
+func (x *Columns) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_search_v3_columnar_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Columns.ProtoReflect.Descriptor instead.
+func (*Columns) Descriptor() ([]byte, []int) {
+	return file_protobuf_search_v3_columnar_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Columns) GetRows() uint64 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *Columns) GetColumns() []*Column {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+type Column struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field  int32    `protobuf:"varint,1,opt,name=field,proto3" json:"field,omitempty"`
+	Counts []int64  `protobuf:"varint,2,rep,packed,name=counts,proto3" json:"counts,omitempty"` // values per row, omitted when every row has one value
+	Values []byte   `protobuf:"bytes,3,opt,name=values,proto3" json:"values,omitempty"`         // scalar values in wire format without tags
+	Delta  bool     `protobuf:"varint,4,opt,name=delta,proto3" json:"delta,omitempty"`          // varints are zigzag deltas to the previous value
+	Nested *Columns `protobuf:"bytes,5,opt,name=nested,proto3" json:"nested,omitempty"`         // values of a message field
+}
+
+func (x *Column) Reset() {
+	*x = Column{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_search_v3_columnar_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Column) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Column) ProtoMessage() {}
+
+func (x *Column) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_search_v3_columnar_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Column.ProtoReflect.Descriptor instead.
+func (*Column) Descriptor() ([]byte, []int) {
+	return file_protobuf_search_v3_columnar_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Column) GetField() int32 {
+	if x != nil {
+		return x.Field
+	}
+	return 0
+}
+
+func (x *Column) GetCounts() []int64 {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *Column) GetValues() []byte {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *Column) GetDelta() bool {
+	if x != nil {
+		return x.Delta
+	}
+	return false
+}
+
+func (x *Column) GetNested() *Columns {
+	if x != nil {
+		return x.Nested
+	}
+	return nil
+}
+
+// Chunk with columnar tickets and flight legs, see MarshalColumnar
+type ColumnarChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chunk      *Chunk   `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"` // without tickets and flight legs
+	Tickets    *Columns `protobuf:"bytes,2,opt,name=tickets,proto3" json:"tickets,omitempty"`
+	FlightLegs *Columns `protobuf:"bytes,3,opt,name=flight_legs,json=flightLegs,proto3" json:"flight_legs,omitempty"`
+}
+
+func (x *ColumnarChunk) Reset() {
+	*x = ColumnarChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_search_v3_columnar_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColumnarChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColumnarChunk) ProtoMessage() {}
+
+func (x *ColumnarChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_search_v3_columnar_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColumnarChunk.ProtoReflect.Descriptor instead.
+func (*ColumnarChunk) Descriptor() ([]byte, []int) {
+	return file_protobuf_search_v3_columnar_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ColumnarChunk) GetChunk() *Chunk {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+func (x *ColumnarChunk) GetTickets() *Columns {
+	if x != nil {
+		return x.Tickets
+	}
+	return nil
+}
+
+func (x *ColumnarChunk) GetFlightLegs() *Columns {
+	if x != nil {
+		return x.FlightLegs
+	}
+	return nil
+}
+
+var File_protobuf_search_v3_columnar_proto protoreflect.FileDescriptor
+
+var file_protobuf_search_v3_columnar_proto_rawDesc = []byte{
+	0x0a, 0x21, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x2d, 0x76, 0x33, 0x2f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x61, 0x72, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x09, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x1a, 0x20,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2d,
+	0x76, 0x33, 0x2f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x4a, 0x0a, 0x07, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72,
+	0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12,
+	0x2b, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x43, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x22, 0x90, 0x01, 0x0a,
+	0x06, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x06, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x64, 0x65,
+	0x6c, 0x74, 0x61, 0x12, 0x2a, 0x0a, 0x06, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e,
+	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x52, 0x06, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x22,
+	0x9a, 0x01, 0x0a, 0x0d, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x61, 0x72, 0x43, 0x68, 0x75, 0x6e,
+	0x6b, 0x12, 0x26, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x43, 0x68, 0x75,
+	0x6e, 0x6b, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x2c, 0x0a, 0x07, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x52, 0x07,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x0b, 0x66, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x5f, 0x6c, 0x65, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73,
+	0x52, 0x0a, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4c, 0x65, 0x67, 0x73, 0x42, 0x0e, 0x5a, 0x0c,
+	0x2e, 0x2e, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2d, 0x76, 0x33, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_protobuf_search_v3_columnar_proto_rawDescOnce sync.Once
+	file_protobuf_search_v3_columnar_proto_rawDescData = file_protobuf_search_v3_columnar_proto_rawDesc
+)
+
+func file_protobuf_search_v3_columnar_proto_rawDescGZIP() []byte {
+	file_protobuf_search_v3_columnar_proto_rawDescOnce.Do(func() {
+		file_protobuf_search_v3_columnar_proto_rawDescData = protoimpl.X.CompressGZIP(file_protobuf_search_v3_columnar_proto_rawDescData)
+	})
+	return file_protobuf_search_v3_columnar_proto_rawDescData
+}
+
+var file_protobuf_search_v3_columnar_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_protobuf_search_v3_columnar_proto_goTypes = []interface{}{
+	(*Columns)(nil),       // 0: search_v3.Columns
+	(*Column)(nil),        // 1: search_v3.Column
+	(*ColumnarChunk)(nil), // 2: search_v3.ColumnarChunk
+	(*Chunk)(nil),         // 3: search_v3.Chunk
+}
+var file_protobuf_search_v3_columnar_proto_depIdxs = []int32{
+	1, // 0: search_v3.Columns.columns:type_name -> search_v3.Column
+	0, // 1: search_v3.Column.nested:type_name -> search_v3.Columns
+	3, // 2: search_v3.ColumnarChunk.chunk:type_name -> search_v3.Chunk
+	0, // 3: search_v3.ColumnarChunk.tickets:type_name -> search_v3.Columns
+	0, // 4: search_v3.ColumnarChunk.flight_legs:type_name -> search_v3.Columns
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_protobuf_search_v3_columnar_proto_init() }
+func file_protobuf_search_v3_columnar_proto_init() {
+	if File_protobuf_search_v3_columnar_proto != nil {
+		return
+	}
+	file_protobuf_search_v3_results_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_protobuf_search_v3_columnar_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Columns); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_search_v3_columnar_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Column); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_search_v3_columnar_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColumnarChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_protobuf_search_v3_columnar_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_protobuf_search_v3_columnar_proto_goTypes,
+		DependencyIndexes: file_protobuf_search_v3_columnar_proto_depIdxs,
+		MessageInfos:      file_protobuf_search_v3_columnar_proto_msgTypes,
+	}.Build()
+	File_protobuf_search_v3_columnar_proto = out.File
+	file_protobuf_search_v3_columnar_proto_rawDesc = nil
+	file_protobuf_search_v3_columnar_proto_goTypes = nil
+	file_protobuf_search_v3_columnar_proto_depIdxs = nil
+}
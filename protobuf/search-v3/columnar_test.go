@@ -0,0 +1,153 @@
+package search_v3
+
+import (
+	"compress/gzip"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	"go-playground/protobuf/utils"
+	"google.golang.org/protobuf/encoding/protowire"
+	"math"
+	"testing"
+)
+
+func TestColumnarRoundTrip(t *testing.T) {
+	for name, results := range map[string]*SearchResults{
+		"dump":      resultsToProto(readDumpStruct()),
+		"generated": resultsToProto(GenerateResults(generatorConfig)),
+		"empty":     {Chunks: []*Chunk{{}}},
+	} {
+		full, columnar := 0, 0
+		for _, chunk := range results.Chunks {
+			original := proto.Clone(chunk)
+			data, err := MarshalColumnar(chunk)
+			require.NoError(t, err, name)
+			require.True(t, proto.Equal(original, chunk), "encoding leaves chunk intact")
+
+			decoded := &Chunk{}
+			require.NoError(t, UnmarshalColumnar(data, decoded), name)
+			require.True(t, proto.Equal(chunk, decoded), name)
+			full += len(utils.Must2(utils.CompressGZIP(utils.Must2(chunk.MarshalVT()), gzip.DefaultCompression)))
+			columnar += len(utils.Must2(utils.CompressGZIP(data, gzip.DefaultCompression)))
+		}
+		t.Logf("%s: %d gzip bytes of chunks, %d gzip bytes of columnar chunks", name, full, columnar)
+		// Columns of similar values compress better, an empty chunk gets only the overhead of the containers
+		if name != "empty" {
+			require.Less(t, columnar, full, name)
+		}
+	}
+}
+
+func TestColumnsFlightLegs(t *testing.T) {
+	legs := []*FlightLeg{
+		{Origin: "MOW", Destination: "LED", DepartureUnixTimestamp: 1700000000, ArrivalUnixTimestamp: 1700005400, Tags: []string{"a", "b"}},
+		{Origin: "LED", Destination: "MOW", DepartureUnixTimestamp: 1700003600, TechnicalStops: []*TechnicalStop{{AirportCode: "AER"}}},
+		{},
+	}
+	columns, err := FlightLegsToColumns(legs)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), columns.Rows)
+
+	for _, column := range columns.Columns {
+		switch column.Field {
+		case 5:
+			require.True(t, column.Delta)
+			require.Equal(t, []int64{1, 1, 0}, column.Counts)
+			first, n := protowire.ConsumeVarint(column.Values)
+			second, _ := protowire.ConsumeVarint(column.Values[n:])
+			require.Equal(t, int64(1700000000), protowire.DecodeZigZag(first))
+			require.Equal(t, int64(3600), protowire.DecodeZigZag(second))
+		case 11:
+			require.Equal(t, []int64{2, 0, 0}, column.Counts)
+		case 9:
+			require.Equal(t, uint64(1), column.Nested.Rows)
+		}
+	}
+
+	data, err := columns.MarshalVT()
+	require.NoError(t, err)
+	received := &Columns{}
+	require.NoError(t, received.UnmarshalVT(data))
+	decoded, err := ColumnsToFlightLegs(received)
+	require.NoError(t, err)
+	require.Len(t, decoded, len(legs))
+	for i := range legs {
+		require.True(t, proto.Equal(legs[i], decoded[i]))
+	}
+}
+
+func TestColumnsTickets(t *testing.T) {
+	tickets := resultsToProto(GenerateResults(generatorConfig)).Chunks[0].Tickets
+	columns, err := TicketsToColumns(tickets)
+	require.NoError(t, err)
+	decoded, err := ColumnsToTickets(columns)
+	require.NoError(t, err)
+	require.Len(t, decoded, len(tickets))
+	for i := range tickets {
+		require.True(t, proto.Equal(tickets[i], decoded[i]))
+	}
+
+	empty, err := TicketsToColumns(nil)
+	require.NoError(t, err)
+	require.Empty(t, empty.Columns)
+	require.Nil(t, utils.Must2(ColumnsToTickets(empty)))
+}
+
+func TestColumnsMalformed(t *testing.T) {
+	columns := utils.Must2(FlightLegsToColumns([]*FlightLeg{{Origin: "MOW"}, {Origin: "LED"}}))
+	columns.Columns[0].Counts = []int64{1}
+	_, err := ColumnsToFlightLegs(columns)
+	require.ErrorIs(t, err, ErrColumns)
+
+	columns.Columns[0].Counts = []int64{2, 1}
+	_, err = ColumnsToFlightLegs(columns)
+	require.ErrorIs(t, err, ErrColumns)
+
+	columns.Columns[0].Counts = []int64{-1, 1}
+	_, err = ColumnsToFlightLegs(columns)
+	require.ErrorIs(t, err, ErrColumns)
+
+	columns.Columns[0].Counts = nil
+	columns.Rows = 1 << 20
+	_, err = ColumnsToFlightLegs(columns)
+	require.ErrorIs(t, err, ErrColumns, "rows beyond the values aren't allocated")
+
+	columns.Rows = 2
+	columns.Columns[0].Field = 100
+	_, err = ColumnsToFlightLegs(columns)
+	require.ErrorIs(t, err, ErrColumns)
+
+	for _, rows := range []uint64{math.MaxUint64, math.MaxInt64, math.MaxInt32 + 1, maxRows + 1} {
+		data := protowire.AppendTag(nil, 1, protowire.VarintType)
+		data = protowire.AppendVarint(data, rows)
+		columns := &Columns{}
+		require.NoError(t, columns.UnmarshalVT(data))
+		_, err = ColumnsToFlightLegs(columns)
+		require.ErrorIs(t, err, ErrColumns, "%d rows", rows)
+	}
+}
+
+func FuzzColumns(f *testing.F) {
+	results := resultsToProto(GenerateResults(generatorConfig))
+	f.Add(utils.Must2(utils.Must2(FlightLegsToColumns(results.Chunks[0].FlightLegs)).MarshalVT()))
+	f.Add(utils.Must2(utils.Must2(TicketsToColumns(results.Chunks[0].Tickets[:2])).MarshalVT()))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		columns := &Columns{}
+		if err := columns.UnmarshalVT(data); err != nil {
+			return
+		}
+		// Corrupt columns are errors rather than panics
+		_, _ = ColumnsToFlightLegs(columns)
+		_, _ = ColumnsToTickets(columns)
+	})
+}
+
+func BenchmarkColumnar(b *testing.B) {
+	for name, results := range map[string]*SearchResults{
+		"Dump":      resultsToProto(readDumpStruct()),
+		"Generated": resultsToProto(GenerateResults(DefaultGeneratorConfig())),
+	} {
+		b.Run(name, func(b *testing.B) {
+			benchmarkChunkSizes(b, results, "columnar", eachChunk(MarshalColumnar))
+		})
+	}
+}
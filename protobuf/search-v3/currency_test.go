@@ -0,0 +1,64 @@
+package search_v3
+
+import (
+	"github.com/KosyanMedia/delta/pkg/currency"
+	"github.com/stretchr/testify/require"
+	"go-playground/protobuf/utils"
+	"regexp"
+	"testing"
+)
+
+// dumpCurrencyCodes are the codes of the amounts delta put into the dump
+func dumpCurrencyCodes() []string {
+	var codes []string
+	for _, match := range regexp.MustCompile(`"currency_code":\s*"(\w*)"`).FindAllSubmatch(Dump(), -1) {
+		codes = append(codes, string(match[1]))
+	}
+	return codes
+}
+
+func TestAmountCurrencyMatrix(t *testing.T) {
+	// Codes delta sends and the generator makes have their enum values
+	known := append(dumpCurrencyCodes(), sampleCurrencies...)
+	require.NotEmpty(t, known)
+	for _, code := range known {
+		require.Contains(t, Currency_value, code)
+	}
+
+	// Every code of the enum maps to its value and every other code round-trips through currency_iso_code:
+	// ADP moved off zero, VED is newer than the enum, the rest are unknown, malformed and zero codes
+	codes := append(known, "ADP", "VED", "ZZZ", "XBT", "VES2", "usd", "CURRENCY_UNSPECIFIED", "")
+	for code := range Currency_value {
+		codes = append(codes, code)
+	}
+	var fallbacks []string
+	for _, code := range codes {
+		original := currency.Amount{CurrencyCode: utils.Must2(fromJSONString[currency.Code](code)), Value: 1234.5}
+		converted := amountToProto(original)
+		if value, ok := Currency_value[code]; ok && Currency(value) != Currency_CURRENCY_UNSPECIFIED {
+			require.Equal(t, Currency(value), converted.CurrencyCode, code)
+			require.Empty(t, converted.CurrencyIsoCode, code)
+		} else {
+			require.Equal(t, Currency_CURRENCY_UNSPECIFIED, converted.CurrencyCode, code)
+			require.Equal(t, code, converted.CurrencyIsoCode, code)
+			fallbacks = append(fallbacks, code)
+		}
+
+		received := &Amount{}
+		require.NoError(t, received.UnmarshalVT(utils.Must2(converted.MarshalVT())), code)
+		require.Equal(t, original, (&protoConverter{}).protoToAmount(received), code)
+	}
+	require.Contains(t, fallbacks, "VED", "codes newer than the enum are kept as strings")
+	require.NotContains(t, fallbacks, "ADP")
+}
+
+func TestAmountZeroCurrency(t *testing.T) {
+	converted := amountToProto(currency.Amount{})
+	require.Zero(t, converted.SizeVT(), "zero amount is empty on the wire")
+	require.Equal(t, currency.Amount{}, (&protoConverter{}).protoToAmount(converted))
+	require.Equal(t, currency.Amount{}, (&protoConverter{}).protoToAmount(nil))
+
+	adp := amountToProto(currency.Amount{CurrencyCode: utils.Must2(fromJSONString[currency.Code]("ADP"))})
+	require.Equal(t, Currency_ADP, adp.CurrencyCode)
+	require.NotZero(t, adp.SizeVT(), "ADP is no longer the zero value")
+}
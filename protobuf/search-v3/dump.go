@@ -0,0 +1,56 @@
+package search_v3
+
+import (
+	"bytes"
+	"embed"
+	v3 "github.com/KosyanMedia/delta/search/cmd/results-api/api/v3"
+	jsoniter "github.com/json-iterator/go"
+	"github.com/pkg/errors"
+	"go-playground/protobuf/codec"
+	"go-playground/protobuf/utils"
+)
+
+var (
+	//go:embed results.json
+	resultsJson embed.FS
+
+	jsonIter = jsoniter.ConfigFastest
+)
+
+// Dump returns embedded search results in JSON
+func Dump() []byte {
+	return utils.Must2(resultsJson.ReadFile("results.json"))
+}
+
+// Results parses the JSON dump, an empty dump is replaced with generated results of the default shape
+func Results(dump []byte) (v3.SearchResults, error) {
+	dump = bytes.TrimSpace(dump)
+	if len(dump) == 0 || bytes.Equal(dump, []byte("[]")) || bytes.Equal(dump, []byte("null")) {
+		return GenerateResults(DefaultGeneratorConfig()), nil
+	}
+
+	var data v3.SearchResults
+	if err := jsonIter.Unmarshal(dump, &data); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return data, nil
+}
+
+// NewPayload parses JSON dump of search results and prepares it for every codec
+func NewPayload(dump []byte) (codec.Payload, error) {
+	data, err := Results(dump)
+	if err != nil {
+		return codec.Payload{}, err
+	}
+	return NewResultsPayload(data), nil
+}
+
+// NewResultsPayload prepares search results for every codec
+func NewResultsPayload(data v3.SearchResults) codec.Payload {
+	return codec.Payload{
+		JSON:     data,
+		Proto:    resultsToProto(data),
+		NewJSON:  func() any { return &v3.SearchResults{} },
+		NewProto: func() any { return &SearchResults{} },
+	}
+}
@@ -0,0 +1,770 @@
+package search_v3
+
+import (
+	"fmt"
+	"github.com/KosyanMedia/delta/pkg/currency"
+	"github.com/KosyanMedia/delta/pkg/iata"
+	"github.com/KosyanMedia/delta/pkg/types/datetime"
+	"github.com/KosyanMedia/delta/pkg/types/search/base"
+	"github.com/KosyanMedia/delta/pkg/types/search/delta"
+	v3 "github.com/KosyanMedia/delta/search/cmd/results-api/api/v3"
+	"github.com/KosyanMedia/delta/search/cmd/results-api/filter"
+	"github.com/KosyanMedia/delta/search/cmd/results-api/filter/boundaries"
+	"github.com/KosyanMedia/delta/search/cmd/results-api/filter/times"
+	"github.com/KosyanMedia/delta/search/cmd/results-api/filter/transfers"
+	"go-playground/protobuf/utils"
+	"math"
+	"sort"
+	"time"
+)
+
+// GeneratorConfig describes the shape of synthetic search results
+type GeneratorConfig struct {
+	Seed               int64
+	Chunks             int
+	TicketsPerChunk    int
+	ProposalsPerTicket int
+	FlightLegsPerChunk int
+	Locales            []string
+}
+
+func DefaultGeneratorConfig() GeneratorConfig {
+	return GeneratorConfig{
+		Seed:               1,
+		Chunks:             2,
+		TicketsPerChunk:    60,
+		ProposalsPerTicket: 3,
+		FlightLegsPerChunk: 50,
+		Locales:            []string{"ru", "en"},
+	}
+}
+
+// GenerateResults builds search results of the configured shape. The same config always produces the same results
+func GenerateResults(config GeneratorConfig) v3.SearchResults {
+	// Every ticket needs a proposal and a flight leg to reference
+	if config.ProposalsPerTicket < 1 {
+		config.ProposalsPerTicket = 1
+	}
+	if config.FlightLegsPerChunk < 1 {
+		config.FlightLegsPerChunk = 1
+	}
+	// Negative counts make nothing rather than a panic
+	if config.Chunks < 0 {
+		config.Chunks = 0
+	}
+	if config.TicketsPerChunk < 0 {
+		config.TicketsPerChunk = 0
+	}
+	g := &generator{
+		config: config,
+		random: utils.NewRandom(config.Seed),
+		start:  time.Date(2023, 2, 18, 0, 0, 0, 0, time.UTC),
+	}
+	result := make(v3.SearchResults, config.Chunks)
+	for i := range result {
+		result[i] = g.chunk(i)
+	}
+	return result
+}
+
+type placeSample struct {
+	code, city, country string
+	lat, lng            float64
+	timezone            string
+	names               map[string]string
+}
+
+type airlineSample struct {
+	iata       string
+	allianceID int
+	lowcost    bool
+	names      map[string]string
+}
+
+type agentSample struct {
+	id       int
+	gateName string
+	label    string
+}
+
+var (
+	samplePlaces = []placeSample{
+		{"DME", "MOW", "RU", 55.414566, 37.899494, "Europe/Moscow", map[string]string{"ru": "Домодедово", "en": "Domodedovo"}},
+		{"SVO", "MOW", "RU", 55.966324, 37.416573, "Europe/Moscow", map[string]string{"ru": "Шереметьево", "en": "Sheremetyevo"}},
+		{"VKO", "MOW", "RU", 55.60315, 37.292098, "Europe/Moscow", map[string]string{"ru": "Внуково", "en": "Vnukovo"}},
+		{"LED", "LED", "RU", 59.806084, 30.3083, "Europe/Moscow", map[string]string{"ru": "Пулково", "en": "Pulkovo"}},
+		{"IKT", "IKT", "RU", 52.268028, 104.38897, "Asia/Irkutsk", map[string]string{"ru": "Иркутск", "en": "Irkutsk"}},
+		{"OVB", "OVB", "RU", 55.00901, 82.66712, "Asia/Novosibirsk", map[string]string{"ru": "Толмачёво", "en": "Tolmachevo"}},
+		{"SVX", "SVX", "RU", 56.750336, 60.804314, "Asia/Yekaterinburg", map[string]string{"ru": "Кольцово", "en": "Koltsovo"}},
+		{"KZN", "KZN", "RU", 55.60844, 49.29824, "Europe/Moscow", map[string]string{"ru": "Казань", "en": "Kazan"}},
+		{"AER", "AER", "RU", 43.44884, 39.941105, "Europe/Moscow", map[string]string{"ru": "Сочи", "en": "Sochi"}},
+		{"IST", "IST", "TR", 41.262222, 28.727778, "Europe/Istanbul", map[string]string{"ru": "Стамбул", "en": "Istanbul"}},
+		{"DXB", "DXB", "AE", 25.248665, 55.352917, "Asia/Dubai", map[string]string{"ru": "Дубай", "en": "Dubai"}},
+		{"TBS", "TBS", "GE", 41.668648, 44.95472, "Asia/Tbilisi", map[string]string{"ru": "Тбилиси", "en": "Tbilisi"}},
+		{"EVN", "EVN", "AM", 40.152527, 44.398881, "Asia/Yerevan", map[string]string{"ru": "Звартноц", "en": "Zvartnots"}},
+	}
+	sampleCities = map[string]map[string]string{
+		"MOW": {"ru": "Москва", "en": "Moscow"},
+		"LED": {"ru": "Санкт-Петербург", "en": "Saint Petersburg"},
+		"IKT": {"ru": "Иркутск", "en": "Irkutsk"},
+		"OVB": {"ru": "Новосибирск", "en": "Novosibirsk"},
+		"SVX": {"ru": "Екатеринбург", "en": "Yekaterinburg"},
+		"KZN": {"ru": "Казань", "en": "Kazan"},
+		"AER": {"ru": "Сочи", "en": "Sochi"},
+		"IST": {"ru": "Стамбул", "en": "Istanbul"},
+		"DXB": {"ru": "Дубай", "en": "Dubai"},
+		"TBS": {"ru": "Тбилиси", "en": "Tbilisi"},
+		"EVN": {"ru": "Ереван", "en": "Yerevan"},
+	}
+	sampleCountries = map[string]map[string]string{
+		"RU": {"ru": "Россия", "en": "Russia"},
+		"TR": {"ru": "Турция", "en": "Turkey"},
+		"AE": {"ru": "ОАЭ", "en": "United Arab Emirates"},
+		"GE": {"ru": "Грузия", "en": "Georgia"},
+		"AM": {"ru": "Армения", "en": "Armenia"},
+	}
+	sampleAirlines = []airlineSample{
+		{"SU", 3, false, map[string]string{"ru": "Аэрофлот", "en": "Aeroflot"}},
+		{"S7", 2, false, map[string]string{"ru": "S7 Airlines", "en": "S7 Airlines"}},
+		{"U6", 0, false, map[string]string{"ru": "Уральские авиалинии", "en": "Ural Airlines"}},
+		{"DP", 0, true, map[string]string{"ru": "Победа", "en": "Pobeda"}},
+		{"UT", 0, false, map[string]string{"ru": "ЮТэйр", "en": "Utair"}},
+		{"TK", 1, false, map[string]string{"ru": "Турецкие авиалинии", "en": "Turkish Airlines"}},
+		{"FZ", 0, true, map[string]string{"ru": "Флайдубай", "en": "flydubai"}},
+		{"PC", 0, true, map[string]string{"ru": "Пегасус", "en": "Pegasus"}},
+	}
+	sampleAlliances = map[int]string{
+		1: "Star Alliance",
+		2: "OneWorld",
+		3: "SkyTeam",
+	}
+	sampleAgents = []agentSample{
+		{20, "onetwotrip", "OneTwoTrip"},
+		{31, "kupibilet", "Kupibilet"},
+		{62, "svyaznoy_travel_api", "Svyaznoy Travel"},
+		{70, "ural_airlines", "Уральские авиалинии"},
+		{148, "biletix", "Biletix"},
+		{170, "s7", "S7 Airlines"},
+		{180, "aeroflot", "Аэрофлот"},
+		{239, "pegastour_nemo", "Пегас Тур"},
+	}
+	sampleEquipments = []v3.Equipment{
+		{Code: "320", Type: base.EquipmentType(EquipmentType_PLANE), Name: "Airbus A320-100/200"},
+		{Code: "321", Type: base.EquipmentType(EquipmentType_PLANE), Name: "Airbus A321"},
+		{Code: "32N", Type: base.EquipmentType(EquipmentType_PLANE), Name: "Airbus A320neo"},
+		{Code: "738", Type: base.EquipmentType(EquipmentType_PLANE), Name: "Boeing 737-800"},
+		{Code: "73H", Type: base.EquipmentType(EquipmentType_PLANE), Name: "Boeing 737-800 (winglets)"},
+		{Code: "SU9", Type: base.EquipmentType(EquipmentType_PLANE), Name: "Sukhoi Superjet 100"},
+	}
+	sampleTicketTags   = []string{"direct", "cheapest", "convenient", "lowcost", "popular"}
+	sampleTransferTags = []string{"short_layover", "long_layover", "night_transfer", "airport_change"}
+	sampleFareNames    = []string{"Promo", "Economy Lite", "Economy Standard", "Economy Plus", "Business"}
+	samplePayments     = []string{"card", "googlepay", "applepay", "terminal", "qiwi"}
+	sampleCurrencies   = []string{"RUB", "EUR", "USD"}
+	currencyWeights    = []float64{0.6, 0.2, 0.2}
+	nameContexts       = []string{"default", "from", "to", "where"}
+)
+
+type generator struct {
+	config GeneratorConfig
+	random *utils.Random
+	start  time.Time
+}
+
+func (g *generator) chunk(index int) *v3.Chunk {
+	legs := g.flightLegs()
+	tickets := make([]v3.Ticket, g.config.TicketsPerChunk)
+	for i := range tickets {
+		tickets[i] = g.ticket(len(legs))
+	}
+	sort.SliceStable(tickets, func(i, j int) bool {
+		return tickets[i].Proposals[0].Price.Value < tickets[j].Proposals[0].Price.Value
+	})
+
+	chunk := &v3.Chunk{
+		ChunkID:             fmt.Sprintf("chunk-%d", index),
+		LastUpdateTimestamp: g.start.Add(time.Duration(index) * time.Second).Unix(),
+		DebugInfo:           g.debugInfo(),
+		Tickets:             tickets,
+		FlightLegs:          legs,
+		Airlines:            make(map[iata.AirlineID]v3.AirlineInfo, len(sampleAirlines)),
+		Places:              g.places(),
+		Agents:              make(map[int]v3.AgentInfo, len(sampleAgents)),
+		Alliances:           make(map[int]v3.Alliance, len(sampleAlliances)),
+		Equipments:          make(map[string]v3.Equipment, len(sampleEquipments)),
+		BrandTickets:        make(map[int]v3.Ticket),
+		FilterState: &filter.State{
+			TransfersCount: []int{0, 1},
+			Baggage:        []string{"full_baggage"},
+		},
+		Order: v3.Order(Order_BEST),
+		Brand: v3.Brand(Brand_AS),
+	}
+	if len(tickets) > 0 {
+		chunk.CheapestTicket = utils.Ptr(tickets[0])
+		chunk.FilteredCheapestTicket = utils.Ptr(tickets[0])
+		chunk.BrandTicket = utils.Ptr(tickets[len(tickets)/2])
+		chunk.BrandTickets[tickets[len(tickets)/2].Proposals[0].AgentID] = tickets[len(tickets)/2]
+		chunk.DirectFlights = []v3.DirectFlights{g.directFlights(tickets[0], legs)}
+	}
+
+	for _, airline := range sampleAirlines {
+		chunk.Airlines[iata.AirlineID(airline.iata)] = v3.AirlineInfo{
+			IATA:       iata.AirlineID(airline.iata),
+			IsLowcost:  airline.lowcost,
+			Name:       g.localized(airline.names, nameContexts[:1]),
+			AllianceID: airline.allianceID,
+		}
+	}
+	for _, agent := range sampleAgents {
+		chunk.Agents[agent.id] = v3.AgentInfo{
+			ID:             agent.id,
+			GateName:       agent.gateName,
+			Label:          g.localized(map[string]string{"ru": agent.label, "en": agent.label}, nameContexts[:1]),
+			PaymentMethods: g.sample(samplePayments, g.random.Int(1, 3)),
+			MobileVersion:  g.random.Chance(0.5),
+		}
+	}
+	for id, name := range sampleAlliances {
+		chunk.Alliances[id] = v3.Alliance{ID: id, Name: name}
+	}
+	for _, equipment := range sampleEquipments {
+		chunk.Equipments[equipment.Code] = equipment
+	}
+
+	chunk.SearchParams.Passengers.Adults = uint32(g.random.Int(1, 2))
+	chunk.SearchParams.TripClass = base.TripClass(TripClass_Y)
+	chunk.SearchParams.SourceKind = base.SourceKind(SourceKind_WEB)
+	chunk.SearchParams.Experiments = map[string]string{
+		"serp-exp-scoring":     "on",
+		"serp-exp-softFilters": "on",
+		"filtering_v3":         "a",
+	}
+
+	chunk.Meta.TotalTicketsCount = len(tickets)
+	chunk.Meta.FilteredTicketsCount = len(tickets)
+	for _, ticket := range tickets {
+		if len(ticket.Segments[0].FlightLegs) == 1 {
+			chunk.Meta.DirectTicketsCount++
+		}
+	}
+
+	chunk.FilterBoundaries, chunk.DegradedFilterBoundaries = g.boundaries(tickets, legs)
+	return chunk
+}
+
+func (g *generator) debugInfo() *v3.DebugInfo {
+	gates := make(map[v3.GateName]v3.GateDebugInfo, len(sampleAgents))
+	for _, agent := range sampleAgents {
+		n := g.random.Intn(5)
+		proposals := make(map[v3.ProposalID]v3.ProposalDebugInfo, n)
+		for i := 0; i < n; i++ {
+			proposals[fmt.Sprintf("%d:%d", agent.id, i)] = v3.ProposalDebugInfo{
+				AgencyPrice:  g.amount(10000, 50000),
+				Multiplier:   g.round(g.random.Float(0, 1), 2),
+				Productivity: g.round(g.random.Float(0, 0.01), 6),
+			}
+		}
+		var errs []string
+		if g.random.Chance(0.25) {
+			errs = []string{fmt.Sprintf("gates/%s/ruler. true", agent.gateName)}
+		}
+		gates[agent.gateName] = v3.GateDebugInfo{
+			Name: agent.gateName,
+			Agents: map[int]v3.AgentDebugInfo{
+				agent.id: {
+					Proposals:      proposals,
+					ProposalsCount: len(proposals),
+					BadProposals:   map[string]int{"merged cause not unique fare code": g.random.Intn(30)},
+				},
+			},
+			ResponseDurationSeconds: g.round(g.random.Float(0, 10), 6),
+			Errors:                  errs,
+		}
+	}
+	return &v3.DebugInfo{
+		ServerName:      "gp.us-west-2/start-chain-generated",
+		DataCenter:      "gp.us-west-2",
+		Gates:           gates,
+		SearchStartTime: g.start,
+	}
+}
+
+func (g *generator) flightLegs() []v3.FlightLeg {
+	legs := make([]v3.FlightLeg, g.config.FlightLegsPerChunk)
+	for i := range legs {
+		origin := utils.Choice(g.random, samplePlaces)
+		destination := utils.Choice(g.random, samplePlaces)
+		for destination.code == origin.code {
+			destination = utils.Choice(g.random, samplePlaces)
+		}
+		airline := utils.Choice(g.random, sampleAirlines)
+		departure := g.random.Timestamp(g.start, 14*24*time.Hour).Truncate(30 * time.Minute)
+		arrival := departure.Add(time.Duration(60+g.random.Intn(10*12)*5) * time.Minute)
+		equipment := utils.Choice(g.random, sampleEquipments)
+
+		var stops []*delta.TechnicalStop
+		if g.random.Chance(0.05) {
+			stops = []*delta.TechnicalStop{{AirportCode: iata.LocationIATACode(utils.Choice(g.random, samplePlaces).code)}}
+		}
+
+		legs[i] = v3.FlightLeg{
+			Origin:                 iata.LocationIATACode(origin.code),
+			Destination:            iata.LocationIATACode(destination.code),
+			LocalDepartureDateTime: generatedDateTime(departure.Format("2006-01-02 15:04")),
+			LocalArrivalDateTime:   generatedDateTime(arrival.Format("2006-01-02 15:04")),
+			DepartureUnixTimestamp: departure.Unix(),
+			ArrivalUnixTimestamp:   arrival.Unix(),
+			OperatingCarrierDesignator: base.FlightDesignator{
+				Carrier:   iata.AirlineID(airline.iata),
+				AirlineID: iata.AirlineID(airline.iata),
+				Number:    base.FlightNumber(fmt.Sprint(g.random.Int(100, 9099))),
+			},
+			Equipment: base.Equipment{
+				Code: base.EquipmentCode(equipment.Code),
+				Type: equipment.Type,
+				Name: equipment.Name,
+			},
+			TechnicalStops: stops,
+			Signature:      fmt.Sprintf("%d:%d:%s:%s", departure.Unix(), arrival.Unix(), origin.code, destination.code),
+		}
+	}
+	return legs
+}
+
+func (g *generator) ticket(legsCount int) v3.Ticket {
+	segments := make([]v3.Segment, g.random.Int(1, 2))
+	var legIndexes []int
+	for i := range segments {
+		flights := make([]int, g.random.Int(1, 2))
+		for j := range flights {
+			flights[j] = g.random.Intn(legsCount)
+		}
+		transfers := make([]v3.Transfer, len(flights)-1)
+		for j := range transfers {
+			transfers[j] = v3.Transfer{
+				RecheckBaggage: g.random.Chance(0.2),
+				NightTransfer:  g.random.Chance(0.25),
+				Tags:           g.sample(sampleTransferTags, g.random.Intn(2)),
+			}
+			transfers[j].VisaRules.Required = g.random.Chance(0.1)
+		}
+		segments[i] = v3.Segment{
+			FlightLegs: flights,
+			Transfers:  transfers,
+		}
+		legIndexes = append(legIndexes, flights...)
+	}
+
+	proposals := make([]v3.Proposal, g.config.ProposalsPerTicket)
+	for i := range proposals {
+		proposals[i] = g.proposal(legIndexes, len(segments))
+	}
+	sort.SliceStable(proposals, func(i, j int) bool {
+		return proposals[i].Price.Value < proposals[j].Price.Value
+	})
+
+	ticket := v3.Ticket{
+		Segments:   segments,
+		Proposals:  proposals,
+		Signature:  g.random.StringOf(32, utils.Hex),
+		Popularity: float64(g.random.Intn(1000)),
+		Score:      g.round(g.random.Float(0, 10), 6),
+		Tags:       g.sample(sampleTicketTags, g.random.Intn(3)),
+	}
+	if len(proposals) > 0 {
+		ticket.ExtraFares = map[string][]v3.FareProposal{
+			"H1|L0|CH1|R1|SP0": {{ID: proposals[0].ID, Index: 0}},
+		}
+	}
+	if g.random.Chance(0.1) {
+		ticket.Badges = []v3.BadgeInfo{g.badge()}
+	}
+	return ticket
+}
+
+func (g *generator) proposal(legIndexes []int, segmentsCount int) v3.Proposal {
+	agent := utils.Choice(g.random, sampleAgents)
+	price := g.amount(8000, 150000)
+	fareCode := g.random.StringOf(5, utils.Uppercase)
+	fareName := utils.Choice(g.random, sampleFareNames)
+
+	terms := make(map[int]v3.FlightTerm, len(legIndexes))
+	for _, index := range legIndexes {
+		airline := utils.Choice(g.random, sampleAirlines)
+		terms[index] = v3.FlightTerm{
+			FareCode:       base.FareCode(fareCode),
+			TripClass:      base.TripClass(TripClass_Y),
+			SeatsAvailable: g.random.Int(1, 9),
+			MarketingCarrierDesignator: &base.FlightDesignator{
+				Carrier:   iata.AirlineID(airline.iata),
+				AirlineID: iata.AirlineID(airline.iata),
+				Number:    base.FlightNumber(fmt.Sprint(g.random.Int(100, 9099))),
+			},
+			Baggage:  g.baggage(),
+			Handbags: g.baggage(),
+			AdditionalTariffInfo: &v3.AdditionalTariffInfo{
+				ReturnBeforeFlight: g.tariffInfo(),
+				ChangeBeforeFlight: g.tariffInfo(),
+				FareName:           fareName,
+				Miles:              g.round(g.random.Float(0, 1), 2),
+			},
+			MergedTermsInfo: v3.MergedTermsInfo{
+				ReturnBeforeFlight: v3.TariffMergeInfo{
+					IsFromConfig: v3.TariffMergeParams{
+						Available:           g.random.Chance(0.5),
+						PenaltyCurrencyCode: g.random.Chance(0.5),
+						PenaltyValue:        g.random.Chance(0.5),
+					},
+				},
+				Baggage: v3.BaggageMergeInfo{
+					IsFromConfig: v3.BaggageMergeParams{
+						Weight: g.random.Chance(0.5),
+						Height: g.random.Chance(0.5),
+					},
+				},
+			},
+		}
+	}
+
+	transferTerms := make([][]v3.TransferTerm, segmentsCount)
+	for i := range transferTerms {
+		transferTerms[i] = []v3.TransferTerm{}
+	}
+
+	proposal := v3.Proposal{
+		ID:             fmt.Sprintf("%d:%d", agent.id, g.random.Intn(200)),
+		Price:          price,
+		PricePerPerson: price,
+		AgentID:        agent.id,
+		FlightTerms:    terms,
+		TransferTerms:  transferTerms,
+		UnifiedPrice:   price,
+		Weight:         g.round(g.random.Float(0, 0.001), 6),
+		Tags:           []string{},
+		MinimumFare: v3.Fare{
+			Baggage:            g.baggage(),
+			Handbags:           g.baggage(),
+			ReturnBeforeFlight: g.tariffInfo(),
+			ChangeBeforeFlight: g.tariffInfo(),
+			FareName:           fareName,
+		},
+	}
+	if g.random.Chance(0.2) {
+		proposal.Cashback = &v3.Cashback{
+			LocalizedAmount: utils.Ptr(g.amount(100, 1000)),
+			Available:       true,
+		}
+	}
+	return proposal
+}
+
+func (g *generator) baggage() *base.Baggage {
+	if g.random.Chance(0.3) {
+		return &base.Baggage{Count: 0}
+	}
+	return &base.Baggage{
+		Count:  1,
+		Weight: float64(5 * g.random.Int(1, 6)),
+		Length: 40,
+		Width:  20,
+		Height: 55,
+	}
+}
+
+func (g *generator) tariffInfo() *v3.TariffInfo {
+	info := &v3.TariffInfo{
+		Available:    g.random.Chance(0.5),
+		IsFromConfig: true,
+	}
+	if info.Available {
+		info.Penalty = utils.Ptr(g.amount(0, 5000))
+	}
+	return info
+}
+
+func (g *generator) badge() v3.BadgeInfo {
+	badge := v3.BadgeInfo{
+		Type:   sampleTicketTags[g.random.Intn(3)],
+		Scores: []float64{g.round(g.random.Float(0, 1), 6), g.round(g.random.Float(0, 10), 6)},
+	}
+	badge.Meta.Name = map[base.LanguageCode]string{}
+	for _, locale := range g.config.Locales {
+		badge.Meta.Name[base.LanguageCode(locale)] = badge.Type
+	}
+	badge.Meta.Priority = g.random.Int(1, 20)
+	badge.Meta.Position = badge.Meta.Priority
+	badge.Meta.Limit = 1
+	badge.Meta.Colors.Light = "#35C772"
+	badge.Meta.Colors.Dark = "#21AB5B"
+	return badge
+}
+
+func (g *generator) directFlights(ticket v3.Ticket, legs []v3.FlightLeg) v3.DirectFlights {
+	leg := legs[ticket.Segments[0].FlightLegs[0]]
+	carrier := string(leg.OperatingCarrierDesignator.Carrier)
+	return v3.DirectFlights{
+		Carrier:        carrier,
+		Carriers:       []string{carrier},
+		CheapestTicket: ticket,
+		Schedule: [][]v3.Schedule{{{
+			Time:              time.Unix(leg.DepartureUnixTimestamp, 0).UTC().Format("15:04"),
+			DateTime:          time.Unix(leg.DepartureUnixTimestamp, 0).UTC().Format(time.RFC3339),
+			TicketsSignatures: []string{ticket.Signature},
+		}}},
+	}
+}
+
+func (g *generator) places() base.Places {
+	places := base.Places{
+		Airports:        make(map[iata.LocationIATACode]base.AirportInfo, len(samplePlaces)),
+		Cities:          make(map[iata.LocationIATACode]base.CityInfo, len(sampleCities)),
+		Countries:       make(map[iata.CountryCode]base.CountryInfo, len(sampleCountries)),
+		MetroAreas:      make(map[iata.LocationIATACode]base.MetroAreaInfo),
+		AirportsToMetro: make(map[iata.LocationIATACode]iata.LocationIATACode),
+	}
+	for _, place := range samplePlaces {
+		airport := base.AirportInfo{
+			Name:     g.localized(place.names, nameContexts[:1]),
+			Code:     iata.LocationIATACode(place.code),
+			CityCode: iata.LocationIATACode(place.city),
+		}
+		airport.Coordinates.Lat = place.lat
+		airport.Coordinates.Lng = place.lng
+		if place.city != place.code {
+			airport.MetroAreaCode = iata.LocationIATACode(place.city)
+			places.AirportsToMetro[airport.Code] = airport.MetroAreaCode
+
+			metro := places.MetroAreas[airport.MetroAreaCode]
+			metro.Code = airport.MetroAreaCode
+			metro.Timezone = place.timezone
+			metro.Airports = append(metro.Airports, airport.Code)
+			places.MetroAreas[airport.MetroAreaCode] = metro
+		}
+		places.Airports[airport.Code] = airport
+
+		city := places.Cities[airport.CityCode]
+		city.Code = airport.CityCode
+		city.Name = g.localized(sampleCities[place.city], nameContexts)
+		city.Country = iata.CountryCode(place.country)
+		city.Timezone = place.timezone
+		city.Airports = append(city.Airports, airport.Code)
+		places.Cities[airport.CityCode] = city
+
+		places.Countries[city.Country] = base.CountryInfo{
+			Code: city.Country,
+			Name: g.localized(sampleCountries[place.country], nameContexts[:2]),
+		}
+	}
+	return places
+}
+
+// localized spreads names over configured locales and name contexts, the contexts are
+// simple prefixes as the generator doesn't know grammar
+func (g *generator) localized(names map[string]string, contexts []string) base.LocalizableContextString {
+	result := make(base.LocalizableContextString, len(g.config.Locales))
+	for _, locale := range g.config.Locales {
+		name, ok := names[locale]
+		if !ok {
+			name = names["en"]
+		}
+		values := make(map[string]string, len(contexts))
+		for _, context := range contexts {
+			if context == "default" {
+				values[context] = name
+			} else {
+				values[context] = context + " " + name
+			}
+		}
+		result[base.LanguageCode(locale)] = values
+	}
+	return result
+}
+
+func (g *generator) boundaries(tickets []v3.Ticket, legs []v3.FlightLeg) (*boundaries.Boundaries, *boundaries.DegradedBoundaries) {
+	bound := &boundaries.Boundaries{
+		Agents:               map[int]float64{},
+		Airlines:             map[iata.AirlineID]float64{},
+		Alliances:            map[int]float64{},
+		Airports:             map[int]boundaries.AirportsBoundaries{},
+		PaymentMethods:       map[string]float64{},
+		Equipments:           map[string]float64{},
+		DepartureArrivalTime: map[int]boundaries.TimeBoundaries{},
+		TransfersCount:       map[int64]float64{},
+		TransfersAirports:    map[iata.LocationIATACode]float64{},
+		TransfersCountries:   map[string]float64{},
+		TransfersDuration:    &transfers.TransferDurationBoundaries{Min: math.MaxInt64},
+	}
+	degraded := &boundaries.DegradedBoundaries{
+		Agents:         map[int]*filter.Price{},
+		Airlines:       map[iata.AirlineID]*filter.Price{},
+		TransfersCount: map[int64]*filter.Price{},
+		Price:          &boundaries.PriceBoundaries{Min: math.MaxFloat64},
+		Baggage: &boundaries.FilterBaggageBoundaries{
+			FullBaggage: &filter.Price{},
+			NoBaggage:   &filter.Price{},
+		},
+		HasLowcosts:   &filter.Bool{},
+		HasInterlines: &filter.Bool{},
+	}
+	bound.Price.Min = math.MaxFloat64
+
+	minPrice := func(current, price float64) float64 {
+		if current == 0 || price < current {
+			return price
+		}
+		return current
+	}
+
+	for _, ticket := range tickets {
+		price := ticket.Proposals[0].Price.Value
+		bound.Price.Min = math.Min(bound.Price.Min, price)
+		bound.Price.Max = math.Max(bound.Price.Max, price)
+		degraded.Price.Min = bound.Price.Min
+		degraded.Price.Max = bound.Price.Max
+
+		for _, proposal := range ticket.Proposals {
+			bound.Agents[proposal.AgentID] = minPrice(bound.Agents[proposal.AgentID], proposal.Price.Value)
+			degraded.Agents[proposal.AgentID] = &filter.Price{EnableMinPrice: bound.Agents[proposal.AgentID]}
+		}
+
+		for segmentIndex, segment := range ticket.Segments {
+			transfersCount := int64(len(segment.Transfers))
+			bound.TransfersCount[transfersCount] = minPrice(bound.TransfersCount[transfersCount], price)
+			degraded.TransfersCount[transfersCount] = &filter.Price{EnableMinPrice: bound.TransfersCount[transfersCount]}
+
+			airports, ok := bound.Airports[segmentIndex]
+			if !ok {
+				airports = boundaries.AirportsBoundaries{
+					Arrival:   map[iata.LocationIATACode]float64{},
+					Departure: map[iata.LocationIATACode]float64{},
+				}
+				bound.Airports[segmentIndex] = airports
+			}
+			first := legs[segment.FlightLegs[0]]
+			last := legs[segment.FlightLegs[len(segment.FlightLegs)-1]]
+			airports.Departure[first.Origin] = minPrice(airports.Departure[first.Origin], price)
+			airports.Arrival[last.Destination] = minPrice(airports.Arrival[last.Destination], price)
+
+			timeBoundaries, ok := bound.DepartureArrivalTime[segmentIndex]
+			if !ok {
+				timeBoundaries = boundaries.TimeBoundaries{
+					ArrivalDate:   map[datetime.Date]float64{},
+					ArrivalTime:   times.DateTimeRangeBoundaries{Buckets: map[datetime.DateTime]float64{}, BucketWidth: 1800},
+					DepartureTime: times.DateTimeRangeBoundaries{Buckets: map[datetime.DateTime]float64{}, BucketWidth: 1800},
+				}
+				timeBoundaries.TripDuration.Min = math.MaxInt64
+				timeBoundaries.TripDuration.Buckets = map[string]float64{}
+				timeBoundaries.TripDuration.BucketWidth = 30
+			}
+			departure := time.Unix(first.DepartureUnixTimestamp, 0).UTC()
+			arrival := time.Unix(last.ArrivalUnixTimestamp, 0).UTC()
+			arrivalDate := generatedDate(arrival.Format("2006-01-02"))
+			timeBoundaries.ArrivalDate[arrivalDate] = minPrice(timeBoundaries.ArrivalDate[arrivalDate], price)
+			departureBucket := generatedDateTime(departure.Truncate(30 * time.Minute).Format("2006-01-02 15:04"))
+			timeBoundaries.DepartureTime.Buckets[departureBucket] = minPrice(timeBoundaries.DepartureTime.Buckets[departureBucket], price)
+			arrivalBucket := generatedDateTime(arrival.Truncate(30 * time.Minute).Format("2006-01-02 15:04"))
+			timeBoundaries.ArrivalTime.Buckets[arrivalBucket] = minPrice(timeBoundaries.ArrivalTime.Buckets[arrivalBucket], price)
+			duration := int64(arrival.Sub(departure).Minutes())
+			if duration < timeBoundaries.TripDuration.Min {
+				timeBoundaries.TripDuration.Min = duration
+			}
+			if duration > timeBoundaries.TripDuration.Max {
+				timeBoundaries.TripDuration.Max = duration
+			}
+			durationBucket := fmt.Sprint(duration / 30 * 30)
+			timeBoundaries.TripDuration.Buckets[durationBucket] = minPrice(timeBoundaries.TripDuration.Buckets[durationBucket], price)
+			bound.DepartureArrivalTime[segmentIndex] = timeBoundaries
+
+			for i, index := range segment.FlightLegs {
+				leg := legs[index]
+				bound.Airlines[leg.OperatingCarrierDesignator.Carrier] = minPrice(bound.Airlines[leg.OperatingCarrierDesignator.Carrier], price)
+				degraded.Airlines[leg.OperatingCarrierDesignator.Carrier] = &filter.Price{EnableMinPrice: bound.Airlines[leg.OperatingCarrierDesignator.Carrier]}
+				bound.Equipments[string(leg.Equipment.Code)] = minPrice(bound.Equipments[string(leg.Equipment.Code)], price)
+				if i > 0 {
+					bound.TransfersAirports[leg.Origin] = minPrice(bound.TransfersAirports[leg.Origin], price)
+					transferDuration := leg.DepartureUnixTimestamp - legs[segment.FlightLegs[i-1]].ArrivalUnixTimestamp
+					if transferDuration < 0 {
+						transferDuration = -transferDuration
+					}
+					transferDuration /= 60
+					if transferDuration < bound.TransfersDuration.Min {
+						bound.TransfersDuration.Min = transferDuration
+					}
+					if transferDuration > bound.TransfersDuration.Max {
+						bound.TransfersDuration.Max = transferDuration
+					}
+				}
+			}
+		}
+
+		baggage := ticket.Proposals[0].MinimumFare.Baggage
+		if baggage != nil && baggage.Count > 0 {
+			bound.Baggage.FullBaggage = minPrice(bound.Baggage.FullBaggage, price)
+			degraded.Baggage.FullBaggage.EnableMinPrice = bound.Baggage.FullBaggage
+		} else {
+			bound.Baggage.NoBaggage = minPrice(bound.Baggage.NoBaggage, price)
+			degraded.Baggage.NoBaggage.EnableMinPrice = bound.Baggage.NoBaggage
+		}
+		bound.HasInterlines = bound.HasInterlines || len(ticket.Segments[0].Transfers) > 0
+	}
+
+	for segmentIndex, timeBoundaries := range bound.DepartureArrivalTime {
+		timeBoundaries.DepartureTime.Min, timeBoundaries.DepartureTime.Max = bucketsRange(timeBoundaries.DepartureTime.Buckets)
+		timeBoundaries.ArrivalTime.Min, timeBoundaries.ArrivalTime.Max = bucketsRange(timeBoundaries.ArrivalTime.Buckets)
+		bound.DepartureArrivalTime[segmentIndex] = timeBoundaries
+	}
+	if bound.TransfersDuration.Min == math.MaxInt64 {
+		bound.TransfersDuration = nil
+	}
+	if len(tickets) == 0 {
+		bound.Price.Min = 0
+		degraded.Price.Min = 0
+	}
+	degraded.TransfersDuration = bound.TransfersDuration
+	return bound, degraded
+}
+
+func bucketsRange(buckets map[datetime.DateTime]float64) (datetime.DateTime, datetime.DateTime) {
+	keys := make([]string, 0, len(buckets))
+	for key := range buckets {
+		keys = append(keys, key.String())
+	}
+	if len(keys) == 0 {
+		return datetime.DateTime{}, datetime.DateTime{}
+	}
+	sort.Strings(keys)
+	return generatedDateTime(keys[0]), generatedDateTime(keys[len(keys)-1])
+}
+
+func (g *generator) amount(min, max int) currency.Amount {
+	return currency.Amount{
+		CurrencyCode: utils.Must2(fromJSONString[currency.Code](utils.WeightedChoice(g.random, sampleCurrencies, currencyWeights))),
+		Value:        float64(g.random.Int(min, max)),
+	}
+}
+
+func (g *generator) sample(values []string, n int) []string {
+	if n == 0 {
+		return nil
+	}
+	result := make([]string, n)
+	for i := range result {
+		result[i] = utils.Choice(g.random, values)
+	}
+	return result
+}
+
+func (g *generator) round(val float64, digits int) float64 {
+	pow := math.Pow10(digits)
+	return math.Round(val*pow) / pow
+}
+
+// generatedDateTime parses a value the generator formats itself, so it never fails
+func generatedDateTime(val string) datetime.DateTime {
+	return utils.Must2(fromJSONString[datetime.DateTime](val))
+}
+
+func generatedDate(val string) datetime.Date {
+	return utils.Must2(fromJSONString[datetime.Date](val))
+}
@@ -0,0 +1,110 @@
+package search_v3
+
+import (
+	"github.com/stretchr/testify/require"
+	"go-playground/protobuf/codec/codectest"
+	"go-playground/protobuf/utils"
+	"reflect"
+	"testing"
+)
+
+var generatorConfig = GeneratorConfig{
+	Seed:               42,
+	Chunks:             3,
+	TicketsPerChunk:    20,
+	ProposalsPerTicket: 3,
+	FlightLegsPerChunk: 15,
+	Locales:            []string{"ru", "en", "de"},
+}
+
+func TestGenerateResultsDeterministic(t *testing.T) {
+	first := utils.Must2(jsonIter.Marshal(GenerateResults(generatorConfig)))
+	second := utils.Must2(jsonIter.Marshal(GenerateResults(generatorConfig)))
+	require.JSONEq(t, string(first), string(second))
+
+	config := generatorConfig
+	config.Seed++
+	other := utils.Must2(jsonIter.Marshal(GenerateResults(config)))
+	require.NotEqual(t, string(first), string(other))
+}
+
+func TestGenerateResultsShape(t *testing.T) {
+	data := GenerateResults(generatorConfig)
+	require.Len(t, data, generatorConfig.Chunks)
+
+	for _, chunk := range data {
+		require.Len(t, chunk.Tickets, generatorConfig.TicketsPerChunk)
+		require.Len(t, chunk.FlightLegs, generatorConfig.FlightLegsPerChunk)
+		for _, ticket := range chunk.Tickets {
+			require.Len(t, ticket.Proposals, generatorConfig.ProposalsPerTicket)
+			for _, segment := range ticket.Segments {
+				for _, index := range segment.FlightLegs {
+					require.Less(t, index, len(chunk.FlightLegs))
+				}
+			}
+		}
+
+		require.NotEmpty(t, chunk.Places.Airports)
+		require.NotEmpty(t, chunk.Places.Cities)
+		for _, city := range chunk.Places.Cities {
+			require.Len(t, city.Name, len(generatorConfig.Locales))
+		}
+		require.NotNil(t, chunk.FilterBoundaries)
+		require.NotNil(t, chunk.DegradedFilterBoundaries)
+		require.NotNil(t, chunk.FilterState)
+	}
+}
+
+func TestGenerateResultsNegativeShape(t *testing.T) {
+	config := generatorConfig
+	config.Chunks = -1
+	require.Empty(t, GenerateResults(config))
+
+	config = generatorConfig
+	config.TicketsPerChunk = -1
+	config.ProposalsPerTicket = -1
+	config.FlightLegsPerChunk = -1
+	data := GenerateResults(config)
+	require.Len(t, data, config.Chunks)
+	for _, chunk := range data {
+		require.Empty(t, chunk.Tickets)
+		require.Len(t, chunk.FlightLegs, 1)
+	}
+}
+
+func TestGeneratedProtoSameAsOriginal(t *testing.T) {
+	data := GenerateResults(generatorConfig)
+	requireDeepEqual(t, reflect.ValueOf(data), reflect.ValueOf(resultsToProto(data).Chunks))
+}
+
+func TestGeneratedProtoToResultsRoundTrip(t *testing.T) {
+	data := GenerateResults(generatorConfig)
+	expected := utils.Must2(jsonIter.Marshal(data))
+	actual := utils.Must2(jsonIter.Marshal(utils.Must2(FromProtoE(resultsToProto(data)))))
+	require.JSONEq(t, string(expected), string(actual))
+}
+
+func TestResultsFromEmptyDump(t *testing.T) {
+	expected := utils.Must2(jsonIter.Marshal(GenerateResults(DefaultGeneratorConfig())))
+	for _, dump := range []string{"", " \n", "[]", "null"} {
+		data, err := Results([]byte(dump))
+		require.NoError(t, err)
+		require.JSONEq(t, string(expected), string(utils.Must2(jsonIter.Marshal(data))), dump)
+	}
+}
+
+func TestGeneratedBinarySize(t *testing.T) {
+	results := codectest.RoundTrip(t, NewResultsPayload(GenerateResults(DefaultGeneratorConfig())))
+	codectest.RequireSizes(t, results, map[string]int{
+		"proto":   289496,
+		"vtproto": 289496,
+	})
+}
+
+func BenchmarkGenerated_Marshal(b *testing.B) {
+	codectest.Marshal(b, NewResultsPayload(GenerateResults(DefaultGeneratorConfig())))
+}
+
+func BenchmarkGenerated_Unmarshal(b *testing.B) {
+	codectest.Unmarshal(b, NewResultsPayload(GenerateResults(DefaultGeneratorConfig())))
+}
@@ -0,0 +1,241 @@
+package search_v3
+
+import (
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"sort"
+)
+
+// Interned chunk is a schema variant of results.proto where InternedFields are indexes into the chunk-level
+// string table instead of the strings themselves, see interned/results_interned.proto. The converter rewrites
+// the wire format of a regular Chunk field by field, so the generated interned package is needed only to read it elsewhere
+
+// InternedFields are identifiers repeating throughout a chunk
+var InternedFields = []protoreflect.FullName{
+	"search_v3.Chunk.airlines",
+	"search_v3.Chunk.equipments",
+	"search_v3.GateDebugInfo.name",
+	"search_v3.DebugInfo.gates",
+	"search_v3.Proposal.tags",
+	"search_v3.Fare.code",
+	"search_v3.TransferTerm.tags",
+	"search_v3.FlightTerm.fare_code",
+	"search_v3.FlightTerm.tags",
+	"search_v3.FlightDesignator.carrier",
+	"search_v3.FlightDesignator.airline_id",
+	"search_v3.TechnicalStop.airport_code",
+	"search_v3.Ticket.signature",
+	"search_v3.Ticket.tags",
+	"search_v3.Ticket.filtered_by",
+	"search_v3.Segment.tags",
+	"search_v3.Transfer.tags",
+	"search_v3.DirectFlights.carrier",
+	"search_v3.DirectFlights.carriers",
+	"search_v3.Schedule.tickets_signatures",
+	"search_v3.FlightLeg.origin",
+	"search_v3.FlightLeg.destination",
+	"search_v3.FlightLeg.signature",
+	"search_v3.FlightLeg.tags",
+	"search_v3.AirlineInfo.iata",
+	"search_v3.Places.airports",
+	"search_v3.Places.cities",
+	"search_v3.Places.countries",
+	"search_v3.Places.metro_areas",
+	"search_v3.Places.airports_to_metro",
+	"search_v3.AirportInfo.code",
+	"search_v3.AirportInfo.city_code",
+	"search_v3.AirportInfo.metro_area_code",
+	"search_v3.CityInfo.code",
+	"search_v3.CityInfo.country",
+	"search_v3.CityInfo.airports",
+	"search_v3.CountryInfo.code",
+	"search_v3.MetroAreaInfo.code",
+	"search_v3.MetroAreaInfo.airports",
+	"search_v3.AgentInfo.gate_name",
+	"search_v3.AgentInfo.payment_methods",
+	"search_v3.AgentInfo.airline_iatas",
+	"search_v3.Equipment.code",
+	"search_v3.FilterState.pin_flight_signatures",
+}
+
+// InternedStringsField is the number of `repeated string strings` field of the interned Chunk
+const InternedStringsField = 100
+
+var ErrInternedIndex = errors.New("string index is out of the table")
+
+var internedFields = func() map[protoreflect.FullName]bool {
+	result := make(map[protoreflect.FullName]bool, len(InternedFields))
+	for _, name := range InternedFields {
+		result[name] = true
+	}
+	return result
+}()
+
+// interned reports whether fd holds an index, mapField is the map the entry of fd belongs to
+func interned(fd, mapField protoreflect.FieldDescriptor) bool {
+	if fd.Kind() != protoreflect.StringKind {
+		return false
+	}
+	if mapField != nil {
+		return internedFields[mapField.FullName()]
+	}
+	return internedFields[fd.FullName()]
+}
+
+// MarshalInterned encodes chunk with the string table
+func MarshalInterned(chunk *Chunk) ([]byte, error) {
+	data, err := chunk.MarshalVT()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	descriptor := chunk.ProtoReflect().Descriptor()
+
+	counts := map[string]int{}
+	if _, err := rewriteInterned(nil, descriptor, nil, data, func(value []byte) []byte {
+		counts[string(value)]++
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	table := make([]string, 0, len(counts))
+	for value := range counts {
+		table = append(table, value)
+	}
+	sort.Slice(table, func(i, j int) bool {
+		if counts[table[i]] != counts[table[j]] {
+			return counts[table[i]] > counts[table[j]]
+		}
+		return table[i] < table[j]
+	})
+	index := make(map[string]uint64, len(table))
+	size := 0
+	for i, value := range table {
+		index[value] = uint64(i)
+		size += protowire.SizeTag(InternedStringsField) + protowire.SizeBytes(len(value))
+	}
+
+	result := make([]byte, 0, size+len(data))
+	for _, value := range table {
+		result = protowire.AppendTag(result, InternedStringsField, protowire.BytesType)
+		result = protowire.AppendString(result, value)
+	}
+	return rewriteInterned(result, descriptor, nil, data, func(value []byte) []byte {
+		return protowire.AppendVarint(nil, index[string(value)])
+	})
+}
+
+// UnmarshalInterned decodes chunk encoded by MarshalInterned
+func UnmarshalInterned(data []byte, chunk *Chunk) error {
+	var table [][]byte
+	body := make([]byte, 0, len(data))
+	for len(data) > 0 {
+		number, wireType, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return errors.WithStack(protowire.ParseError(n))
+		}
+		m := protowire.ConsumeFieldValue(number, wireType, data[n:])
+		if m < 0 {
+			return errors.WithStack(protowire.ParseError(m))
+		}
+		if number == InternedStringsField && wireType == protowire.BytesType {
+			value, _ := protowire.ConsumeBytes(data[n:])
+			table = append(table, value)
+		} else {
+			body = append(body, data[:n+m]...)
+		}
+		data = data[n+m:]
+	}
+
+	decoded, err := restoreInterned(nil, chunk.ProtoReflect().Descriptor(), nil, body, table)
+	if err != nil {
+		return err
+	}
+	return errors.WithStack(chunk.UnmarshalVT(decoded))
+}
+
+// rewriteInterned appends message data to dst with interned strings replaced by the result of intern
+func rewriteInterned(dst []byte, descriptor protoreflect.MessageDescriptor, mapField protoreflect.FieldDescriptor, data []byte, intern func(value []byte) []byte) ([]byte, error) {
+	for len(data) > 0 {
+		number, wireType, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return nil, errors.WithStack(protowire.ParseError(n))
+		}
+		m := protowire.ConsumeFieldValue(number, wireType, data[n:])
+		if m < 0 {
+			return nil, errors.WithStack(protowire.ParseError(m))
+		}
+		field := data[:n+m]
+		data = data[n+m:]
+
+		fd := descriptor.Fields().ByNumber(number)
+		if fd == nil || wireType != protowire.BytesType {
+			dst = append(dst, field...)
+			continue
+		}
+		value, _ := protowire.ConsumeBytes(field[n:])
+		switch {
+		case interned(fd, mapField):
+			dst = protowire.AppendTag(dst, number, protowire.VarintType)
+			dst = append(dst, intern(value)...)
+		case fd.Kind() == protoreflect.MessageKind:
+			entryOf := fd
+			if !fd.IsMap() {
+				entryOf = nil
+			}
+			nested, err := rewriteInterned(nil, fd.Message(), entryOf, value, intern)
+			if err != nil {
+				return nil, err
+			}
+			dst = protowire.AppendTag(dst, number, protowire.BytesType)
+			dst = protowire.AppendBytes(dst, nested)
+		default:
+			dst = append(dst, field...)
+		}
+	}
+	return dst, nil
+}
+
+// restoreInterned is the inverse of rewriteInterned
+func restoreInterned(dst []byte, descriptor protoreflect.MessageDescriptor, mapField protoreflect.FieldDescriptor, data []byte, table [][]byte) ([]byte, error) {
+	for len(data) > 0 {
+		number, wireType, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return nil, errors.WithStack(protowire.ParseError(n))
+		}
+		m := protowire.ConsumeFieldValue(number, wireType, data[n:])
+		if m < 0 {
+			return nil, errors.WithStack(protowire.ParseError(m))
+		}
+		field := data[:n+m]
+		data = data[n+m:]
+
+		fd := descriptor.Fields().ByNumber(number)
+		switch {
+		case fd == nil:
+			dst = append(dst, field...)
+		case wireType == protowire.VarintType && interned(fd, mapField):
+			index, _ := protowire.ConsumeVarint(field[n:])
+			if index >= uint64(len(table)) {
+				return nil, errors.Wrapf(ErrInternedIndex, "%s: %d of %d", fd.FullName(), index, len(table))
+			}
+			dst = protowire.AppendTag(dst, number, protowire.BytesType)
+			dst = protowire.AppendBytes(dst, table[index])
+		case wireType == protowire.BytesType && fd.Kind() == protoreflect.MessageKind:
+			value, _ := protowire.ConsumeBytes(field[n:])
+			entryOf := fd
+			if !fd.IsMap() {
+				entryOf = nil
+			}
+			nested, err := restoreInterned(nil, fd.Message(), entryOf, value, table)
+			if err != nil {
+				return nil, err
+			}
+			dst = protowire.AppendTag(dst, number, protowire.BytesType)
+			dst = protowire.AppendBytes(dst, nested)
+		default:
+			dst = append(dst, field...)
+		}
+	}
+	return dst, nil
+}
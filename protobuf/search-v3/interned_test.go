@@ -0,0 +1,185 @@
+package search_v3
+
+import (
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	internedpb "go-playground/protobuf/search-v3/interned"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protopath"
+	"google.golang.org/protobuf/reflect/protorange"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"testing"
+)
+
+func TestInternedFields(t *testing.T) {
+	for _, name := range InternedFields {
+		descriptor, err := protoregistry.GlobalFiles.FindDescriptorByName(name)
+		require.NoError(t, err, name)
+		fd := descriptor.(protoreflect.FieldDescriptor)
+		if fd.IsMap() {
+			require.Equal(t, protoreflect.StringKind, fd.MapKey().Kind(), name)
+		} else {
+			require.Equal(t, protoreflect.StringKind, fd.Kind(), name)
+		}
+	}
+	require.Nil(t, (&Chunk{}).ProtoReflect().Descriptor().Fields().ByNumber(InternedStringsField))
+}
+
+// schemaType is the type of a field as in .proto, messages and enums by their names without the package
+func schemaType(fd protoreflect.FieldDescriptor) string {
+	if fd.IsMap() {
+		return fmt.Sprintf("map<%s, %s>", schemaType(fd.MapKey()), schemaType(fd.MapValue()))
+	}
+	name := fd.Kind().String()
+	switch {
+	case fd.Message() != nil:
+		return string(fd.Message().Name())
+	case fd.Enum() != nil:
+		name = string(fd.Enum().Name())
+	}
+	switch {
+	case fd.IsList() && !fd.IsPacked() && kindWireType(fd.Kind()) != protowire.BytesType:
+		return "repeated " + name + " [packed = false]"
+	case fd.IsList():
+		return "repeated " + name
+	case fd.HasPresence():
+		return "optional " + name
+	}
+	return name
+}
+
+// TestInternedSchema checks that results_interned.proto is results.proto with InternedFields turned into indexes
+func TestInternedSchema(t *testing.T) {
+	original, variant := File_protobuf_search_v3_results_proto, internedpb.File_protobuf_search_v3_interned_results_interned_proto
+	require.Equal(t, original.Enums().Len(), variant.Enums().Len())
+	for i := 0; i < original.Enums().Len(); i++ {
+		enum := original.Enums().Get(i)
+		require.Equal(t, enum.Values().Len(), variant.Enums().ByName(enum.Name()).Values().Len(), enum.FullName())
+	}
+
+	require.Equal(t, original.Messages().Len(), variant.Messages().Len())
+	changed := 0
+	for i := 0; i < original.Messages().Len(); i++ {
+		message := original.Messages().Get(i)
+		indexes := variant.Messages().ByName(message.Name())
+		require.NotNil(t, indexes, message.FullName())
+		fields := message.Fields().Len()
+		if message.Name() == "Chunk" {
+			fields++
+			require.Equal(t, "repeated string", schemaType(indexes.Fields().ByNumber(InternedStringsField)))
+		}
+		require.Equal(t, fields, indexes.Fields().Len(), message.FullName())
+
+		for j := 0; j < message.Fields().Len(); j++ {
+			fd := message.Fields().Get(j)
+			index := indexes.Fields().ByNumber(fd.Number())
+			require.NotNil(t, index, fd.FullName())
+			require.Equal(t, fd.Name(), index.Name())
+			expected := schemaType(fd)
+			if internedFields[fd.FullName()] {
+				changed++
+				switch {
+				case fd.IsMap() && fd.MapValue().Kind() == protoreflect.StringKind:
+					expected = "map<uint32, uint32>"
+				case fd.IsMap():
+					expected = fmt.Sprintf("map<uint32, %s>", schemaType(fd.MapValue()))
+				case fd.IsList():
+					expected = "repeated uint32 [packed = false]"
+				default:
+					expected = "optional uint32"
+				}
+			}
+			require.Equal(t, expected, schemaType(index), fd.FullName())
+		}
+	}
+	require.Equal(t, len(InternedFields), changed)
+}
+
+// TestInternedGeneratedCode reads MarshalInterned output with the generated code of results_interned.proto and writes it back
+func TestInternedGeneratedCode(t *testing.T) {
+	for _, chunk := range resultsToProto(GenerateResults(generatorConfig)).Chunks {
+		data, err := MarshalInterned(chunk)
+		require.NoError(t, err)
+		variant := &internedpb.Chunk{}
+		require.NoError(t, variant.UnmarshalVT(data))
+		require.Equal(t, chunk.Tickets[0].Signature, variant.Strings[variant.Tickets[0].GetSignature()])
+		err = protorange.Range(variant.ProtoReflect(), func(values protopath.Values) error {
+			if message, ok := values.Index(-1).Value.Interface().(protoreflect.Message); ok {
+				require.Empty(t, message.GetUnknown(), "%s", values.Path)
+			}
+			return nil
+		})
+		require.NoError(t, err)
+
+		encoded, err := variant.MarshalVT()
+		require.NoError(t, err)
+		decoded := &Chunk{}
+		require.NoError(t, UnmarshalInterned(encoded, decoded))
+		require.True(t, proto.Equal(chunk, decoded))
+	}
+}
+
+func TestInternedRoundTrip(t *testing.T) {
+	for name, results := range map[string]*SearchResults{
+		"dump":      resultsToProto(readDumpStruct()),
+		"generated": resultsToProto(GenerateResults(generatorConfig)),
+		"empty":     {Chunks: []*Chunk{{}}},
+	} {
+		full, interned := 0, 0
+		for _, chunk := range results.Chunks {
+			data, err := MarshalInterned(chunk)
+			require.NoError(t, err, name)
+			decoded := &Chunk{}
+			require.NoError(t, UnmarshalInterned(data, decoded), name)
+			require.True(t, proto.Equal(chunk, decoded), name)
+			full += chunk.SizeVT()
+			interned += len(data)
+		}
+		t.Logf("%s: %d bytes of chunks, %d bytes of interned chunks", name, full, interned)
+		if name != "empty" {
+			require.Less(t, interned, full, name)
+		}
+	}
+}
+
+func TestInternedTable(t *testing.T) {
+	chunk := &Chunk{Tickets: []*Ticket{
+		{Signature: "a", Tags: []string{"direct", "cheap"}},
+		{Signature: "b", Tags: []string{"direct"}},
+	}}
+	data, err := MarshalInterned(chunk)
+	require.NoError(t, err)
+
+	// The most frequent string comes first
+	number, wireType, n := protowire.ConsumeTag(data)
+	require.Equal(t, protowire.Number(InternedStringsField), number)
+	require.Equal(t, protowire.BytesType, wireType)
+	first, _ := protowire.ConsumeString(data[n:])
+	require.Equal(t, "direct", first)
+}
+
+func TestInternedIndexOutOfTable(t *testing.T) {
+	var data []byte
+	data = protowire.AppendTag(data, InternedStringsField, protowire.BytesType)
+	data = protowire.AppendString(data, "signature")
+	ticket := protowire.AppendTag(nil, 3, protowire.VarintType)
+	ticket = protowire.AppendVarint(ticket, 1)
+	data = protowire.AppendTag(data, chunkTicketsField, protowire.BytesType)
+	data = protowire.AppendBytes(data, ticket)
+
+	err := UnmarshalInterned(data, &Chunk{})
+	require.ErrorIs(t, err, ErrInternedIndex)
+}
+
+func BenchmarkInterned(b *testing.B) {
+	for name, results := range map[string]*SearchResults{
+		"Dump":      resultsToProto(readDumpStruct()),
+		"Generated": resultsToProto(GenerateResults(DefaultGeneratorConfig())),
+	} {
+		b.Run(name, func(b *testing.B) {
+			benchmarkChunkSizes(b, results, "interned", eachChunk(MarshalInterned))
+		})
+	}
+}
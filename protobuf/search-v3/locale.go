@@ -0,0 +1,60 @@
+package search_v3
+
+import (
+	"github.com/KosyanMedia/delta/pkg/types/search/base"
+)
+
+// LocaleFilter keeps only the requested locales of localized names. A name missing the locale falls back
+// along Fallbacks chain, e.g. "uk" -> "ru" -> "en", and keeps the locale it is found in,
+// so a client sees that the name is English rather than Russian
+type LocaleFilter struct {
+	// Locales to keep, all of them are kept when empty
+	Locales []string
+	// Fallbacks is the next locale of the chain by locale
+	Fallbacks map[string]string
+}
+
+// DefaultLocaleFallbacks fall back to Russian for CIS languages and to English for Russian
+var DefaultLocaleFallbacks = map[string]string{
+	"uk": "ru",
+	"be": "ru",
+	"kk": "ru",
+	"ru": "en",
+}
+
+// NewLocaleFilter keeps locales with DefaultLocaleFallbacks
+func NewLocaleFilter(locales ...string) LocaleFilter {
+	return LocaleFilter{
+		Locales:   locales,
+		Fallbacks: DefaultLocaleFallbacks,
+	}
+}
+
+// Apply prunes name to the requested locales
+func (f LocaleFilter) Apply(name base.LocalizableContextString) base.LocalizableContextString {
+	if name == nil || len(f.Locales) == 0 {
+		return name
+	}
+	result := make(base.LocalizableContextString, len(f.Locales))
+	for _, locale := range f.Locales {
+		if code, ok := f.resolve(name, locale); ok {
+			result[code] = name[code]
+		}
+	}
+	return result
+}
+
+// resolve finds the first locale of the chain present in name, a cyclic chain is cut after every locale is tried
+func (f LocaleFilter) resolve(name base.LocalizableContextString, locale string) (base.LanguageCode, bool) {
+	for i := 0; i <= len(f.Fallbacks); i++ {
+		if values := name[base.LanguageCode(locale)]; values != nil {
+			return base.LanguageCode(locale), true
+		}
+		next, ok := f.Fallbacks[locale]
+		if !ok {
+			break
+		}
+		locale = next
+	}
+	return "", false
+}
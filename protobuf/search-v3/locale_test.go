@@ -0,0 +1,125 @@
+package search_v3
+
+import (
+	"compress/gzip"
+	"github.com/KosyanMedia/delta/pkg/types/search/base"
+	v3 "github.com/KosyanMedia/delta/search/cmd/results-api/api/v3"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	"go-playground/protobuf/utils"
+	"testing"
+)
+
+func TestLocaleFilter(t *testing.T) {
+	name := base.LocalizableContextString{
+		"en": {"default": "Moscow"},
+		"ru": {"default": "Москва", "genitive": "Москвы"},
+		"de": {"default": "Moskau"},
+		"fr": nil,
+	}
+	cases := []struct {
+		name     string
+		filter   LocaleFilter
+		expected []base.LanguageCode
+	}{
+		{"everything", LocaleFilter{}, []base.LanguageCode{"en", "ru", "de", "fr"}},
+		{"single", NewLocaleFilter("ru"), []base.LanguageCode{"ru"}},
+		{"chain", NewLocaleFilter("uk"), []base.LanguageCode{"ru"}},
+		{"several", NewLocaleFilter("de", "kk"), []base.LanguageCode{"de", "ru"}},
+		{"nil locale falls back", LocaleFilter{Locales: []string{"fr"}, Fallbacks: map[string]string{"fr": "en"}}, []base.LanguageCode{"en"}},
+		{"no fallback", LocaleFilter{Locales: []string{"uk"}}, nil},
+		{"cycle", LocaleFilter{Locales: []string{"uk"}, Fallbacks: map[string]string{"uk": "be", "be": "kk", "kk": "be"}}, nil},
+	}
+	for _, c := range cases {
+		result := c.filter.Apply(name)
+		require.Len(t, result, len(c.expected), c.name)
+		for _, locale := range c.expected {
+			require.Equal(t, name[locale], result[locale], c.name)
+		}
+
+		converted := c.filter.localizableContextStringToProto(name)
+		if len(c.filter.Locales) == 0 {
+			// A nil locale is skipped by the conversion
+			require.Len(t, converted, len(c.expected)-1, c.name)
+			continue
+		}
+		require.Len(t, converted, len(c.expected), c.name)
+		for _, locale := range c.expected {
+			require.Equal(t, name[locale], converted[string(locale)].Map, c.name)
+		}
+	}
+	require.Nil(t, NewLocaleFilter("ru").Apply(nil))
+}
+
+func TestToProtoWithLocales(t *testing.T) {
+	data := GenerateResults(generatorConfig)
+	full := resultsToProto(data)
+	pruned := ToProtoWith(data, ConvertOptions{Locales: NewLocaleFilter("ru")})
+
+	for i, chunk := range pruned.Chunks {
+		for code, airport := range chunk.Places.Airports {
+			require.Len(t, airport.Name, 1, code)
+			require.Equal(t, full.Chunks[i].Places.Airports[code].Name["ru"].Map, airport.Name["ru"].Map, code)
+		}
+		for code, city := range chunk.Places.Cities {
+			require.Len(t, city.Name, 1, code)
+		}
+		for code, country := range chunk.Places.Countries {
+			require.Len(t, country.Name, 1, code)
+		}
+		for code, airline := range chunk.Airlines {
+			require.Len(t, airline.Name, 1, code)
+		}
+		for id, agent := range chunk.Agents {
+			require.LessOrEqual(t, len(agent.Label), 1, id)
+		}
+		chunk.Places, chunk.Airlines, chunk.Agents = nil, nil, nil
+		full.Chunks[i].Places, full.Chunks[i].Airlines, full.Chunks[i].Agents = nil, nil, nil
+	}
+	require.True(t, proto.Equal(full, pruned), "only localized names differ")
+	require.Less(t, ToProtoWith(data, ConvertOptions{Locales: NewLocaleFilter("ru")}).SizeVT(), resultsToProto(data).SizeVT())
+}
+
+var localeFilters = []struct {
+	name   string
+	filter LocaleFilter
+}{
+	{"all", LocaleFilter{}},
+	{"ru_en", NewLocaleFilter("ru", "en")},
+	{"ru", NewLocaleFilter("ru")},
+}
+
+// Sizes are reported for the whole results and for places only, as most of localized names are there
+func BenchmarkLocales_ToProto(b *testing.B) {
+	for _, source := range []struct {
+		name string
+		data v3.SearchResults
+	}{
+		{"Object", readDumpStruct()},
+		{"Generated", GenerateResults(generatorConfig)},
+	} {
+		data := source.data
+		for _, locales := range localeFilters {
+			b.Run(source.name+"/"+locales.name, func(b *testing.B) {
+				opts := ConvertOptions{Locales: locales.filter}
+				b.ReportAllocs()
+				b.ResetTimer()
+
+				var results *SearchResults
+				for i := 0; i < b.N; i++ {
+					results = ToProtoWith(data, opts)
+				}
+				b.StopTimer()
+
+				encoded := utils.Must2(results.MarshalVT())
+				places := 0
+				for _, chunk := range results.Chunks {
+					places += chunk.Places.SizeVT()
+				}
+				b.ReportMetric(float64(len(encoded)), "bytes")
+				b.ReportMetric(float64(len(utils.Must2(utils.CompressGZIP(encoded, gzip.DefaultCompression)))), "gzip-bytes")
+				b.ReportMetric(float64(places), "places-bytes")
+			})
+		}
+	}
+}
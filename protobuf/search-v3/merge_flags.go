@@ -0,0 +1,285 @@
+package search_v3
+
+import (
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"sync"
+)
+
+// MergedTermsInfo is a tree of 64 booleans repeated in every flight term, MergeFlags packs them into bitfields.
+// A chunk with packed flags is encoded with results.proto, but FlightTerm.merged_terms_info is replaced with
+//
+//	message FlightTerm {
+//	  ...
+//	  uint64 merged_flags = 100;  // set whenever merged_terms_info is
+//	  uint32 merged_absent = 101;
+//	}
+
+type MergeFlags struct {
+	// Flags are the booleans of the tree in field order: six TariffMergeInfo of 2x3 bits, then two BaggageMergeInfo of 2x7 bits
+	Flags uint64
+	// Absent marks nil messages of the tree in field order: the root, its 8 children, then their 16 params
+	Absent uint32
+}
+
+const (
+	mergeFlagsField  = 100
+	mergeAbsentField = 101
+	mergedTermsField = 10
+)
+
+// PackMergedTermsInfo packs info, nil one included
+func PackMergedTermsInfo(info *MergedTermsInfo) MergeFlags {
+	w := &mergeFlagsWriter{}
+	w.present(info != nil)
+	for _, tariff := range []*TariffMergeInfo{
+		info.GetSeatAtRegistration(),
+		info.GetSeatAtPurchase(),
+		info.GetReturnBeforeFlight(),
+		info.GetReturnAfterFlight(),
+		info.GetChangeBeforeFlight(),
+		info.GetChangeAfterFlight(),
+	} {
+		w.present(tariff != nil)
+		for _, params := range []*TariffMergeParams{tariff.GetIsFromConfig(), tariff.GetMismatch()} {
+			w.present(params != nil)
+			w.bool(params.GetAvailable(), params.GetPenaltyCurrencyCode(), params.GetPenaltyValue())
+		}
+	}
+	for _, baggage := range []*BaggageMergeInfo{info.GetBaggage(), info.GetHandbags()} {
+		w.present(baggage != nil)
+		for _, params := range []*BaggageMergeParams{baggage.GetIsFromConfig(), baggage.GetMismatch()} {
+			w.present(params != nil)
+			w.bool(params.GetCount(), params.GetWeight(), params.GetTotalWeight(), params.GetHeight(),
+				params.GetLength(), params.GetWidth(), params.GetSumDimension())
+		}
+	}
+	return w.flags
+}
+
+// MergedTermsInfo unpacks the flags packed by PackMergedTermsInfo
+func (f MergeFlags) MergedTermsInfo() *MergedTermsInfo {
+	r := &mergeFlagsReader{flags: f}
+	if !r.present() {
+		return nil
+	}
+	tariff := func() *TariffMergeInfo {
+		present := r.present()
+		params := func() *TariffMergeParams {
+			present := r.present()
+			result := &TariffMergeParams{Available: r.bool(), PenaltyCurrencyCode: r.bool(), PenaltyValue: r.bool()}
+			return presentOrNil(result, present)
+		}
+		result := &TariffMergeInfo{IsFromConfig: params(), Mismatch: params()}
+		return presentOrNil(result, present)
+	}
+	baggage := func() *BaggageMergeInfo {
+		present := r.present()
+		params := func() *BaggageMergeParams {
+			present := r.present()
+			result := &BaggageMergeParams{Count: r.bool(), Weight: r.bool(), TotalWeight: r.bool(), Height: r.bool(),
+				Length: r.bool(), Width: r.bool(), SumDimension: r.bool()}
+			return presentOrNil(result, present)
+		}
+		result := &BaggageMergeInfo{IsFromConfig: params(), Mismatch: params()}
+		return presentOrNil(result, present)
+	}
+	// Struct literals evaluate in order, which is the order of bits
+	return &MergedTermsInfo{
+		SeatAtRegistration: tariff(),
+		SeatAtPurchase:     tariff(),
+		ReturnBeforeFlight: tariff(),
+		ReturnAfterFlight:  tariff(),
+		ChangeBeforeFlight: tariff(),
+		ChangeAfterFlight:  tariff(),
+		Baggage:            baggage(),
+		Handbags:           baggage(),
+	}
+}
+
+func presentOrNil[M any](message *M, present bool) *M {
+	if !present {
+		return nil
+	}
+	return message
+}
+
+type mergeFlagsWriter struct {
+	flags         MergeFlags
+	bit, position int
+}
+
+func (w *mergeFlagsWriter) bool(values ...bool) {
+	for _, value := range values {
+		if value {
+			w.flags.Flags |= 1 << w.bit
+		}
+		w.bit++
+	}
+}
+
+func (w *mergeFlagsWriter) present(present bool) {
+	if !present {
+		w.flags.Absent |= 1 << w.position
+	}
+	w.position++
+}
+
+type mergeFlagsReader struct {
+	flags         MergeFlags
+	bit, position int
+}
+
+func (r *mergeFlagsReader) bool() bool {
+	r.bit++
+	return r.flags.Flags&(1<<(r.bit-1)) != 0
+}
+
+func (r *mergeFlagsReader) present() bool {
+	r.position++
+	return r.flags.Absent&(1<<(r.position-1)) == 0
+}
+
+// MarshalMergeFlags encodes chunk with merged terms info of every flight term packed into MergeFlags
+func MarshalMergeFlags(chunk *Chunk) ([]byte, error) {
+	data, err := chunk.MarshalVT()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return rewriteFlightTerms(nil, chunk.ProtoReflect().Descriptor(), data, packFlightTerm)
+}
+
+// UnmarshalMergeFlags decodes chunk encoded by MarshalMergeFlags
+func UnmarshalMergeFlags(data []byte, chunk *Chunk) error {
+	decoded, err := rewriteFlightTerms(nil, chunk.ProtoReflect().Descriptor(), data, unpackFlightTerm)
+	if err != nil {
+		return err
+	}
+	return errors.WithStack(chunk.UnmarshalVT(decoded))
+}
+
+func packFlightTerm(dst []byte, term []byte) ([]byte, error) {
+	err := consumeFields(term, func(number protowire.Number, wireType protowire.Type, value []byte) error {
+		if number != mergedTermsField || wireType != protowire.BytesType {
+			dst = appendField(dst, number, wireType, value)
+			return nil
+		}
+		info := &MergedTermsInfo{}
+		if err := info.UnmarshalVT(value); err != nil {
+			return errors.WithStack(err)
+		}
+		flags := PackMergedTermsInfo(info)
+		dst = protowire.AppendTag(dst, mergeFlagsField, protowire.VarintType)
+		dst = protowire.AppendVarint(dst, flags.Flags)
+		if flags.Absent != 0 {
+			dst = protowire.AppendTag(dst, mergeAbsentField, protowire.VarintType)
+			dst = protowire.AppendVarint(dst, uint64(flags.Absent))
+		}
+		return nil
+	})
+	return dst, err
+}
+
+func unpackFlightTerm(dst []byte, term []byte) ([]byte, error) {
+	var flags MergeFlags
+	merged := false
+	err := consumeFields(term, func(number protowire.Number, wireType protowire.Type, value []byte) error {
+		switch {
+		case number == mergeFlagsField && wireType == protowire.VarintType:
+			flags.Flags, _ = protowire.ConsumeVarint(value)
+			merged = true
+		case number == mergeAbsentField && wireType == protowire.VarintType:
+			absent, _ := protowire.ConsumeVarint(value)
+			flags.Absent = uint32(absent)
+			merged = true
+		default:
+			dst = appendField(dst, number, wireType, value)
+		}
+		return nil
+	})
+	if err != nil || !merged {
+		return dst, err
+	}
+	return appendMessage(dst, mergedTermsField, flags.MergedTermsInfo())
+}
+
+// rewriteFlightTerms appends message data to dst with every FlightTerm replaced by the result of rewrite
+func rewriteFlightTerms(dst []byte, descriptor protoreflect.MessageDescriptor, data []byte, rewrite func(dst, term []byte) ([]byte, error)) ([]byte, error) {
+	if descriptor.FullName() == flightTermName {
+		return rewrite(dst, data)
+	}
+	fields := descriptor.Fields()
+	err := consumeFields(data, func(number protowire.Number, wireType protowire.Type, value []byte) error {
+		fd := fields.ByNumber(number)
+		if fd == nil || wireType != protowire.BytesType || fd.Message() == nil || !containsFlightTerms(fd.Message()) {
+			dst = appendField(dst, number, wireType, value)
+			return nil
+		}
+		nested, err := rewriteFlightTerms(nil, fd.Message(), value, rewrite)
+		if err != nil {
+			return err
+		}
+		dst = protowire.AppendTag(dst, number, protowire.BytesType)
+		dst = protowire.AppendBytes(dst, nested)
+		return nil
+	})
+	return dst, err
+}
+
+const flightTermName protoreflect.FullName = "search_v3.FlightTerm"
+
+var (
+	// flightTermContainers are the messages FlightTerm is reachable from, computed once to be read concurrently
+	flightTermContainers     map[protoreflect.FullName]bool
+	flightTermContainersOnce sync.Once
+)
+
+func findFlightTermContainers() {
+	result := map[protoreflect.FullName]bool{}
+	var contains func(descriptor protoreflect.MessageDescriptor) bool
+	contains = func(descriptor protoreflect.MessageDescriptor) bool {
+		if descriptor.FullName() == flightTermName {
+			return true
+		}
+		if found, ok := result[descriptor.FullName()]; ok {
+			return found
+		}
+		// Recursive messages don't contain terms until proven otherwise
+		result[descriptor.FullName()] = false
+		found := false
+		fields := descriptor.Fields()
+		for i := 0; i < fields.Len(); i++ {
+			if message := fields.Get(i).Message(); message != nil && contains(message) {
+				found = true
+			}
+		}
+		result[descriptor.FullName()] = found
+		return found
+	}
+	contains((&Chunk{}).ProtoReflect().Descriptor())
+	flightTermContainers = result
+}
+
+func containsFlightTerms(descriptor protoreflect.MessageDescriptor) bool {
+	flightTermContainersOnce.Do(findFlightTermContainers)
+	return descriptor.FullName() == flightTermName || flightTermContainers[descriptor.FullName()]
+}
+
+// appendField appends a field consumed by consumeFields
+func appendField(dst []byte, number protowire.Number, wireType protowire.Type, value []byte) []byte {
+	dst = protowire.AppendTag(dst, number, wireType)
+	if wireType == protowire.BytesType {
+		return protowire.AppendBytes(dst, value)
+	}
+	return append(dst, value...)
+}
+
+func appendMessage(data []byte, number protowire.Number, message interface{ MarshalVT() ([]byte, error) }) ([]byte, error) {
+	encoded, err := message.MarshalVT()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	data = protowire.AppendTag(data, number, protowire.BytesType)
+	return protowire.AppendBytes(data, encoded), nil
+}
@@ -0,0 +1,69 @@
+package search_v3
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"math/rand"
+	"testing"
+)
+
+// mergedTermsInfo sets every flag of the tree to flag() and leaves a message nil when drop() is true
+func mergedTermsInfo(flag, drop func() bool) *MergedTermsInfo {
+	var fill func(m protoreflect.Message)
+	fill = func(m protoreflect.Message) {
+		fields := m.Descriptor().Fields()
+		for i := 0; i < fields.Len(); i++ {
+			fd := fields.Get(i)
+			if fd.Message() == nil {
+				m.Set(fd, protoreflect.ValueOfBool(flag()))
+			} else if !drop() {
+				fill(m.Mutable(fd).Message())
+			}
+		}
+	}
+	info := &MergedTermsInfo{}
+	fill(info.ProtoReflect())
+	return info
+}
+
+func TestMergeFlagsRoundTrip(t *testing.T) {
+	require.Nil(t, PackMergedTermsInfo(nil).MergedTermsInfo())
+	require.True(t, proto.Equal(&MergedTermsInfo{}, PackMergedTermsInfo(&MergedTermsInfo{}).MergedTermsInfo()))
+
+	full := mergedTermsInfo(func() bool { return true }, func() bool { return false })
+	flags := PackMergedTermsInfo(full)
+	require.Equal(t, ^uint64(0), flags.Flags, "64 flags fill the word")
+	require.Zero(t, flags.Absent)
+	require.True(t, proto.Equal(full, flags.MergedTermsInfo()))
+
+	random := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		info := mergedTermsInfo(func() bool { return random.Intn(2) == 0 }, func() bool { return random.Intn(8) == 0 })
+		require.True(t, proto.Equal(info, PackMergedTermsInfo(info).MergedTermsInfo()), "%v", info)
+	}
+}
+
+func TestMergeFlagsChunk(t *testing.T) {
+	for name, results := range map[string]*SearchResults{
+		"dump":      resultsToProto(readDumpStruct()),
+		"generated": resultsToProto(GenerateResults(generatorConfig)),
+	} {
+		full, packed := 0, 0
+		for _, chunk := range results.Chunks {
+			data, err := MarshalMergeFlags(chunk)
+			require.NoError(t, err, name)
+			decoded := &Chunk{}
+			require.NoError(t, UnmarshalMergeFlags(data, decoded), name)
+			require.True(t, proto.Equal(chunk, decoded), name)
+			full += chunk.SizeVT()
+			packed += len(data)
+		}
+		t.Logf("%s: %d bytes of chunks, %d bytes with packed merge flags", name, full, packed)
+		require.Less(t, packed, full, name)
+	}
+}
+
+func BenchmarkMergeFlags(b *testing.B) {
+	benchmarkChunkSizes(b, resultsToProto(GenerateResults(DefaultGeneratorConfig())), "packed", eachChunk(MarshalMergeFlags))
+}
@@ -0,0 +1,60 @@
+package search_v3
+
+import (
+	"github.com/KosyanMedia/delta/pkg/types/search/base"
+	v3 "github.com/KosyanMedia/delta/search/cmd/results-api/api/v3"
+	"github.com/KosyanMedia/delta/search/cmd/results-api/filter"
+	"github.com/stretchr/testify/require"
+	"go-playground/protobuf/utils"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestOptionalDateTimeRange(t *testing.T) {
+	epoch := time.Unix(0, 0).UTC()
+	later := time.Date(2023, 2, 18, 10, 30, 0, 0, time.UTC)
+	for name, original := range map[string]filter.DateTimeRange{
+		"nil":   {},
+		"epoch": {Min: &epoch, Max: &epoch},
+		"min":   {Min: &later},
+		"max":   {Max: &later},
+		"both":  {Min: &epoch, Max: &later},
+	} {
+		converted := dateTimeRangeToProto(original)
+		requireDeepEqual(t, reflect.ValueOf(original), reflect.ValueOf(converted))
+
+		received := &DateTimeRange{}
+		require.NoError(t, received.UnmarshalVT(utils.Must2(converted.MarshalVT())), name)
+		require.Equal(t, original, (&protoConverter{}).protoToDateTimeRange(received), name)
+	}
+}
+
+func TestOptionalPointerBool(t *testing.T) {
+	for _, value := range []string{"null", "true", "false"} {
+		original := utils.Must2(fromJSON[base.PointerBool](value))
+		converted := &AirportInfo{HasTransitZone: pointerBoolToProto(original)}
+		require.Equal(t, original.IsUnknown(), converted.HasTransitZone == nil, value)
+
+		received := &AirportInfo{}
+		require.NoError(t, received.UnmarshalVT(utils.Must2(converted.MarshalVT())), value)
+		require.Equal(t, original, (&protoConverter{}).protoToPointerBool(received.HasTransitZone), value)
+	}
+}
+
+func TestOptionalSearchStartTime(t *testing.T) {
+	for name, start := range map[string]time.Time{
+		"zero":  {},
+		"epoch": time.Unix(0, 0).UTC(),
+		"nanos": time.Date(2023, 2, 18, 10, 30, 15, 123456789, time.UTC),
+	} {
+		original := &v3.DebugInfo{SearchStartTime: start}
+		converted := debugInfoToProto(original)
+		require.Equal(t, start.IsZero(), converted.SearchStartTime == nil, name)
+		requireDeepEqual(t, reflect.ValueOf(original), reflect.ValueOf(converted))
+
+		received := &DebugInfo{}
+		require.NoError(t, received.UnmarshalVT(utils.Must2(converted.MarshalVT())), name)
+		require.Equal(t, start, (&protoConverter{}).protoToDebugInfo(received).SearchStartTime, name)
+	}
+}
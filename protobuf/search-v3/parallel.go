@@ -0,0 +1,76 @@
+package search_v3
+
+import (
+	v3 "github.com/KosyanMedia/delta/search/cmd/results-api/api/v3"
+	"github.com/pkg/errors"
+	"sync"
+	"sync/atomic"
+)
+
+// EncodeChunks converts, marshals and compresses every chunk of results on opts.Workers goroutines
+// and returns the encoded chunks in the order of results. compress may be nil to keep chunks uncompressed,
+// otherwise it must be safe for concurrent use like compress.Compressor
+func EncodeChunks(results v3.SearchResults, opts ConvertOptions, compress func(data []byte) ([]byte, error)) ([][]byte, error) {
+	encoded := make([][]byte, len(results))
+	err := parallel(len(results), opts.Workers, func(i int) error {
+		data, err := chunkToProto(results[i], opts).MarshalVT()
+		if err != nil {
+			return errors.Wrapf(err, "chunk %d", i)
+		}
+		if compress != nil {
+			if data, err = compress(data); err != nil {
+				return errors.Wrapf(err, "chunk %d", i)
+			}
+		}
+		encoded[i] = data
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return encoded, nil
+}
+
+// parallel calls fn for every index below n on at most workers goroutines, serially when workers is less than 2.
+// Indexes are no longer handed out after an error, the error of the lowest index is returned
+func parallel(n, workers int, fn func(i int) error) error {
+	if workers > n {
+		workers = n
+	}
+	if workers < 2 {
+		for i := 0; i < n; i++ {
+			if err := fn(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	errs := make([]error, n)
+	var next int64 = -1
+	var failed int32
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for atomic.LoadInt32(&failed) == 0 {
+				i := int(atomic.AddInt64(&next, 1))
+				if i >= n {
+					return
+				}
+				if errs[i] = fn(i); errs[i] != nil {
+					atomic.StoreInt32(&failed, 1)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
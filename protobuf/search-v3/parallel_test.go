@@ -0,0 +1,151 @@
+package search_v3
+
+import (
+	"compress/gzip"
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"go-playground/protobuf/compress"
+	"go-playground/protobuf/utils"
+	"runtime"
+	"sync/atomic"
+	"testing"
+)
+
+// Run with -race, the chunks share nothing but the options
+func TestToProtoWorkers(t *testing.T) {
+	config := generatorConfig
+	config.Chunks = 8
+	results := GenerateResults(config)
+	serial := resultsToProto(results)
+	for _, workers := range []int{-1, 0, 1, 2, 3, 8, 100} {
+		converted := ToProtoWith(results, ConvertOptions{Workers: workers})
+		require.True(t, proto.Equal(serial, converted), "workers %d", workers)
+	}
+}
+
+func TestEncodeChunks(t *testing.T) {
+	config := generatorConfig
+	config.Chunks = 8
+	results := GenerateResults(config)
+	serial := resultsToProto(results)
+	compressor := compress.Gzip.Level(gzip.DefaultCompression)
+	for _, workers := range []int{1, 3, 8, 100} {
+		encoded, err := EncodeChunks(results, ConvertOptions{Workers: workers}, compressor.Compress)
+		require.NoError(t, err)
+		require.Len(t, encoded, len(serial.Chunks))
+		for i, data := range encoded {
+			decoded := &Chunk{}
+			require.NoError(t, decoded.UnmarshalVT(requireDecompress(t, compressor, data)))
+			require.True(t, proto.Equal(serial.Chunks[i], decoded), "workers %d, chunk %d", workers, i)
+		}
+	}
+
+	encoded, err := EncodeChunks(results, ConvertOptions{Workers: 4}, nil)
+	require.NoError(t, err)
+	for i, data := range encoded {
+		require.Equal(t, serial.Chunks[i].SizeVT(), len(data), "uncompressed chunk %d", i)
+	}
+	encoded, err = EncodeChunks(nil, ConvertOptions{Workers: 4}, nil)
+	require.NoError(t, err)
+	require.Empty(t, encoded)
+}
+
+func TestEncodeChunksError(t *testing.T) {
+	results := GenerateResults(generatorConfig)
+	failure := errors.New("compression failed")
+	encoded, err := EncodeChunks(results, ConvertOptions{Workers: 2}, func(data []byte) ([]byte, error) {
+		return nil, failure
+	})
+	require.Nil(t, encoded)
+	require.ErrorIs(t, err, failure)
+}
+
+func TestParallel(t *testing.T) {
+	var running, peak int32
+	calls := make([]int32, 100)
+	require.NoError(t, parallel(len(calls), 4, func(i int) error {
+		current := atomic.AddInt32(&running, 1)
+		for {
+			highest := atomic.LoadInt32(&peak)
+			if current <= highest || atomic.CompareAndSwapInt32(&peak, highest, current) {
+				break
+			}
+		}
+		runtime.Gosched()
+		atomic.AddInt32(&calls[i], 1)
+		atomic.AddInt32(&running, -1)
+		return nil
+	}))
+	require.LessOrEqual(t, peak, int32(4))
+	for i, count := range calls {
+		require.Equal(t, int32(1), count, "index %d", i)
+	}
+
+	// The error of the lowest failed index wins whatever finishes first
+	err := parallel(100, 8, func(i int) error {
+		if i%10 == 7 {
+			return fmt.Errorf("index %d", i)
+		}
+		return nil
+	})
+	require.EqualError(t, err, "index 7")
+
+	var handed int32
+	require.Error(t, parallel(1000, 2, func(i int) error {
+		atomic.AddInt32(&handed, 1)
+		return errors.New("stop")
+	}))
+	require.Less(t, handed, int32(1000), "indexes aren't handed out after an error")
+}
+
+func requireDecompress(t *testing.T, compressor compress.Compressor, data []byte) []byte {
+	decompressed, err := compressor.Decompress(data)
+	require.NoError(t, err)
+	return decompressed
+}
+
+// Run with -cpu 1,2,4,8 to see the scaling, the parallel cases use GOMAXPROCS workers
+func BenchmarkEncodeChunks(b *testing.B) {
+	config := DefaultGeneratorConfig()
+	config.Chunks = 16
+	results := GenerateResults(config)
+	compressor := compress.Gzip.Level(gzip.DefaultCompression)
+	for _, bench := range []struct {
+		name    string
+		workers func() int
+	}{
+		{"Serial", func() int { return 1 }},
+		{"GOMAXPROCS", func() int { return runtime.GOMAXPROCS(0) }},
+	} {
+		b.Run(bench.name, func(b *testing.B) {
+			opts := ConvertOptions{Workers: bench.workers()}
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				if _, err := EncodeChunks(results, opts, compressor.Compress); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// Same as BenchmarkObject_Convert_MarshalVTProto_GZipDefault with a chunk per worker
+func BenchmarkObject_Convert_MarshalVTProto_GZipDefault_Parallel(b *testing.B) {
+	originalStruct := readDumpStruct()
+	compressGZIP := func(data []byte) ([]byte, error) {
+		return utils.CompressGZIP(data, gzip.DefaultCompression)
+	}
+	opts := ConvertOptions{Workers: runtime.GOMAXPROCS(0)}
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := EncodeChunks(originalStruct, opts, compressGZIP); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
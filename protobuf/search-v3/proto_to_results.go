@@ -0,0 +1,1048 @@
+package search_v3
+
+import (
+	"encoding/json"
+	"github.com/KosyanMedia/delta/pkg/currency"
+	"github.com/KosyanMedia/delta/pkg/iata"
+	"github.com/KosyanMedia/delta/pkg/types/datetime"
+	"github.com/KosyanMedia/delta/pkg/types/search/base"
+	"github.com/KosyanMedia/delta/pkg/types/search/delta"
+	v3 "github.com/KosyanMedia/delta/search/cmd/results-api/api/v3"
+	"github.com/KosyanMedia/delta/search/cmd/results-api/filter"
+	"github.com/KosyanMedia/delta/search/cmd/results-api/filter/boundaries"
+	"github.com/KosyanMedia/delta/search/cmd/results-api/filter/times"
+	"github.com/KosyanMedia/delta/search/cmd/results-api/filter/transfers"
+	"github.com/pkg/errors"
+	"go-playground/protobuf/conv"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"strconv"
+	"time"
+)
+
+// FromProto converts proto representation back into search results,
+// values which can't be restored like malformed dates are left zero, see FromProtoE
+func FromProto(results *SearchResults) v3.SearchResults {
+	return (&protoConverter{}).protoToResults(results)
+}
+
+// FromProtoE converts like FromProto and fails when a value can't be restored
+func FromProtoE(results *SearchResults) (v3.SearchResults, error) {
+	c := &protoConverter{}
+	converted := c.protoToResults(results)
+	if c.err != nil {
+		return nil, c.err
+	}
+	return converted, nil
+}
+
+// protoConverter keeps the first value which can't be restored, the conversion goes on with the zero value
+type protoConverter struct {
+	err error
+}
+
+func (c *protoConverter) fail(err error) {
+	if c.err == nil && err != nil {
+		c.err = err
+	}
+}
+
+// int converts ids, indexes and counts, which don't fit int on 32-bit platforms only
+func (c *protoConverter) int(v int64) int {
+	converted, err := conv.Int[int64, int](v)
+	c.fail(err)
+	return converted
+}
+
+func (c *protoConverter) ints(from []int64) []int {
+	converted, err := conv.ArrayE(from, conv.Int[int64, int])
+	c.fail(err)
+	return converted
+}
+
+// protoToResults is the inverse of resultsToProto
+func (c *protoConverter) protoToResults(results *SearchResults) v3.SearchResults {
+	if results == nil {
+		return nil
+	}
+	return c.protoToChunks(results.Chunks)
+}
+
+func (c *protoConverter) protoToChunks(chunks []*Chunk) []*v3.Chunk {
+	if chunks == nil {
+		return nil
+	}
+	result := make([]*v3.Chunk, len(chunks))
+	for i, chunk := range chunks {
+		result[i] = c.protoToChunk(chunk)
+	}
+	return result
+}
+
+func (c *protoConverter) protoToChunk(chunk *Chunk) *v3.Chunk {
+	if chunk == nil {
+		return nil
+	}
+	result := &v3.Chunk{
+		ChunkID:                              chunk.ChunkId,
+		LastUpdateTimestamp:                  chunk.LastUpdateTimestamp,
+		DebugInfo:                            c.protoToDebugInfo(chunk.DebugInfo),
+		Tickets:                              conv.Array(chunk.Tickets, c.protoToTicket),
+		SoftTickets:                          c.protoToSoftResponse(chunk.SoftTickets),
+		BrandTicket:                          c.protoToTicketOpt(chunk.BrandTicket),
+		BrandTickets:                         conv.Map(chunk.BrandTickets, c.int, c.protoToTicket),
+		CheapestTicket:                       c.protoToTicketOpt(chunk.CheapestTicket),
+		FilteredCheapestTicket:               c.protoToTicketOpt(chunk.FilteredCheapestTicket),
+		CheapestTicketWithoutAirportPreCheck: c.protoToTicketOpt(chunk.CheapestTicketWithoutAirportPrecheck),
+		DirectFlights:                        conv.Array(chunk.DirectFlights, c.protoToDirectFlights),
+		FlightLegs:                           conv.Array(chunk.FlightLegs, c.protoToFlightLeg),
+		Airlines:                             conv.Map(chunk.Airlines, conv.FromString[iata.AirlineID], c.protoToAirlineInfo),
+		Places:                               c.protoToPlaces(chunk.Places),
+		Agents:                               conv.Map(chunk.Agents, c.int, c.protoToAgentInfo),
+		Alliances:                            conv.Map(chunk.Alliances, c.int, c.protoToAlliance),
+		Equipments:                           conv.Map(chunk.Equipments, conv.Same[string], c.protoToEquipment),
+		DegradedFilterBoundaries:             c.protoToDegradedBoundaries(chunk.DegradedFilterBoundaries),
+		FilterBoundaries:                     c.protoToBoundaries(chunk.FilterBoundaries),
+		FilterState:                          c.protoToFilterState(chunk.FilterState),
+		Order:                                v3.Order(chunk.Order),
+		Brand:                                v3.Brand(chunk.Brand),
+	}
+
+	if params := chunk.SearchParams; params != nil {
+		result.SearchParams.Passengers.Adults = params.GetPassengers().GetAdults()
+		result.SearchParams.Passengers.Children = params.GetPassengers().GetChildren()
+		result.SearchParams.Passengers.Infants = params.GetPassengers().GetInfants()
+		result.SearchParams.TripClass = base.TripClass(params.TripClass)
+		result.SearchParams.SourceKind = base.SourceKind(params.SourceKind)
+		result.SearchParams.Experiments = params.Experiments
+		result.SearchParams.PaymentOptions = conv.Array(params.PaymentOptions, conv.FromString[v3.PaymentOption])
+	}
+
+	if meta := chunk.Meta; meta != nil {
+		result.Meta.FilteredTicketsCount = c.int(meta.FilteredTicketsCount)
+		result.Meta.TotalTicketsCount = c.int(meta.TotalTicketsCount)
+		result.Meta.DirectTicketsCount = c.int(meta.DirectTicketsCount)
+	}
+	return result
+}
+
+func (c *protoConverter) protoToDebugInfo(info *DebugInfo) *v3.DebugInfo {
+	if info == nil {
+		return nil
+	}
+	return &v3.DebugInfo{
+		ServerName:      info.ServerName,
+		DataCenter:      info.DataCenter,
+		Gates:           c.protoToGates(info.Gates),
+		FromCache:       info.FromCache,
+		SearchStartTime: c.protoToTime(info.GetSearchStartTime()),
+	}
+}
+
+func (c *protoConverter) protoToGates(gates map[string]*GateDebugInfo) map[v3.GateName]v3.GateDebugInfo {
+	if gates == nil {
+		return nil
+	}
+	result := make(map[v3.GateName]v3.GateDebugInfo, len(gates))
+	for name, info := range gates {
+		result[name] = v3.GateDebugInfo{
+			Name:                    info.GetName(),
+			Agents:                  c.protoToAgents(info.GetAgents()),
+			ResponseDurationSeconds: info.GetResponseDurationSeconds(),
+			Errors:                  info.GetErrors(),
+			FromCache:               info.GetFromCache(),
+			CacheSearchID:           info.GetCacheSearchUuid(),
+			CacheSearchCreatedAt:    info.GetCacheSearchCreatedAt(),
+		}
+	}
+	return result
+}
+
+func (c *protoConverter) protoToAgents(agents map[int64]*AgentDebugInfo) map[int]v3.AgentDebugInfo {
+	if agents == nil {
+		return nil
+	}
+	result := make(map[int]v3.AgentDebugInfo, len(agents))
+	for id, info := range agents {
+		result[c.int(id)] = v3.AgentDebugInfo{
+			Proposals:                c.protoToProposalsMap(info.GetProposals()),
+			ProposalsCount:           c.int(info.GetProposalsCount()),
+			BadProposals:             conv.Map(info.GetBadProposals(), conv.Same[string], c.int),
+			FilteredProposals:        conv.Map(info.GetFilteredProposals(), conv.Same[string], c.protoToProposals),
+			MergedFlightTermsSources: conv.Map(info.GetMergedFlightTermsSources(), conv.Same[string], c.int),
+		}
+	}
+	return result
+}
+
+func (c *protoConverter) protoToProposalsMap(proposals map[string]*ProposalDebugInfo) map[v3.ProposalID]v3.ProposalDebugInfo {
+	if proposals == nil {
+		return nil
+	}
+	result := make(map[v3.ProposalID]v3.ProposalDebugInfo, len(proposals))
+	for id, info := range proposals {
+		result[id] = v3.ProposalDebugInfo{
+			AgencyPrice:  c.protoToAmount(info.GetAgencyPrice()),
+			Multiplier:   info.GetMultiplier(),
+			Productivity: info.GetProductivity(),
+			FlightTerms:  c.protoToFlightTermsDebugInfo(info.GetFlightTerms()),
+			Cashback:     c.protoToCashbackDebugInfo(info.GetCashback()),
+		}
+	}
+	return result
+}
+
+func (c *protoConverter) protoToAmountOpt(amount *Amount) *currency.Amount {
+	return conv.OptRef(amount, c.protoToAmount)
+}
+
+func (c *protoConverter) protoToAmount(amount *Amount) currency.Amount {
+	if amount == nil {
+		return currency.Amount{}
+	}
+	return currency.Amount{
+		CurrencyCode: c.protoToCurrencyCode(amountCurrencyCode(amount)),
+		Value:        amount.Value,
+	}
+}
+
+// amountCurrencyCode is the ISO code of the currency, empty for CURRENCY_UNSPECIFIED without a fallback
+func amountCurrencyCode(amount *Amount) string {
+	if amount.GetCurrencyCode() == Currency_CURRENCY_UNSPECIFIED {
+		return amount.GetCurrencyIsoCode()
+	}
+	return amount.GetCurrencyCode().String()
+}
+
+func (c *protoConverter) protoToFlightTermsDebugInfo(terms map[int64]*FlightTermDebugInfo) map[v3.FlightLegIndex]v3.FlightTermDebugInfo {
+	if terms == nil {
+		return nil
+	}
+	result := make(map[v3.FlightLegIndex]v3.FlightTermDebugInfo, len(terms))
+	for index, info := range terms {
+		result[v3.FlightLegIndex(index)] = v3.FlightTermDebugInfo{
+			BaggageSource:      v3.TermSource(info.GetBaggageSource()),
+			HandbagsSource:     v3.TermSource(info.GetHandbagsSource()),
+			GateTechnicalStops: conv.Array(info.GetGateTechnicalStops(), c.protoToTechnicalStop),
+		}
+	}
+	return result
+}
+
+func (c *protoConverter) protoToTechnicalStopOpt(stop *TechnicalStop) *delta.TechnicalStop {
+	return conv.OptRef(stop, c.protoToTechnicalStop)
+}
+
+func (c *protoConverter) protoToTechnicalStop(stop *TechnicalStop) delta.TechnicalStop {
+	return delta.TechnicalStop{
+		AirportCode: iata.LocationIATACode(stop.GetAirportCode()),
+	}
+}
+
+func (c *protoConverter) protoToCashbackDebugInfo(info *CashbackDebugInfo) *v3.CashbackDebugInfo {
+	if info == nil {
+		return nil
+	}
+	return &v3.CashbackDebugInfo{
+		Amount:          c.protoToAmountOpt(info.Amount),
+		LocalizedAmount: c.protoToAmountOpt(info.LocalizedAmount),
+		Available:       info.Available,
+	}
+}
+
+func (c *protoConverter) protoToProposals(proposals *Proposals) []v3.Proposal {
+	if proposals == nil {
+		return nil
+	}
+	return conv.Array(proposals.Proposals, c.protoToProposal)
+}
+
+func (c *protoConverter) protoToProposal(proposal *Proposal) v3.Proposal {
+	return v3.Proposal{
+		ID:                proposal.GetId(),
+		Price:             c.protoToAmount(proposal.GetPrice()),
+		PricePerPerson:    c.protoToAmount(proposal.GetPricePerPerson()),
+		AgentID:           c.int(proposal.GetAgentId()),
+		FlightTerms:       conv.Map(proposal.GetFlightTerms(), c.int, c.protoToFlightTerm),
+		TransferTerms:     c.protoToTransferTerms(proposal.GetTransferTerms()),
+		UnifiedPrice:      c.protoToAmount(proposal.GetUnifiedPrice()),
+		Options:           c.protoToProposalOptions(proposal.GetOptions()),
+		Weight:            proposal.GetWeight(),
+		FromMainAirline:   proposal.GetFromMainAirline(),
+		Tags:              proposal.GetTags(),
+		MinimumFare:       c.protoToFare(proposal.GetMinimumFare()),
+		IsWarmcache:       proposal.GetIsWarmcache(),
+		Cashback:          c.protoToCashback(proposal.GetCashback()),
+		CashbackPerPerson: c.protoToCashback(proposal.GetCashbackPerPerson()),
+		AcceptedCards:     conv.Array(proposal.GetAcceptedCards(), c.protoToAcceptedCard),
+	}
+}
+
+func (c *protoConverter) protoToFlightTerm(term *FlightTerm) v3.FlightTerm {
+	return v3.FlightTerm{
+		FareCode:                   base.FareCode(term.GetFareCode()),
+		TripClass:                  base.TripClass(term.GetTripClass()),
+		SeatsAvailable:             conv.Cast[int32, int](term.GetSeatsAvailable()),
+		MarketingCarrierDesignator: c.protoToFlightDesignatorOpt(term.GetMarketingCarrierDesignator()),
+		Baggage:                    c.protoToBaggage(term.GetBaggage()),
+		Handbags:                   c.protoToBaggage(term.GetHandbags()),
+		AdditionalTariffInfo:       c.protoToAdditionalTariffInfo(term.GetAdditionalTariffInfo()),
+		IsCharter:                  term.GetIsCharter(),
+		Tags:                       term.GetTags(),
+		MergedTermsInfo:            c.protoToMergedTermsInfo(term.GetMergedTermsInfo()),
+		MergedFromOtherProposals:   conv.Map(term.GetMergedFromOtherProposals(), conv.Same[string], c.int),
+	}
+}
+
+func (c *protoConverter) protoToFlightDesignatorOpt(fd *FlightDesignator) *base.FlightDesignator {
+	return conv.OptRef(fd, c.protoToFlightDesignator)
+}
+
+func (c *protoConverter) protoToFlightDesignator(fd *FlightDesignator) base.FlightDesignator {
+	return base.FlightDesignator{
+		Carrier:   iata.AirlineID(fd.GetCarrier()),
+		AirlineID: iata.AirlineID(fd.GetAirlineId()),
+		Number:    base.FlightNumber(fd.GetNumber()),
+	}
+}
+
+func (c *protoConverter) protoToAdditionalTariffInfo(ti *AdditionalTariffInfo) *v3.AdditionalTariffInfo {
+	if ti == nil {
+		return nil
+	}
+	return &v3.AdditionalTariffInfo{
+		SeatAtPurchaseInfo:     c.protoToTariffInfo(ti.SeatAtPurchaseInfo),
+		SeatAtRegistrationInfo: c.protoToTariffInfo(ti.SeatAtRegistrationInfo),
+		ReturnBeforeFlight:     c.protoToTariffInfo(ti.ReturnBeforeFlight),
+		ReturnAfterFlight:      c.protoToTariffInfo(ti.ReturnAfterFlight),
+		ChangeBeforeFlight:     c.protoToTariffInfo(ti.ChangeBeforeFlight),
+		ChangeAfterFlight:      c.protoToTariffInfo(ti.ChangeAfterFlight),
+		FareName:               ti.FareName,
+		Miles:                  ti.Miles,
+	}
+}
+
+func (c *protoConverter) protoToMergedTermsInfo(mti *MergedTermsInfo) v3.MergedTermsInfo {
+	return v3.MergedTermsInfo{
+		SeatAtRegistration: c.protoToTariffMergeInfo(mti.GetSeatAtRegistration()),
+		SeatAtPurchase:     c.protoToTariffMergeInfo(mti.GetSeatAtPurchase()),
+		ReturnBeforeFlight: c.protoToTariffMergeInfo(mti.GetReturnBeforeFlight()),
+		ReturnAfterFlight:  c.protoToTariffMergeInfo(mti.GetReturnAfterFlight()),
+		ChangeBeforeFlight: c.protoToTariffMergeInfo(mti.GetChangeBeforeFlight()),
+		ChangeAfterFlight:  c.protoToTariffMergeInfo(mti.GetChangeAfterFlight()),
+		Baggage:            c.protoToBaggageMergeInfo(mti.GetBaggage()),
+		Handbags:           c.protoToBaggageMergeInfo(mti.GetHandbags()),
+	}
+}
+
+func (c *protoConverter) protoToTariffMergeInfo(info *TariffMergeInfo) v3.TariffMergeInfo {
+	return v3.TariffMergeInfo{
+		IsFromConfig: c.protoToTariffMergeParams(info.GetIsFromConfig()),
+		Mismatch:     c.protoToTariffMergeParams(info.GetMismatch()),
+	}
+}
+
+func (c *protoConverter) protoToTariffMergeParams(tmp *TariffMergeParams) v3.TariffMergeParams {
+	return v3.TariffMergeParams{
+		Available:           tmp.GetAvailable(),
+		PenaltyCurrencyCode: tmp.GetPenaltyCurrencyCode(),
+		PenaltyValue:        tmp.GetPenaltyValue(),
+	}
+}
+
+func (c *protoConverter) protoToBaggageMergeInfo(info *BaggageMergeInfo) v3.BaggageMergeInfo {
+	return v3.BaggageMergeInfo{
+		IsFromConfig: c.protoToBaggageMergeParams(info.GetIsFromConfig()),
+		Mismatch:     c.protoToBaggageMergeParams(info.GetMismatch()),
+	}
+}
+
+func (c *protoConverter) protoToBaggageMergeParams(bmp *BaggageMergeParams) v3.BaggageMergeParams {
+	return v3.BaggageMergeParams{
+		Count:        bmp.GetCount(),
+		Weight:       bmp.GetWeight(),
+		TotalWeight:  bmp.GetTotalWeight(),
+		Height:       bmp.GetHeight(),
+		Length:       bmp.GetLength(),
+		Width:        bmp.GetWidth(),
+		SumDimension: bmp.GetSumDimension(),
+	}
+}
+
+func (c *protoConverter) protoToTransferTerms(terms []*TransferTerms) [][]v3.TransferTerm {
+	return conv.Array(terms, func(v1 *TransferTerms) []v3.TransferTerm {
+		if v1 == nil {
+			return nil
+		}
+		return conv.Array(v1.Terms, func(v1 *TransferTerm) v3.TransferTerm {
+			return v3.TransferTerm{
+				IsVirtualInterline: v1.GetIsVirtualInterline(),
+				Tags:               v1.GetTags(),
+			}
+		})
+	})
+}
+
+func (c *protoConverter) protoToProposalOptions(opts *ProposalOptions) *v3.ProposalOptions {
+	if opts == nil {
+		return nil
+	}
+	if opts.Hotel == nil {
+		return &v3.ProposalOptions{}
+	}
+	return &v3.ProposalOptions{
+		Hotel: &v3.Hotel{
+			Name:     opts.Hotel.Name,
+			Stars:    opts.Hotel.Stars,
+			RoomType: opts.Hotel.RoomType,
+			Meals:    opts.Hotel.Meals,
+		},
+	}
+}
+
+func (c *protoConverter) protoToFare(fare *Fare) v3.Fare {
+	if fare == nil {
+		return v3.Fare{}
+	}
+	return v3.Fare{
+		Code:               fare.Code,
+		Baggage:            c.protoToBaggage(fare.Baggage),
+		Handbags:           c.protoToBaggage(fare.Handbags),
+		ReturnBeforeFlight: c.protoToTariffInfo(fare.ReturnBeforeFlight),
+		ReturnAfterFlight:  c.protoToTariffInfo(fare.ReturnAfterFlight),
+		ChangeBeforeFlight: c.protoToTariffInfo(fare.ChangeBeforeFlight),
+		ChangeAfterFlight:  c.protoToTariffInfo(fare.ChangeAfterFlight),
+		SeatAtPurchase:     c.protoToTariffInfo(fare.SeatAtPurchase),
+		SeatAtRegistration: c.protoToTariffInfo(fare.SeatAtRegistration),
+		FareName:           fare.FareName,
+		Miles:              fare.Miles,
+	}
+}
+
+func (c *protoConverter) protoToBaggage(baggage *Baggage) *base.Baggage {
+	if baggage == nil {
+		return nil
+	}
+	return &base.Baggage{
+		Count:        c.int(baggage.Count),
+		Weight:       baggage.Weight,
+		TotalWeight:  baggage.TotalWeight,
+		Length:       baggage.Length,
+		Width:        baggage.Width,
+		Height:       baggage.Height,
+		SumDimension: baggage.SumDimension,
+	}
+}
+
+func (c *protoConverter) protoToTariffInfo(info *TariffInfo) *v3.TariffInfo {
+	if info == nil {
+		return nil
+	}
+	return &v3.TariffInfo{
+		Available:    info.Available,
+		Penalty:      c.protoToAmountOpt(info.Penalty),
+		IsFromConfig: info.IsFromConfig,
+	}
+}
+
+func (c *protoConverter) protoToCashback(cashback *Cashback) *v3.Cashback {
+	if cashback == nil {
+		return nil
+	}
+	return &v3.Cashback{
+		LocalizedAmount: c.protoToAmountOpt(cashback.LocalizedAmount),
+		Available:       cashback.Available,
+	}
+}
+
+func (c *protoConverter) protoToAcceptedCard(card *AcceptedCard) v3.AcceptedCard {
+	return v3.AcceptedCard{
+		Region: card.GetRegion(),
+		System: card.GetSystem(),
+	}
+}
+
+func (c *protoConverter) protoToTicket(ticket *Ticket) v3.Ticket {
+	var proposals []v3.Proposal
+	if ticket.GetProposals() != nil {
+		proposals = c.protoToProposals(&Proposals{Proposals: ticket.Proposals})
+	}
+	return v3.Ticket{
+		Segments:   conv.Array(ticket.GetSegments(), c.protoToSegment),
+		Proposals:  proposals,
+		Signature:  ticket.GetSignature(),
+		Popularity: ticket.GetPopularity(),
+		Score:      ticket.GetScore(),
+		HashSum:    ticket.GetHashsum(),
+		Tags:       ticket.GetTags(),
+		Badges:     conv.Array(ticket.GetBadges(), c.protoToBadgeInfo),
+		ExtraFares: conv.Map(ticket.GetExtraFares(), conv.Same[string], c.protoToFareProposals),
+		FilteredBy: ticket.GetFilteredBy(),
+	}
+}
+
+func (c *protoConverter) protoToTicketOpt(ticket *Ticket) *v3.Ticket {
+	return conv.OptRef(ticket, c.protoToTicket)
+}
+
+func (c *protoConverter) protoToSegment(segment *Segment) v3.Segment {
+	return v3.Segment{
+		FlightLegs: c.ints(segment.GetFlights()),
+		Transfers:  conv.Array(segment.GetTransfers(), c.protoToTransfer),
+		Tags:       segment.GetTags(),
+	}
+}
+
+func (c *protoConverter) protoToTransfer(transfer *Transfer) v3.Transfer {
+	result := v3.Transfer{
+		RecheckBaggage: transfer.GetRecheckBaggage(),
+		NightTransfer:  transfer.GetNightTransfer(),
+		Tags:           transfer.GetTags(),
+	}
+	result.VisaRules.Required = transfer.GetVisaRules().GetRequired()
+	return result
+}
+
+func (c *protoConverter) protoToBadgeInfo(info *BadgeInfo) v3.BadgeInfo {
+	result := v3.BadgeInfo{
+		Type:   info.GetType(),
+		Scores: info.GetScores(),
+	}
+	meta := info.GetMeta()
+	result.Meta.Name = conv.Map(meta.GetName(), conv.FromString[base.LanguageCode], conv.Same[string])
+	result.Meta.Priority = c.int(meta.GetPriority())
+	result.Meta.Position = c.int(meta.GetPosition())
+	result.Meta.Limit = c.int(meta.GetLimit())
+	result.Meta.Colors.Light = meta.GetColors().GetLight()
+	result.Meta.Colors.Dark = meta.GetColors().GetDark()
+	return result
+}
+
+func (c *protoConverter) protoToFareProposals(proposals *FareProposals) []v3.FareProposal {
+	if proposals == nil {
+		return nil
+	}
+	return conv.Array(proposals.Proposals, func(v1 *FareProposal) v3.FareProposal {
+		return v3.FareProposal{
+			ID:    v1.GetProposalId(),
+			Index: c.int(v1.GetIndex()),
+		}
+	})
+}
+
+func (c *protoConverter) protoToSoftResponse(response *SoftResponse) *v3.SoftResponse {
+	if response == nil {
+		return nil
+	}
+	return &v3.SoftResponse{
+		FiltersApplied: response.FiltersApplied,
+		Tickets:        conv.Array(response.Tickets, c.protoToTicket),
+	}
+}
+
+func (c *protoConverter) protoToDirectFlights(df *DirectFlights) v3.DirectFlights {
+	return v3.DirectFlights{
+		Carrier:        df.GetCarrier(),
+		Carriers:       df.GetCarriers(),
+		CheapestTicket: c.protoToTicket(df.GetCheapestTicket()),
+		Schedule: conv.Array(df.GetSchedule(), func(v1 *ScheduleList) []v3.Schedule {
+			if v1 == nil {
+				return nil
+			}
+			return conv.Array(v1.List, func(v1 *Schedule) v3.Schedule {
+				return v3.Schedule{
+					Time:              v1.GetTime(),
+					DateTime:          v1.GetDatetime(),
+					TicketsSignatures: v1.GetTicketsSignatures(),
+				}
+			})
+		}),
+	}
+}
+
+func (c *protoConverter) protoToFlightLeg(leg *FlightLeg) v3.FlightLeg {
+	return v3.FlightLeg{
+		Origin:                     iata.LocationIATACode(leg.GetOrigin()),
+		Destination:                iata.LocationIATACode(leg.GetDestination()),
+		LocalDepartureDateTime:     c.protoToDateTime(leg.GetLocalDepartureDateTime()),
+		LocalArrivalDateTime:       c.protoToDateTime(leg.GetLocalArrivalDateTime()),
+		DepartureUnixTimestamp:     leg.GetDepartureUnixTimestamp(),
+		ArrivalUnixTimestamp:       leg.GetArrivalUnixTimestamp(),
+		OperatingCarrierDesignator: c.protoToFlightDesignator(leg.GetOperatingCarrierDesignator()),
+		Equipment:                  c.protoToBaseEquipment(leg.GetEquipment()),
+		TechnicalStops:             conv.Array(leg.GetTechnicalStops(), c.protoToTechnicalStopOpt),
+		Signature:                  leg.GetSignature(),
+		Tags:                       leg.GetTags(),
+	}
+}
+
+func (c *protoConverter) protoToEquipment(equipment *Equipment) v3.Equipment {
+	return v3.Equipment{
+		Code: equipment.GetCode(),
+		Type: base.EquipmentType(equipment.GetType()),
+		Name: equipment.GetName(),
+	}
+}
+
+func (c *protoConverter) protoToBaseEquipment(equipment *Equipment) base.Equipment {
+	return base.Equipment{
+		Code: base.EquipmentCode(equipment.GetCode()),
+		Type: base.EquipmentType(equipment.GetType()),
+		Name: equipment.GetName(),
+	}
+}
+
+func (c *protoConverter) protoToAirlineInfo(info *AirlineInfo) v3.AirlineInfo {
+	return v3.AirlineInfo{
+		IATA:       iata.AirlineID(info.GetIata()),
+		IsLowcost:  info.GetIsLowcost(),
+		Name:       c.protoToLocalizableContextString(info.GetName()),
+		AllianceID: c.int(info.GetAllianceId()),
+		SiteName:   info.GetSiteName(),
+		BrandColor: info.GetBrandColor(),
+	}
+}
+
+func (c *protoConverter) protoToLocalizableContextString(name map[string]*MapStringString) base.LocalizableContextString {
+	if name == nil {
+		return nil
+	}
+
+	result := make(base.LocalizableContextString, len(name))
+	for k1, v1 := range name {
+		if v1 != nil {
+			result[base.LanguageCode(k1)] = v1.Map
+		}
+	}
+	return result
+}
+
+func (c *protoConverter) protoToPlaces(places *Places) base.Places {
+	if places == nil {
+		return base.Places{}
+	}
+	return base.Places{
+		Airports: conv.Map(places.Airports, conv.FromString[iata.LocationIATACode],
+			func(v1 *AirportInfo) base.AirportInfo {
+				result := base.AirportInfo{
+					Name:                c.protoToLocalizableContextString(v1.GetName()),
+					Code:                iata.LocationIATACode(v1.GetCode()),
+					CityCode:            iata.LocationIATACode(v1.GetCityCode()),
+					MetroAreaCode:       iata.LocationIATACode(v1.GetMetroAreaCode()),
+					HasTransitZone:      c.protoToPointerBool(v1.HasTransitZone),
+					TransitWorkHoursMin: c.int(v1.GetTransitWorkHoursMin()),
+					TransitWorkHoursMax: c.int(v1.GetTransitWorkHoursMax()),
+				}
+				result.Coordinates.Lat = v1.GetCoordinates().GetLat()
+				result.Coordinates.Lng = v1.GetCoordinates().GetLng()
+				return result
+			}),
+		Cities: conv.Map(places.Cities, conv.FromString[iata.LocationIATACode],
+			func(v1 *CityInfo) base.CityInfo {
+				return base.CityInfo{
+					Code:     iata.LocationIATACode(v1.GetCode()),
+					Name:     c.protoToLocalizableContextString(v1.GetName()),
+					Country:  iata.CountryCode(v1.GetCountry()),
+					Timezone: v1.GetTimezone(),
+					Airports: conv.Array(v1.GetAirports(), conv.FromString[iata.LocationIATACode]),
+				}
+			}),
+		Countries: conv.Map(places.Countries, conv.FromString[iata.CountryCode],
+			func(v1 *CountryInfo) base.CountryInfo {
+				return base.CountryInfo{
+					Code:        iata.CountryCode(v1.GetCode()),
+					Name:        c.protoToLocalizableContextString(v1.GetName()),
+					UnifiedVisa: v1.GetUnifiedVisa(),
+				}
+			}),
+		MetroAreas: conv.Map(places.MetroAreas, conv.FromString[iata.LocationIATACode],
+			func(v1 *MetroAreaInfo) base.MetroAreaInfo {
+				return base.MetroAreaInfo{
+					Code:     iata.LocationIATACode(v1.GetCode()),
+					Airports: conv.Array(v1.GetAirports(), conv.FromString[iata.LocationIATACode]),
+					Timezone: v1.GetTimezone(),
+				}
+			}),
+		AirportsToMetro: conv.Map(places.AirportsToMetro, conv.FromString[iata.LocationIATACode], conv.FromString[iata.LocationIATACode]),
+	}
+}
+
+func (c *protoConverter) protoToPointerBool(bool *bool) base.PointerBool {
+	raw := "null"
+	if bool != nil {
+		raw = strconv.FormatBool(*bool)
+	}
+	result, err := fromJSON[base.PointerBool](raw)
+	c.fail(err)
+	return result
+}
+
+func (c *protoConverter) protoToAgentInfo(info *AgentInfo) v3.AgentInfo {
+	return v3.AgentInfo{
+		ID:             c.int(info.GetId()),
+		GateName:       info.GetGateName(),
+		Label:          c.protoToLocalizableContextString(info.GetLabel()),
+		PaymentMethods: info.GetPaymentMethods(),
+		MobileVersion:  info.GetMobileVersion(),
+		HideProposals:  info.GetHideProposals(),
+		Assisted:       info.GetAssisted(),
+		MobileType:     info.GetMobileType(),
+		AirlineIATAs:   info.GetAirlineIatas(),
+	}
+}
+
+func (c *protoConverter) protoToAlliance(alliance *Alliance) v3.Alliance {
+	return v3.Alliance{
+		ID:   c.int(alliance.GetId()),
+		Name: alliance.GetName(),
+	}
+}
+
+func (c *protoConverter) protoToDegradedBoundaries(bound *DegradedBoundaries) *boundaries.DegradedBoundaries {
+	if bound == nil {
+		return nil
+	}
+
+	var airports map[int]boundaries.DegradedAirportsBoundaries
+	if bound.Airports != nil {
+		airports = make(map[int]boundaries.DegradedAirportsBoundaries, len(bound.Airports))
+		for i, airportsBoundaries := range bound.Airports {
+			airports[c.int(i)] = boundaries.DegradedAirportsBoundaries{
+				Arrival:   conv.Map(airportsBoundaries.GetArrival(), conv.FromString[iata.LocationIATACode], c.protoToFilterPrice),
+				Departure: conv.Map(airportsBoundaries.GetDeparture(), conv.FromString[iata.LocationIATACode], c.protoToFilterPrice),
+			}
+		}
+	}
+
+	var baggage *boundaries.FilterBaggageBoundaries
+	if bound.Baggage != nil {
+		baggage = &boundaries.FilterBaggageBoundaries{
+			FullBaggage:  c.protoToFilterPrice(bound.Baggage.FullBaggage),
+			NoBaggage:    c.protoToFilterPrice(bound.Baggage.NoBaggage),
+			LargeHandbag: c.protoToFilterPrice(bound.Baggage.LargeHandbag),
+		}
+	}
+
+	var departureArrivalTime map[int]boundaries.DegradedTimeBoundaries
+	if bound.DepartureArrivalTime != nil {
+		departureArrivalTime = make(map[int]boundaries.DegradedTimeBoundaries, len(bound.DepartureArrivalTime))
+		for i, timeBoundaries := range bound.DepartureArrivalTime {
+			departureArrivalTime[c.int(i)] = boundaries.DegradedTimeBoundaries{
+				ArrivalDate:   conv.Map(timeBoundaries.GetArrivalDate(), c.protoToDate, c.protoToFilterPrice),
+				ArrivalTime:   c.protoToDateTimeRangeBoundariesOpt(timeBoundaries.GetArrivalTime()),
+				DepartureTime: c.protoToDateTimeRangeBoundariesOpt(timeBoundaries.GetDepartureTime()),
+				TripDuration:  (*boundaries.TripDurationBoundaries)(c.protoToRangeBoundariesOpt(timeBoundaries.GetTripDuration())),
+			}
+		}
+	}
+
+	var returnTicket *boundaries.DegradedReturnTicketBoundaries
+	if bound.ReturnTicket != nil {
+		returnTicket = &boundaries.DegradedReturnTicketBoundaries{
+			Available: c.protoToFilterPrice(bound.ReturnTicket.Available),
+			Free:      c.protoToFilterPrice(bound.ReturnTicket.Free),
+		}
+	}
+
+	var changeTicket *boundaries.DegradedReturnTicketBoundaries
+	if bound.ChangeTicket != nil {
+		changeTicket = &boundaries.DegradedReturnTicketBoundaries{
+			Available: c.protoToFilterPrice(bound.ChangeTicket.Available),
+			Free:      c.protoToFilterPrice(bound.ChangeTicket.Free),
+		}
+	}
+
+	return &boundaries.DegradedBoundaries{
+		Agents:                           conv.Map(bound.Agents, c.int, c.protoToFilterPrice),
+		Airlines:                         conv.Map(bound.Airlines, conv.FromString[iata.AirlineID], c.protoToFilterPrice),
+		Alliances:                        conv.Map(bound.Alliances, c.int, c.protoToFilterPrice),
+		HasInterlines:                    c.protoToFilterBool(bound.HasInterlines),
+		HasLowcosts:                      c.protoToFilterBool(bound.HasLowcosts),
+		Airports:                         airports,
+		SameDepartureArrivalAirport:      conv.Map(bound.SameDepartureArrivalAirport, conv.FromString[iata.LocationIATACode], c.protoToFilterPrice),
+		Baggage:                          baggage,
+		Equipments:                       conv.Map(bound.Equipments, conv.Same[string], c.protoToFilterPrice),
+		PaymentMethods:                   conv.Map(bound.PaymentMethods, conv.Same[string], c.protoToFilterPrice),
+		Price:                            (*boundaries.PriceBoundaries)(c.protoToFloatRangeOpt(bound.Price)),
+		DepartureArrivalTime:             departureArrivalTime,
+		ReturnTicket:                     returnTicket,
+		ChangeTicket:                     changeTicket,
+		TransfersCount:                   conv.Map(bound.TransfersCount, conv.Same[int64], c.protoToFilterPrice),
+		TransfersDuration:                c.protoToTransferDurationBoundaries(bound.TransfersDuration),
+		TransfersAirports:                conv.Map(bound.TransfersAirports, conv.FromString[iata.LocationIATACode], c.protoToFilterPrice),
+		TransfersCountries:               conv.Map(bound.TransfersCountries, conv.Same[string], c.protoToFilterPrice),
+		HasTransfersWithAirportChange:    c.protoToFilterBool(bound.HasTransfersWithAirportChange),
+		HasTransfersWithBaggageRecheck:   c.protoToFilterBool(bound.HasTransfersWithBaggageRecheck),
+		HasTransfersWithVisa:             c.protoToFilterBool(bound.HasTransfersWithVisa),
+		HasTransfersWithVirtualInterline: c.protoToFilterBool(bound.HasTransfersWithVirtualInterline),
+		HasCovidRestrictions:             c.protoToFilterBool(bound.HasCovidRestrictions),
+		HasNightTransfers:                c.protoToFilterBool(bound.HasNightTransfers),
+		HasConvenientTransfers:           c.protoToFilterBool(bound.HasConvenientTransfers),
+		HasShortLayoverTransfers:         c.protoToFilterBool(bound.HasShortLayoverTransfers),
+		HasLongLayoverTransfers:          c.protoToFilterBool(bound.HasLongLayoverTransfers),
+	}
+}
+
+func (c *protoConverter) protoToFilterPrice(price *FilterPrice) *filter.Price {
+	if price == nil {
+		return nil
+	}
+	return &filter.Price{
+		EnableMinPrice:  price.EnableMinPrice,
+		DisableMinPrice: price.DisableMinPrice,
+	}
+}
+
+func (c *protoConverter) protoToFilterBool(bool *FilterBool) *filter.Bool {
+	if bool == nil {
+		return nil
+	}
+	return &filter.Bool{
+		EnableMinPrice:  bool.EnableMinPrice,
+		DisableMinPrice: bool.DisableMinPrice,
+	}
+}
+
+func (c *protoConverter) protoToFloatRangeOpt(pb *PriceBoundaries) *filter.FloatRange {
+	return conv.OptRef(pb, c.protoToFloatRange)
+}
+
+func (c *protoConverter) protoToFloatRange(pb *PriceBoundaries) filter.FloatRange {
+	return filter.FloatRange{
+		Min: pb.GetMin(),
+		Max: pb.GetMax(),
+	}
+}
+
+func (c *protoConverter) protoToDateTimeRangeBoundaries(dtrb *DateTimeRangeBoundaries) times.DateTimeRangeBoundaries {
+	return times.DateTimeRangeBoundaries{
+		Min:         c.protoToDateTime(dtrb.GetMin()),
+		Max:         c.protoToDateTime(dtrb.GetMax()),
+		Buckets:     conv.Map(dtrb.GetBuckets(), c.protoToDateTime, conv.Same[float64]),
+		BucketWidth: dtrb.GetBucketWidth(),
+	}
+}
+
+func (c *protoConverter) protoToDateTimeRangeBoundariesOpt(dtrb *DateTimeRangeBoundaries) *times.DateTimeRangeBoundaries {
+	return conv.OptRef(dtrb, c.protoToDateTimeRangeBoundaries)
+}
+
+func (c *protoConverter) protoToRangeBoundariesOpt(rb *RangeBoundaries) *times.RangeBoundaries {
+	return conv.OptRef(rb, c.protoToRangeBoundaries)
+}
+
+func (c *protoConverter) protoToRangeBoundaries(rb *RangeBoundaries) times.RangeBoundaries {
+	return times.RangeBoundaries{
+		Min:         rb.GetMin(),
+		Max:         rb.GetMax(),
+		Buckets:     rb.GetBuckets(),
+		BucketWidth: rb.GetBucketWidth(),
+	}
+}
+
+func (c *protoConverter) protoToTransferDurationBoundaries(tdb *TransferDurationBoundaries) *transfers.TransferDurationBoundaries {
+	if tdb == nil {
+		return nil
+	}
+	return &transfers.TransferDurationBoundaries{
+		Min: tdb.Min,
+		Max: tdb.Max,
+	}
+}
+
+func (c *protoConverter) protoToBoundaries(bound *Boundaries) *boundaries.Boundaries {
+	if bound == nil {
+		return nil
+	}
+
+	var airports map[int]boundaries.AirportsBoundaries
+	if bound.Airports != nil {
+		airports = make(map[int]boundaries.AirportsBoundaries, len(bound.Airports))
+		for i, airportsBoundaries := range bound.Airports {
+			airports[c.int(i)] = boundaries.AirportsBoundaries{
+				Arrival:   conv.Map(airportsBoundaries.GetArrival(), conv.FromString[iata.LocationIATACode], conv.Same[float64]),
+				Departure: conv.Map(airportsBoundaries.GetDeparture(), conv.FromString[iata.LocationIATACode], conv.Same[float64]),
+			}
+		}
+	}
+
+	var departureArrivalTime map[int]boundaries.TimeBoundaries
+	if bound.DepartureArrivalTime != nil {
+		departureArrivalTime = make(map[int]boundaries.TimeBoundaries, len(bound.DepartureArrivalTime))
+		for i, timeBoundaries := range bound.DepartureArrivalTime {
+			departureArrivalTime[c.int(i)] = boundaries.TimeBoundaries{
+				ArrivalDate:   conv.Map(timeBoundaries.GetArrivalDate(), c.protoToDate, conv.Same[float64]),
+				ArrivalTime:   c.protoToDateTimeRangeBoundaries(timeBoundaries.GetArrivalTime()),
+				DepartureTime: c.protoToDateTimeRangeBoundaries(timeBoundaries.GetDepartureTime()),
+				TripDuration:  boundaries.TripDurationBoundaries(c.protoToRangeBoundaries(timeBoundaries.GetTripDuration())),
+			}
+		}
+	}
+
+	result := &boundaries.Boundaries{
+		Agents:                           conv.Map(bound.Agents, c.int, conv.Same[float64]),
+		Airlines:                         conv.Map(bound.Airlines, conv.FromString[iata.AirlineID], conv.Same[float64]),
+		Alliances:                        conv.Map(bound.Alliances, c.int, conv.Same[float64]),
+		HasInterlines:                    bound.HasInterlines,
+		HasLowcosts:                      bound.HasLowcosts,
+		Airports:                         airports,
+		SameDepartureArrivalAirport:      conv.Map(bound.SameDepartureArrivalAirport, conv.FromString[iata.LocationIATACode], conv.Same[float64]),
+		Equipments:                       bound.Equipments,
+		PaymentMethods:                   bound.PaymentMethods,
+		Price:                            boundaries.PriceBoundaries(c.protoToFloatRange(bound.Price)),
+		DepartureArrivalTime:             departureArrivalTime,
+		TransfersCount:                   bound.TransfersCount,
+		TransfersDuration:                c.protoToTransferDurationBoundaries(bound.TransfersDuration),
+		TransfersAirports:                conv.Map(bound.TransfersAirports, conv.FromString[iata.LocationIATACode], conv.Same[float64]),
+		TransfersCountries:               bound.TransfersCountries,
+		HasTransfersWithAirportChange:    bound.HasTransfersWithAirportChange,
+		HasTransfersWithBaggageRecheck:   bound.HasTransfersWithBaggageRecheck,
+		HasTransfersWithVisa:             bound.HasTransfersWithVisa,
+		HasTransfersWithVirtualInterline: bound.HasTransfersWithVirtualInterline,
+		HasCovidRestrictions:             bound.HasCovidRestrictions,
+		HasNightTransfers:                bound.HasNightTransfers,
+		HasConvenientTransfers:           bound.HasConvenientTransfers,
+		HasShortLayoverTransfers:         bound.HasShortLayoverTransfers,
+		HasLongLayoverTransfers:          bound.HasLongLayoverTransfers,
+	}
+	result.Baggage.FullBaggage = bound.GetBaggage().GetFullBaggage()
+	result.Baggage.NoBaggage = bound.GetBaggage().GetNoBaggage()
+	result.Baggage.LargeHandbag = bound.GetBaggage().GetLargeHandbag()
+	result.ReturnTicket.Available = bound.GetReturnTicket().GetAvailable()
+	result.ReturnTicket.Free = bound.GetReturnTicket().GetFree()
+	result.ChangeTicket.Available = bound.GetChangeTicket().GetAvailable()
+	result.ChangeTicket.Free = bound.GetChangeTicket().GetFree()
+	return result
+}
+
+func (c *protoConverter) protoToFilterState(state *FilterState) *filter.State {
+	if state == nil {
+		return nil
+	}
+	return &filter.State{
+		Agents:                          c.ints(state.Agents),
+		Airlines:                        state.Airlines,
+		Alliances:                       c.ints(state.Alliances),
+		WithoutInterlines:               state.WithoutInterlines,
+		WithoutLowcosts:                 state.WithoutLowcosts,
+		Segments:                        conv.Map(state.Segments, c.int, c.protoToSegmentFilter),
+		WithSameDepartureArrivalAirport: state.WithSameDepartureArrivalAirport,
+		Equipments:                      state.Equipments,
+		PaymentMethods:                  state.PaymentMethods,
+		PinFlightSignatures:             state.PinFlightSignatures,
+		Price: conv.Array(state.Price, func(v1 *FloatRange) filter.FloatRange {
+			return c.protoToFloatRange((*PriceBoundaries)(v1))
+		}),
+		TransfersCount:                   c.ints(state.TransfersCount),
+		TransfersDuration:                conv.Array(state.TransfersDuration, c.protoToRange),
+		TransfersWithoutAirportChange:    state.TransfersWithoutAirportChange,
+		TransfersWithoutBaggageRecheck:   state.TransfersWithoutBaggageRecheck,
+		TransfersWithoutVisa:             state.TransfersWithoutVisa,
+		TransfersWithoutVirtualInterline: state.TransfersWithoutVirtualInterline,
+		ConvenientTransfers:              state.ConvenientTransfers,
+		WithoutNightTransfers:            state.WithoutNightTransfers,
+		WithoutShortLayover:              state.WithoutShortLayover,
+		WithoutLongLayover:               state.WithoutLongLayover,
+		TransfersAirports:                state.TransfersAirports,
+		TransfersCountries:               state.TransfersCountries,
+		WithoutCovidRestrictions:         state.WithoutCovidRestrictions,
+		Baggage:                          state.Baggage,
+		TimeBuckets:                      c.protoToTimeBuckets(state.TimeBuckets),
+		ReturnBeforeFlight:               state.ReturnBeforeFlight,
+		ChangeBeforeFlight:               state.ChangeBeforeFlight,
+	}
+}
+
+func (c *protoConverter) protoToSegmentFilter(f *SegmentFilter) filter.SegmentFilter {
+	return filter.SegmentFilter{
+		AirportsArrival:   f.GetAirportsArrival(),
+		AirportsDeparture: f.GetAirportsDeparture(),
+		ArrivalTime:       conv.Array(f.GetArrivalTime(), c.protoToDateTimeOrTimeRange),
+		ArrivalDate:       conv.Array(f.GetArrivalDate(), c.protoToDate),
+		DepartureTime:     conv.Array(f.GetDepartureTime(), c.protoToDateTimeRange),
+		TripDuration:      conv.Array(f.GetTripDuration(), c.protoToRange),
+	}
+}
+
+func (c *protoConverter) protoToDateTimeOrTimeRange(d *DateTimeOrTimeRange) filter.DateTimeOrTimeRange {
+	return filter.DateTimeOrTimeRange{
+		Min: d.GetMin(),
+		Max: d.GetMax(),
+	}
+}
+
+func (c *protoConverter) protoToDateTimeRange(d *DateTimeRange) filter.DateTimeRange {
+	if d == nil {
+		return filter.DateTimeRange{}
+	}
+	return filter.DateTimeRange{
+		Min: c.protoToUnix(d.Min),
+		Max: c.protoToUnix(d.Max),
+	}
+}
+
+func (c *protoConverter) protoToUnix(unix *int64) *time.Time {
+	return conv.OptPtr(unix, func(unix int64) time.Time {
+		return time.Unix(unix, 0).UTC()
+	})
+}
+
+func (c *protoConverter) protoToTime(t *timestamppb.Timestamp) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return t.AsTime()
+}
+
+func (c *protoConverter) protoToRange(r *Range) filter.Range {
+	return filter.Range{
+		Min: r.GetMin(),
+		Max: r.GetMax(),
+	}
+}
+
+func (c *protoConverter) protoToTimeBuckets(buckets *TimeBuckets) *filter.TimeBuckets {
+	if buckets == nil {
+		return nil
+	}
+	return &filter.TimeBuckets{
+		ArrivalTimeBucketWidth:      c.int(buckets.ArrivalTimeBucketWidth),
+		DepartureTimeBucketWidth:    c.int(buckets.DepartureTimeBucketWidth),
+		TripDurationTimeBucketWidth: c.int(buckets.TripDurationTimeBucketWidth),
+	}
+}
+
+func (c *protoConverter) protoToDateTime(val string) datetime.DateTime {
+	result, err := fromJSONString[datetime.DateTime](val)
+	c.fail(err)
+	return result
+}
+
+func (c *protoConverter) protoToDate(val string) datetime.Date {
+	result, err := fromJSONString[datetime.Date](val)
+	c.fail(err)
+	return result
+}
+
+func (c *protoConverter) protoToCurrencyCode(val string) currency.Code {
+	result, err := fromJSONString[currency.Code](val)
+	c.fail(err)
+	return result
+}
+
+// fromJSONString restores delta value types, which are only constructible from their textual form
+// (currency codes, dates), the same way they are read from a results dump
+func fromJSONString[V1 any](val string) (V1, error) {
+	if val == "" {
+		var zero V1
+		return zero, nil
+	}
+	return fromJSON[V1](strconv.Quote(val))
+}
+
+func fromJSON[V1 any](raw string) (V1, error) {
+	var result V1
+	if err := json.Unmarshal([]byte(raw), &result); err != nil {
+		return result, errors.Wrapf(err, "%T from %s", result, raw)
+	}
+	return result, nil
+}
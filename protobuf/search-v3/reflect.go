@@ -2,12 +2,16 @@ package search_v3
 
 import (
 	"fmt"
+	"github.com/KosyanMedia/delta/pkg/currency"
+	"github.com/KosyanMedia/delta/pkg/types/search/base"
 	"github.com/fatih/structtag"
 	"github.com/stretchr/testify/require"
 	"go-playground/protobuf/utils"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"reflect"
 	"sort"
 	"testing"
+	"time"
 )
 
 func requireDeepEqual(t *testing.T, leftV, rightV reflect.Value) {
@@ -19,6 +23,24 @@ func requireDeepEqual(t *testing.T, leftV, rightV reflect.Value) {
 	leftT := leftV.Type()
 	rightT := rightV.Type()
 
+	// Optional fields are unset exactly when the original is unknown or zero
+	if leftT == reflect.TypeOf(base.PointerBool{}) && rightT == reflect.TypeOf((*bool)(nil)) {
+		pointerBool := leftV.Interface().(base.PointerBool)
+		require.Equal(t, pointerBool.IsUnknown(), rightV.IsNil())
+		if !rightV.IsNil() {
+			require.Equal(t, pointerBool.IsTrue(), rightV.Elem().Bool())
+		}
+		return
+	}
+	if leftT == reflect.TypeOf(time.Time{}) && rightT == reflect.TypeOf((*timestamppb.Timestamp)(nil)) {
+		original := leftV.Interface().(time.Time)
+		require.Equal(t, original.IsZero(), rightV.IsNil())
+		if !rightV.IsNil() {
+			require.True(t, original.Equal(rightV.Interface().(*timestamppb.Timestamp).AsTime()))
+		}
+		return
+	}
+
 	if leftT.Kind() != rightT.Kind() {
 		if leftT.Kind() == reflect.Ptr {
 			requireDeepEqual(t, leftV.Elem(), rightV)
@@ -40,8 +62,9 @@ func requireDeepEqual(t *testing.T, leftV, rightV reflect.Value) {
 		return
 	}
 
-	// Time can be represented as int64
-	if leftT.Kind() == reflect.Struct && rightT.Kind() == reflect.Int64 && leftT.Name() == "Time" {
+	// Time can be represented as unix seconds
+	if leftT == reflect.TypeOf(time.Time{}) && rightT.Kind() == reflect.Int64 {
+		require.Equal(t, leftV.Interface().(time.Time).Unix(), rightV.Int())
 		return
 	}
 
@@ -50,11 +73,16 @@ func requireDeepEqual(t *testing.T, leftV, rightV reflect.Value) {
 		return
 	}
 
-	// Some exceptional cases
-	if leftT.Kind() == reflect.Struct && leftT.Name() == "Code" && rightT.Kind() == reflect.Int32 {
+	// Currency missing from the enum is kept as a string
+	if leftT == reflect.TypeOf(currency.Amount{}) && rightT == reflect.TypeOf(Amount{}) {
+		amount := rightV.Addr().Interface().(*Amount)
+		require.Equal(t, leftV.Interface().(currency.Amount).CurrencyCode.String(), amountCurrencyCode(amount))
+		require.Equal(t, leftV.FieldByName("Value").Float(), amount.Value)
 		return
 	}
-	if leftT.Kind() == reflect.Struct && leftT.Name() == "PointerBool" && rightT.Kind() == reflect.Struct && rightT.Name() == "OptBool" {
+
+	// Some exceptional cases
+	if leftT.Kind() == reflect.Struct && leftT.Name() == "Code" && rightT.Kind() == reflect.Int32 {
 		return
 	}
 
@@ -9,6 +9,7 @@ package search_v3
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -307,312 +308,314 @@ func (Brand) EnumDescriptor() ([]byte, []int) {
 type Currency int32
 
 const (
-	Currency_ADP Currency = 0
-	Currency_AED Currency = 1
-	Currency_AFA Currency = 2
-	Currency_AFN Currency = 3
-	Currency_ALK Currency = 4
-	Currency_ALL Currency = 5
-	Currency_AMD Currency = 6
-	Currency_ANG Currency = 7
-	Currency_AOA Currency = 8
-	Currency_AOK Currency = 9
-	Currency_AON Currency = 10
-	Currency_AOR Currency = 11
-	Currency_ARA Currency = 12
-	Currency_ARL Currency = 13
-	Currency_ARM Currency = 14
-	Currency_ARP Currency = 15
-	Currency_ARS Currency = 16
-	Currency_ATS Currency = 17
-	Currency_AUD Currency = 18
-	Currency_AWG Currency = 19
-	Currency_AZM Currency = 20
-	Currency_AZN Currency = 21
-	Currency_BAD Currency = 22
-	Currency_BAM Currency = 23
-	Currency_BAN Currency = 24
-	Currency_BBD Currency = 25
-	Currency_BDT Currency = 26
-	Currency_BEC Currency = 27
-	Currency_BEF Currency = 28
-	Currency_BEL Currency = 29
-	Currency_BGL Currency = 30
-	Currency_BGM Currency = 31
-	Currency_BGN Currency = 32
-	Currency_BGO Currency = 33
-	Currency_BHD Currency = 34
-	Currency_BIF Currency = 35
-	Currency_BMD Currency = 36
-	Currency_BND Currency = 37
-	Currency_BOB Currency = 38
-	Currency_BOL Currency = 39
-	Currency_BOP Currency = 40
-	Currency_BOV Currency = 41
-	Currency_BRB Currency = 42
-	Currency_BRC Currency = 43
-	Currency_BRE Currency = 44
-	Currency_BRL Currency = 45
-	Currency_BRN Currency = 46
-	Currency_BRR Currency = 47
-	Currency_BRZ Currency = 48
-	Currency_BSD Currency = 49
-	Currency_BTN Currency = 50
-	Currency_BUK Currency = 51
-	Currency_BWP Currency = 52
-	Currency_BYB Currency = 53
-	Currency_BYN Currency = 54
-	Currency_BYR Currency = 55
-	Currency_BZD Currency = 56
-	Currency_CAD Currency = 57
-	Currency_CDF Currency = 58
-	Currency_CHE Currency = 59
-	Currency_CHF Currency = 60
-	Currency_CHW Currency = 61
-	Currency_CLE Currency = 62
-	Currency_CLF Currency = 63
-	Currency_CLP Currency = 64
-	Currency_CNH Currency = 65
-	Currency_CNX Currency = 66
-	Currency_CNY Currency = 67
-	Currency_COP Currency = 68
-	Currency_COU Currency = 69
-	Currency_CRC Currency = 70
-	Currency_CSD Currency = 71
-	Currency_CSK Currency = 72
-	Currency_CUC Currency = 73
-	Currency_CUP Currency = 74
-	Currency_CVE Currency = 75
-	Currency_CYP Currency = 76
-	Currency_CZK Currency = 77
-	Currency_DDM Currency = 78
-	Currency_DEM Currency = 79
-	Currency_DJF Currency = 80
-	Currency_DKK Currency = 81
-	Currency_DOP Currency = 82
-	Currency_DZD Currency = 83
-	Currency_ECS Currency = 84
-	Currency_ECV Currency = 85
-	Currency_EEK Currency = 86
-	Currency_EGP Currency = 87
-	Currency_ERN Currency = 88
-	Currency_ESA Currency = 89
-	Currency_ESB Currency = 90
-	Currency_ESP Currency = 91
-	Currency_ETB Currency = 92
-	Currency_EUR Currency = 93
-	Currency_FIM Currency = 94
-	Currency_FJD Currency = 95
-	Currency_FKP Currency = 96
-	Currency_FRF Currency = 97
-	Currency_GBP Currency = 98
-	Currency_GEK Currency = 99
-	Currency_GEL Currency = 100
-	Currency_GHC Currency = 101
-	Currency_GHS Currency = 102
-	Currency_GIP Currency = 103
-	Currency_GMD Currency = 104
-	Currency_GNF Currency = 105
-	Currency_GNS Currency = 106
-	Currency_GQE Currency = 107
-	Currency_GRD Currency = 108
-	Currency_GTQ Currency = 109
-	Currency_GWE Currency = 110
-	Currency_GWP Currency = 111
-	Currency_GYD Currency = 112
-	Currency_HKD Currency = 113
-	Currency_HNL Currency = 114
-	Currency_HRD Currency = 115
-	Currency_HRK Currency = 116
-	Currency_HTG Currency = 117
-	Currency_HUF Currency = 118
-	Currency_IDR Currency = 119
-	Currency_IEP Currency = 120
-	Currency_ILP Currency = 121
-	Currency_ILR Currency = 122
-	Currency_ILS Currency = 123
-	Currency_INR Currency = 124
-	Currency_IQD Currency = 125
-	Currency_IRR Currency = 126
-	Currency_ISJ Currency = 127
-	Currency_ISK Currency = 128
-	Currency_ITL Currency = 129
-	Currency_JMD Currency = 130
-	Currency_JOD Currency = 131
-	Currency_JPY Currency = 132
-	Currency_KES Currency = 133
-	Currency_KGS Currency = 134
-	Currency_KHR Currency = 135
-	Currency_KMF Currency = 136
-	Currency_KPW Currency = 137
-	Currency_KRH Currency = 138
-	Currency_KRO Currency = 139
-	Currency_KRW Currency = 140
-	Currency_KWD Currency = 141
-	Currency_KYD Currency = 142
-	Currency_KZT Currency = 143
-	Currency_LAK Currency = 144
-	Currency_LBP Currency = 145
-	Currency_LKR Currency = 146
-	Currency_LRD Currency = 147
-	Currency_LSL Currency = 148
-	Currency_LTL Currency = 149
-	Currency_LTT Currency = 150
-	Currency_LUC Currency = 151
-	Currency_LUF Currency = 152
-	Currency_LUL Currency = 153
-	Currency_LVL Currency = 154
-	Currency_LVR Currency = 155
-	Currency_LYD Currency = 156
-	Currency_MAD Currency = 157
-	Currency_MAF Currency = 158
-	Currency_MCF Currency = 159
-	Currency_MDC Currency = 160
-	Currency_MDL Currency = 161
-	Currency_MGA Currency = 162
-	Currency_MGF Currency = 163
-	Currency_MKD Currency = 164
-	Currency_MKN Currency = 165
-	Currency_MLF Currency = 166
-	Currency_MMK Currency = 167
-	Currency_MNT Currency = 168
-	Currency_MOP Currency = 169
-	Currency_MRO Currency = 170
-	Currency_MTL Currency = 171
-	Currency_MTP Currency = 172
-	Currency_MUR Currency = 173
-	Currency_MVP Currency = 174
-	Currency_MVR Currency = 175
-	Currency_MWK Currency = 176
-	Currency_MXN Currency = 177
-	Currency_MXP Currency = 178
-	Currency_MXV Currency = 179
-	Currency_MYR Currency = 180
-	Currency_MZE Currency = 181
-	Currency_MZM Currency = 182
-	Currency_MZN Currency = 183
-	Currency_NAD Currency = 184
-	Currency_NGN Currency = 185
-	Currency_NIC Currency = 186
-	Currency_NIO Currency = 187
-	Currency_NLG Currency = 188
-	Currency_NOK Currency = 189
-	Currency_NPR Currency = 190
-	Currency_NZD Currency = 191
-	Currency_OMR Currency = 192
-	Currency_PAB Currency = 193
-	Currency_PEI Currency = 194
-	Currency_PEN Currency = 195
-	Currency_PES Currency = 196
-	Currency_PGK Currency = 197
-	Currency_PHP Currency = 198
-	Currency_PKR Currency = 199
-	Currency_PLN Currency = 200
-	Currency_PLZ Currency = 201
-	Currency_PTE Currency = 202
-	Currency_PYG Currency = 203
-	Currency_QAR Currency = 204
-	Currency_RHD Currency = 205
-	Currency_ROL Currency = 206
-	Currency_RON Currency = 207
-	Currency_RSD Currency = 208
-	Currency_RUB Currency = 209
-	Currency_RUR Currency = 210
-	Currency_RWF Currency = 211
-	Currency_SAR Currency = 212
-	Currency_SBD Currency = 213
-	Currency_SCR Currency = 214
-	Currency_SDD Currency = 215
-	Currency_SDG Currency = 216
-	Currency_SDP Currency = 217
-	Currency_SEK Currency = 218
-	Currency_SGD Currency = 219
-	Currency_SHP Currency = 220
-	Currency_SIT Currency = 221
-	Currency_SKK Currency = 222
-	Currency_SLL Currency = 223
-	Currency_SOS Currency = 224
-	Currency_SRD Currency = 225
-	Currency_SRG Currency = 226
-	Currency_SSP Currency = 227
-	Currency_STD Currency = 228
-	Currency_STN Currency = 229
-	Currency_SUR Currency = 230
-	Currency_SVC Currency = 231
-	Currency_SYP Currency = 232
-	Currency_SZL Currency = 233
-	Currency_THB Currency = 234
-	Currency_TJR Currency = 235
-	Currency_TJS Currency = 236
-	Currency_TMM Currency = 237
-	Currency_TMT Currency = 238
-	Currency_TND Currency = 239
-	Currency_TOP Currency = 240
-	Currency_TPE Currency = 241
-	Currency_TRL Currency = 242
-	Currency_TRY Currency = 243
-	Currency_TTD Currency = 244
-	Currency_TWD Currency = 245
-	Currency_TZS Currency = 246
-	Currency_UAH Currency = 247
-	Currency_UAK Currency = 248
-	Currency_UGS Currency = 249
-	Currency_UGX Currency = 250
-	Currency_USD Currency = 251
-	Currency_USN Currency = 252
-	Currency_USS Currency = 253
-	Currency_UYI Currency = 254
-	Currency_UYP Currency = 255
-	Currency_UYU Currency = 256
-	Currency_UZS Currency = 257
-	Currency_VEB Currency = 258
-	Currency_VEF Currency = 259
-	Currency_VND Currency = 260
-	Currency_VNN Currency = 261
-	Currency_VUV Currency = 262
-	Currency_WST Currency = 263
-	Currency_XAF Currency = 264
-	Currency_XAG Currency = 265
-	Currency_XAU Currency = 266
-	Currency_XBA Currency = 267
-	Currency_XBB Currency = 268
-	Currency_XBC Currency = 269
-	Currency_XBD Currency = 270
-	Currency_XCD Currency = 271
-	Currency_XDR Currency = 272
-	Currency_XEU Currency = 273
-	Currency_XFO Currency = 274
-	Currency_XFU Currency = 275
-	Currency_XOF Currency = 276
-	Currency_XPD Currency = 277
-	Currency_XPF Currency = 278
-	Currency_XPT Currency = 279
-	Currency_XRE Currency = 280
-	Currency_XSU Currency = 281
-	Currency_XTS Currency = 282
-	Currency_XUA Currency = 283
-	Currency_XXX Currency = 284
-	Currency_YDD Currency = 285
-	Currency_YER Currency = 286
-	Currency_YUD Currency = 287
-	Currency_YUM Currency = 288
-	Currency_YUN Currency = 289
-	Currency_YUR Currency = 290
-	Currency_ZAL Currency = 291
-	Currency_ZAR Currency = 292
-	Currency_ZMK Currency = 293
-	Currency_ZMW Currency = 294
-	Currency_ZRN Currency = 295
-	Currency_ZRZ Currency = 296
-	Currency_ZWD Currency = 297
-	Currency_ZWL Currency = 298
-	Currency_ZWR Currency = 299
+	Currency_CURRENCY_UNSPECIFIED Currency = 0
+	Currency_ADP                  Currency = 300
+	Currency_AED                  Currency = 1
+	Currency_AFA                  Currency = 2
+	Currency_AFN                  Currency = 3
+	Currency_ALK                  Currency = 4
+	Currency_ALL                  Currency = 5
+	Currency_AMD                  Currency = 6
+	Currency_ANG                  Currency = 7
+	Currency_AOA                  Currency = 8
+	Currency_AOK                  Currency = 9
+	Currency_AON                  Currency = 10
+	Currency_AOR                  Currency = 11
+	Currency_ARA                  Currency = 12
+	Currency_ARL                  Currency = 13
+	Currency_ARM                  Currency = 14
+	Currency_ARP                  Currency = 15
+	Currency_ARS                  Currency = 16
+	Currency_ATS                  Currency = 17
+	Currency_AUD                  Currency = 18
+	Currency_AWG                  Currency = 19
+	Currency_AZM                  Currency = 20
+	Currency_AZN                  Currency = 21
+	Currency_BAD                  Currency = 22
+	Currency_BAM                  Currency = 23
+	Currency_BAN                  Currency = 24
+	Currency_BBD                  Currency = 25
+	Currency_BDT                  Currency = 26
+	Currency_BEC                  Currency = 27
+	Currency_BEF                  Currency = 28
+	Currency_BEL                  Currency = 29
+	Currency_BGL                  Currency = 30
+	Currency_BGM                  Currency = 31
+	Currency_BGN                  Currency = 32
+	Currency_BGO                  Currency = 33
+	Currency_BHD                  Currency = 34
+	Currency_BIF                  Currency = 35
+	Currency_BMD                  Currency = 36
+	Currency_BND                  Currency = 37
+	Currency_BOB                  Currency = 38
+	Currency_BOL                  Currency = 39
+	Currency_BOP                  Currency = 40
+	Currency_BOV                  Currency = 41
+	Currency_BRB                  Currency = 42
+	Currency_BRC                  Currency = 43
+	Currency_BRE                  Currency = 44
+	Currency_BRL                  Currency = 45
+	Currency_BRN                  Currency = 46
+	Currency_BRR                  Currency = 47
+	Currency_BRZ                  Currency = 48
+	Currency_BSD                  Currency = 49
+	Currency_BTN                  Currency = 50
+	Currency_BUK                  Currency = 51
+	Currency_BWP                  Currency = 52
+	Currency_BYB                  Currency = 53
+	Currency_BYN                  Currency = 54
+	Currency_BYR                  Currency = 55
+	Currency_BZD                  Currency = 56
+	Currency_CAD                  Currency = 57
+	Currency_CDF                  Currency = 58
+	Currency_CHE                  Currency = 59
+	Currency_CHF                  Currency = 60
+	Currency_CHW                  Currency = 61
+	Currency_CLE                  Currency = 62
+	Currency_CLF                  Currency = 63
+	Currency_CLP                  Currency = 64
+	Currency_CNH                  Currency = 65
+	Currency_CNX                  Currency = 66
+	Currency_CNY                  Currency = 67
+	Currency_COP                  Currency = 68
+	Currency_COU                  Currency = 69
+	Currency_CRC                  Currency = 70
+	Currency_CSD                  Currency = 71
+	Currency_CSK                  Currency = 72
+	Currency_CUC                  Currency = 73
+	Currency_CUP                  Currency = 74
+	Currency_CVE                  Currency = 75
+	Currency_CYP                  Currency = 76
+	Currency_CZK                  Currency = 77
+	Currency_DDM                  Currency = 78
+	Currency_DEM                  Currency = 79
+	Currency_DJF                  Currency = 80
+	Currency_DKK                  Currency = 81
+	Currency_DOP                  Currency = 82
+	Currency_DZD                  Currency = 83
+	Currency_ECS                  Currency = 84
+	Currency_ECV                  Currency = 85
+	Currency_EEK                  Currency = 86
+	Currency_EGP                  Currency = 87
+	Currency_ERN                  Currency = 88
+	Currency_ESA                  Currency = 89
+	Currency_ESB                  Currency = 90
+	Currency_ESP                  Currency = 91
+	Currency_ETB                  Currency = 92
+	Currency_EUR                  Currency = 93
+	Currency_FIM                  Currency = 94
+	Currency_FJD                  Currency = 95
+	Currency_FKP                  Currency = 96
+	Currency_FRF                  Currency = 97
+	Currency_GBP                  Currency = 98
+	Currency_GEK                  Currency = 99
+	Currency_GEL                  Currency = 100
+	Currency_GHC                  Currency = 101
+	Currency_GHS                  Currency = 102
+	Currency_GIP                  Currency = 103
+	Currency_GMD                  Currency = 104
+	Currency_GNF                  Currency = 105
+	Currency_GNS                  Currency = 106
+	Currency_GQE                  Currency = 107
+	Currency_GRD                  Currency = 108
+	Currency_GTQ                  Currency = 109
+	Currency_GWE                  Currency = 110
+	Currency_GWP                  Currency = 111
+	Currency_GYD                  Currency = 112
+	Currency_HKD                  Currency = 113
+	Currency_HNL                  Currency = 114
+	Currency_HRD                  Currency = 115
+	Currency_HRK                  Currency = 116
+	Currency_HTG                  Currency = 117
+	Currency_HUF                  Currency = 118
+	Currency_IDR                  Currency = 119
+	Currency_IEP                  Currency = 120
+	Currency_ILP                  Currency = 121
+	Currency_ILR                  Currency = 122
+	Currency_ILS                  Currency = 123
+	Currency_INR                  Currency = 124
+	Currency_IQD                  Currency = 125
+	Currency_IRR                  Currency = 126
+	Currency_ISJ                  Currency = 127
+	Currency_ISK                  Currency = 128
+	Currency_ITL                  Currency = 129
+	Currency_JMD                  Currency = 130
+	Currency_JOD                  Currency = 131
+	Currency_JPY                  Currency = 132
+	Currency_KES                  Currency = 133
+	Currency_KGS                  Currency = 134
+	Currency_KHR                  Currency = 135
+	Currency_KMF                  Currency = 136
+	Currency_KPW                  Currency = 137
+	Currency_KRH                  Currency = 138
+	Currency_KRO                  Currency = 139
+	Currency_KRW                  Currency = 140
+	Currency_KWD                  Currency = 141
+	Currency_KYD                  Currency = 142
+	Currency_KZT                  Currency = 143
+	Currency_LAK                  Currency = 144
+	Currency_LBP                  Currency = 145
+	Currency_LKR                  Currency = 146
+	Currency_LRD                  Currency = 147
+	Currency_LSL                  Currency = 148
+	Currency_LTL                  Currency = 149
+	Currency_LTT                  Currency = 150
+	Currency_LUC                  Currency = 151
+	Currency_LUF                  Currency = 152
+	Currency_LUL                  Currency = 153
+	Currency_LVL                  Currency = 154
+	Currency_LVR                  Currency = 155
+	Currency_LYD                  Currency = 156
+	Currency_MAD                  Currency = 157
+	Currency_MAF                  Currency = 158
+	Currency_MCF                  Currency = 159
+	Currency_MDC                  Currency = 160
+	Currency_MDL                  Currency = 161
+	Currency_MGA                  Currency = 162
+	Currency_MGF                  Currency = 163
+	Currency_MKD                  Currency = 164
+	Currency_MKN                  Currency = 165
+	Currency_MLF                  Currency = 166
+	Currency_MMK                  Currency = 167
+	Currency_MNT                  Currency = 168
+	Currency_MOP                  Currency = 169
+	Currency_MRO                  Currency = 170
+	Currency_MTL                  Currency = 171
+	Currency_MTP                  Currency = 172
+	Currency_MUR                  Currency = 173
+	Currency_MVP                  Currency = 174
+	Currency_MVR                  Currency = 175
+	Currency_MWK                  Currency = 176
+	Currency_MXN                  Currency = 177
+	Currency_MXP                  Currency = 178
+	Currency_MXV                  Currency = 179
+	Currency_MYR                  Currency = 180
+	Currency_MZE                  Currency = 181
+	Currency_MZM                  Currency = 182
+	Currency_MZN                  Currency = 183
+	Currency_NAD                  Currency = 184
+	Currency_NGN                  Currency = 185
+	Currency_NIC                  Currency = 186
+	Currency_NIO                  Currency = 187
+	Currency_NLG                  Currency = 188
+	Currency_NOK                  Currency = 189
+	Currency_NPR                  Currency = 190
+	Currency_NZD                  Currency = 191
+	Currency_OMR                  Currency = 192
+	Currency_PAB                  Currency = 193
+	Currency_PEI                  Currency = 194
+	Currency_PEN                  Currency = 195
+	Currency_PES                  Currency = 196
+	Currency_PGK                  Currency = 197
+	Currency_PHP                  Currency = 198
+	Currency_PKR                  Currency = 199
+	Currency_PLN                  Currency = 200
+	Currency_PLZ                  Currency = 201
+	Currency_PTE                  Currency = 202
+	Currency_PYG                  Currency = 203
+	Currency_QAR                  Currency = 204
+	Currency_RHD                  Currency = 205
+	Currency_ROL                  Currency = 206
+	Currency_RON                  Currency = 207
+	Currency_RSD                  Currency = 208
+	Currency_RUB                  Currency = 209
+	Currency_RUR                  Currency = 210
+	Currency_RWF                  Currency = 211
+	Currency_SAR                  Currency = 212
+	Currency_SBD                  Currency = 213
+	Currency_SCR                  Currency = 214
+	Currency_SDD                  Currency = 215
+	Currency_SDG                  Currency = 216
+	Currency_SDP                  Currency = 217
+	Currency_SEK                  Currency = 218
+	Currency_SGD                  Currency = 219
+	Currency_SHP                  Currency = 220
+	Currency_SIT                  Currency = 221
+	Currency_SKK                  Currency = 222
+	Currency_SLL                  Currency = 223
+	Currency_SOS                  Currency = 224
+	Currency_SRD                  Currency = 225
+	Currency_SRG                  Currency = 226
+	Currency_SSP                  Currency = 227
+	Currency_STD                  Currency = 228
+	Currency_STN                  Currency = 229
+	Currency_SUR                  Currency = 230
+	Currency_SVC                  Currency = 231
+	Currency_SYP                  Currency = 232
+	Currency_SZL                  Currency = 233
+	Currency_THB                  Currency = 234
+	Currency_TJR                  Currency = 235
+	Currency_TJS                  Currency = 236
+	Currency_TMM                  Currency = 237
+	Currency_TMT                  Currency = 238
+	Currency_TND                  Currency = 239
+	Currency_TOP                  Currency = 240
+	Currency_TPE                  Currency = 241
+	Currency_TRL                  Currency = 242
+	Currency_TRY                  Currency = 243
+	Currency_TTD                  Currency = 244
+	Currency_TWD                  Currency = 245
+	Currency_TZS                  Currency = 246
+	Currency_UAH                  Currency = 247
+	Currency_UAK                  Currency = 248
+	Currency_UGS                  Currency = 249
+	Currency_UGX                  Currency = 250
+	Currency_USD                  Currency = 251
+	Currency_USN                  Currency = 252
+	Currency_USS                  Currency = 253
+	Currency_UYI                  Currency = 254
+	Currency_UYP                  Currency = 255
+	Currency_UYU                  Currency = 256
+	Currency_UZS                  Currency = 257
+	Currency_VEB                  Currency = 258
+	Currency_VEF                  Currency = 259
+	Currency_VND                  Currency = 260
+	Currency_VNN                  Currency = 261
+	Currency_VUV                  Currency = 262
+	Currency_WST                  Currency = 263
+	Currency_XAF                  Currency = 264
+	Currency_XAG                  Currency = 265
+	Currency_XAU                  Currency = 266
+	Currency_XBA                  Currency = 267
+	Currency_XBB                  Currency = 268
+	Currency_XBC                  Currency = 269
+	Currency_XBD                  Currency = 270
+	Currency_XCD                  Currency = 271
+	Currency_XDR                  Currency = 272
+	Currency_XEU                  Currency = 273
+	Currency_XFO                  Currency = 274
+	Currency_XFU                  Currency = 275
+	Currency_XOF                  Currency = 276
+	Currency_XPD                  Currency = 277
+	Currency_XPF                  Currency = 278
+	Currency_XPT                  Currency = 279
+	Currency_XRE                  Currency = 280
+	Currency_XSU                  Currency = 281
+	Currency_XTS                  Currency = 282
+	Currency_XUA                  Currency = 283
+	Currency_XXX                  Currency = 284
+	Currency_YDD                  Currency = 285
+	Currency_YER                  Currency = 286
+	Currency_YUD                  Currency = 287
+	Currency_YUM                  Currency = 288
+	Currency_YUN                  Currency = 289
+	Currency_YUR                  Currency = 290
+	Currency_ZAL                  Currency = 291
+	Currency_ZAR                  Currency = 292
+	Currency_ZMK                  Currency = 293
+	Currency_ZMW                  Currency = 294
+	Currency_ZRN                  Currency = 295
+	Currency_ZRZ                  Currency = 296
+	Currency_ZWD                  Currency = 297
+	Currency_ZWL                  Currency = 298
+	Currency_ZWR                  Currency = 299
 )
 
 // Enum value maps for Currency.
 var (
 	Currency_name = map[int32]string{
-		0:   "ADP",
+		0:   "CURRENCY_UNSPECIFIED",
+		300: "ADP",
 		1:   "AED",
 		2:   "AFA",
 		3:   "AFN",
@@ -914,306 +917,307 @@ var (
 		299: "ZWR",
 	}
 	Currency_value = map[string]int32{
-		"ADP": 0,
-		"AED": 1,
-		"AFA": 2,
-		"AFN": 3,
-		"ALK": 4,
-		"ALL": 5,
-		"AMD": 6,
-		"ANG": 7,
-		"AOA": 8,
-		"AOK": 9,
-		"AON": 10,
-		"AOR": 11,
-		"ARA": 12,
-		"ARL": 13,
-		"ARM": 14,
-		"ARP": 15,
-		"ARS": 16,
-		"ATS": 17,
-		"AUD": 18,
-		"AWG": 19,
-		"AZM": 20,
-		"AZN": 21,
-		"BAD": 22,
-		"BAM": 23,
-		"BAN": 24,
-		"BBD": 25,
-		"BDT": 26,
-		"BEC": 27,
-		"BEF": 28,
-		"BEL": 29,
-		"BGL": 30,
-		"BGM": 31,
-		"BGN": 32,
-		"BGO": 33,
-		"BHD": 34,
-		"BIF": 35,
-		"BMD": 36,
-		"BND": 37,
-		"BOB": 38,
-		"BOL": 39,
-		"BOP": 40,
-		"BOV": 41,
-		"BRB": 42,
-		"BRC": 43,
-		"BRE": 44,
-		"BRL": 45,
-		"BRN": 46,
-		"BRR": 47,
-		"BRZ": 48,
-		"BSD": 49,
-		"BTN": 50,
-		"BUK": 51,
-		"BWP": 52,
-		"BYB": 53,
-		"BYN": 54,
-		"BYR": 55,
-		"BZD": 56,
-		"CAD": 57,
-		"CDF": 58,
-		"CHE": 59,
-		"CHF": 60,
-		"CHW": 61,
-		"CLE": 62,
-		"CLF": 63,
-		"CLP": 64,
-		"CNH": 65,
-		"CNX": 66,
-		"CNY": 67,
-		"COP": 68,
-		"COU": 69,
-		"CRC": 70,
-		"CSD": 71,
-		"CSK": 72,
-		"CUC": 73,
-		"CUP": 74,
-		"CVE": 75,
-		"CYP": 76,
-		"CZK": 77,
-		"DDM": 78,
-		"DEM": 79,
-		"DJF": 80,
-		"DKK": 81,
-		"DOP": 82,
-		"DZD": 83,
-		"ECS": 84,
-		"ECV": 85,
-		"EEK": 86,
-		"EGP": 87,
-		"ERN": 88,
-		"ESA": 89,
-		"ESB": 90,
-		"ESP": 91,
-		"ETB": 92,
-		"EUR": 93,
-		"FIM": 94,
-		"FJD": 95,
-		"FKP": 96,
-		"FRF": 97,
-		"GBP": 98,
-		"GEK": 99,
-		"GEL": 100,
-		"GHC": 101,
-		"GHS": 102,
-		"GIP": 103,
-		"GMD": 104,
-		"GNF": 105,
-		"GNS": 106,
-		"GQE": 107,
-		"GRD": 108,
-		"GTQ": 109,
-		"GWE": 110,
-		"GWP": 111,
-		"GYD": 112,
-		"HKD": 113,
-		"HNL": 114,
-		"HRD": 115,
-		"HRK": 116,
-		"HTG": 117,
-		"HUF": 118,
-		"IDR": 119,
-		"IEP": 120,
-		"ILP": 121,
-		"ILR": 122,
-		"ILS": 123,
-		"INR": 124,
-		"IQD": 125,
-		"IRR": 126,
-		"ISJ": 127,
-		"ISK": 128,
-		"ITL": 129,
-		"JMD": 130,
-		"JOD": 131,
-		"JPY": 132,
-		"KES": 133,
-		"KGS": 134,
-		"KHR": 135,
-		"KMF": 136,
-		"KPW": 137,
-		"KRH": 138,
-		"KRO": 139,
-		"KRW": 140,
-		"KWD": 141,
-		"KYD": 142,
-		"KZT": 143,
-		"LAK": 144,
-		"LBP": 145,
-		"LKR": 146,
-		"LRD": 147,
-		"LSL": 148,
-		"LTL": 149,
-		"LTT": 150,
-		"LUC": 151,
-		"LUF": 152,
-		"LUL": 153,
-		"LVL": 154,
-		"LVR": 155,
-		"LYD": 156,
-		"MAD": 157,
-		"MAF": 158,
-		"MCF": 159,
-		"MDC": 160,
-		"MDL": 161,
-		"MGA": 162,
-		"MGF": 163,
-		"MKD": 164,
-		"MKN": 165,
-		"MLF": 166,
-		"MMK": 167,
-		"MNT": 168,
-		"MOP": 169,
-		"MRO": 170,
-		"MTL": 171,
-		"MTP": 172,
-		"MUR": 173,
-		"MVP": 174,
-		"MVR": 175,
-		"MWK": 176,
-		"MXN": 177,
-		"MXP": 178,
-		"MXV": 179,
-		"MYR": 180,
-		"MZE": 181,
-		"MZM": 182,
-		"MZN": 183,
-		"NAD": 184,
-		"NGN": 185,
-		"NIC": 186,
-		"NIO": 187,
-		"NLG": 188,
-		"NOK": 189,
-		"NPR": 190,
-		"NZD": 191,
-		"OMR": 192,
-		"PAB": 193,
-		"PEI": 194,
-		"PEN": 195,
-		"PES": 196,
-		"PGK": 197,
-		"PHP": 198,
-		"PKR": 199,
-		"PLN": 200,
-		"PLZ": 201,
-		"PTE": 202,
-		"PYG": 203,
-		"QAR": 204,
-		"RHD": 205,
-		"ROL": 206,
-		"RON": 207,
-		"RSD": 208,
-		"RUB": 209,
-		"RUR": 210,
-		"RWF": 211,
-		"SAR": 212,
-		"SBD": 213,
-		"SCR": 214,
-		"SDD": 215,
-		"SDG": 216,
-		"SDP": 217,
-		"SEK": 218,
-		"SGD": 219,
-		"SHP": 220,
-		"SIT": 221,
-		"SKK": 222,
-		"SLL": 223,
-		"SOS": 224,
-		"SRD": 225,
-		"SRG": 226,
-		"SSP": 227,
-		"STD": 228,
-		"STN": 229,
-		"SUR": 230,
-		"SVC": 231,
-		"SYP": 232,
-		"SZL": 233,
-		"THB": 234,
-		"TJR": 235,
-		"TJS": 236,
-		"TMM": 237,
-		"TMT": 238,
-		"TND": 239,
-		"TOP": 240,
-		"TPE": 241,
-		"TRL": 242,
-		"TRY": 243,
-		"TTD": 244,
-		"TWD": 245,
-		"TZS": 246,
-		"UAH": 247,
-		"UAK": 248,
-		"UGS": 249,
-		"UGX": 250,
-		"USD": 251,
-		"USN": 252,
-		"USS": 253,
-		"UYI": 254,
-		"UYP": 255,
-		"UYU": 256,
-		"UZS": 257,
-		"VEB": 258,
-		"VEF": 259,
-		"VND": 260,
-		"VNN": 261,
-		"VUV": 262,
-		"WST": 263,
-		"XAF": 264,
-		"XAG": 265,
-		"XAU": 266,
-		"XBA": 267,
-		"XBB": 268,
-		"XBC": 269,
-		"XBD": 270,
-		"XCD": 271,
-		"XDR": 272,
-		"XEU": 273,
-		"XFO": 274,
-		"XFU": 275,
-		"XOF": 276,
-		"XPD": 277,
-		"XPF": 278,
-		"XPT": 279,
-		"XRE": 280,
-		"XSU": 281,
-		"XTS": 282,
-		"XUA": 283,
-		"XXX": 284,
-		"YDD": 285,
-		"YER": 286,
-		"YUD": 287,
-		"YUM": 288,
-		"YUN": 289,
-		"YUR": 290,
-		"ZAL": 291,
-		"ZAR": 292,
-		"ZMK": 293,
-		"ZMW": 294,
-		"ZRN": 295,
-		"ZRZ": 296,
-		"ZWD": 297,
-		"ZWL": 298,
-		"ZWR": 299,
+		"CURRENCY_UNSPECIFIED": 0,
+		"ADP":                  300,
+		"AED":                  1,
+		"AFA":                  2,
+		"AFN":                  3,
+		"ALK":                  4,
+		"ALL":                  5,
+		"AMD":                  6,
+		"ANG":                  7,
+		"AOA":                  8,
+		"AOK":                  9,
+		"AON":                  10,
+		"AOR":                  11,
+		"ARA":                  12,
+		"ARL":                  13,
+		"ARM":                  14,
+		"ARP":                  15,
+		"ARS":                  16,
+		"ATS":                  17,
+		"AUD":                  18,
+		"AWG":                  19,
+		"AZM":                  20,
+		"AZN":                  21,
+		"BAD":                  22,
+		"BAM":                  23,
+		"BAN":                  24,
+		"BBD":                  25,
+		"BDT":                  26,
+		"BEC":                  27,
+		"BEF":                  28,
+		"BEL":                  29,
+		"BGL":                  30,
+		"BGM":                  31,
+		"BGN":                  32,
+		"BGO":                  33,
+		"BHD":                  34,
+		"BIF":                  35,
+		"BMD":                  36,
+		"BND":                  37,
+		"BOB":                  38,
+		"BOL":                  39,
+		"BOP":                  40,
+		"BOV":                  41,
+		"BRB":                  42,
+		"BRC":                  43,
+		"BRE":                  44,
+		"BRL":                  45,
+		"BRN":                  46,
+		"BRR":                  47,
+		"BRZ":                  48,
+		"BSD":                  49,
+		"BTN":                  50,
+		"BUK":                  51,
+		"BWP":                  52,
+		"BYB":                  53,
+		"BYN":                  54,
+		"BYR":                  55,
+		"BZD":                  56,
+		"CAD":                  57,
+		"CDF":                  58,
+		"CHE":                  59,
+		"CHF":                  60,
+		"CHW":                  61,
+		"CLE":                  62,
+		"CLF":                  63,
+		"CLP":                  64,
+		"CNH":                  65,
+		"CNX":                  66,
+		"CNY":                  67,
+		"COP":                  68,
+		"COU":                  69,
+		"CRC":                  70,
+		"CSD":                  71,
+		"CSK":                  72,
+		"CUC":                  73,
+		"CUP":                  74,
+		"CVE":                  75,
+		"CYP":                  76,
+		"CZK":                  77,
+		"DDM":                  78,
+		"DEM":                  79,
+		"DJF":                  80,
+		"DKK":                  81,
+		"DOP":                  82,
+		"DZD":                  83,
+		"ECS":                  84,
+		"ECV":                  85,
+		"EEK":                  86,
+		"EGP":                  87,
+		"ERN":                  88,
+		"ESA":                  89,
+		"ESB":                  90,
+		"ESP":                  91,
+		"ETB":                  92,
+		"EUR":                  93,
+		"FIM":                  94,
+		"FJD":                  95,
+		"FKP":                  96,
+		"FRF":                  97,
+		"GBP":                  98,
+		"GEK":                  99,
+		"GEL":                  100,
+		"GHC":                  101,
+		"GHS":                  102,
+		"GIP":                  103,
+		"GMD":                  104,
+		"GNF":                  105,
+		"GNS":                  106,
+		"GQE":                  107,
+		"GRD":                  108,
+		"GTQ":                  109,
+		"GWE":                  110,
+		"GWP":                  111,
+		"GYD":                  112,
+		"HKD":                  113,
+		"HNL":                  114,
+		"HRD":                  115,
+		"HRK":                  116,
+		"HTG":                  117,
+		"HUF":                  118,
+		"IDR":                  119,
+		"IEP":                  120,
+		"ILP":                  121,
+		"ILR":                  122,
+		"ILS":                  123,
+		"INR":                  124,
+		"IQD":                  125,
+		"IRR":                  126,
+		"ISJ":                  127,
+		"ISK":                  128,
+		"ITL":                  129,
+		"JMD":                  130,
+		"JOD":                  131,
+		"JPY":                  132,
+		"KES":                  133,
+		"KGS":                  134,
+		"KHR":                  135,
+		"KMF":                  136,
+		"KPW":                  137,
+		"KRH":                  138,
+		"KRO":                  139,
+		"KRW":                  140,
+		"KWD":                  141,
+		"KYD":                  142,
+		"KZT":                  143,
+		"LAK":                  144,
+		"LBP":                  145,
+		"LKR":                  146,
+		"LRD":                  147,
+		"LSL":                  148,
+		"LTL":                  149,
+		"LTT":                  150,
+		"LUC":                  151,
+		"LUF":                  152,
+		"LUL":                  153,
+		"LVL":                  154,
+		"LVR":                  155,
+		"LYD":                  156,
+		"MAD":                  157,
+		"MAF":                  158,
+		"MCF":                  159,
+		"MDC":                  160,
+		"MDL":                  161,
+		"MGA":                  162,
+		"MGF":                  163,
+		"MKD":                  164,
+		"MKN":                  165,
+		"MLF":                  166,
+		"MMK":                  167,
+		"MNT":                  168,
+		"MOP":                  169,
+		"MRO":                  170,
+		"MTL":                  171,
+		"MTP":                  172,
+		"MUR":                  173,
+		"MVP":                  174,
+		"MVR":                  175,
+		"MWK":                  176,
+		"MXN":                  177,
+		"MXP":                  178,
+		"MXV":                  179,
+		"MYR":                  180,
+		"MZE":                  181,
+		"MZM":                  182,
+		"MZN":                  183,
+		"NAD":                  184,
+		"NGN":                  185,
+		"NIC":                  186,
+		"NIO":                  187,
+		"NLG":                  188,
+		"NOK":                  189,
+		"NPR":                  190,
+		"NZD":                  191,
+		"OMR":                  192,
+		"PAB":                  193,
+		"PEI":                  194,
+		"PEN":                  195,
+		"PES":                  196,
+		"PGK":                  197,
+		"PHP":                  198,
+		"PKR":                  199,
+		"PLN":                  200,
+		"PLZ":                  201,
+		"PTE":                  202,
+		"PYG":                  203,
+		"QAR":                  204,
+		"RHD":                  205,
+		"ROL":                  206,
+		"RON":                  207,
+		"RSD":                  208,
+		"RUB":                  209,
+		"RUR":                  210,
+		"RWF":                  211,
+		"SAR":                  212,
+		"SBD":                  213,
+		"SCR":                  214,
+		"SDD":                  215,
+		"SDG":                  216,
+		"SDP":                  217,
+		"SEK":                  218,
+		"SGD":                  219,
+		"SHP":                  220,
+		"SIT":                  221,
+		"SKK":                  222,
+		"SLL":                  223,
+		"SOS":                  224,
+		"SRD":                  225,
+		"SRG":                  226,
+		"SSP":                  227,
+		"STD":                  228,
+		"STN":                  229,
+		"SUR":                  230,
+		"SVC":                  231,
+		"SYP":                  232,
+		"SZL":                  233,
+		"THB":                  234,
+		"TJR":                  235,
+		"TJS":                  236,
+		"TMM":                  237,
+		"TMT":                  238,
+		"TND":                  239,
+		"TOP":                  240,
+		"TPE":                  241,
+		"TRL":                  242,
+		"TRY":                  243,
+		"TTD":                  244,
+		"TWD":                  245,
+		"TZS":                  246,
+		"UAH":                  247,
+		"UAK":                  248,
+		"UGS":                  249,
+		"UGX":                  250,
+		"USD":                  251,
+		"USN":                  252,
+		"USS":                  253,
+		"UYI":                  254,
+		"UYP":                  255,
+		"UYU":                  256,
+		"UZS":                  257,
+		"VEB":                  258,
+		"VEF":                  259,
+		"VND":                  260,
+		"VNN":                  261,
+		"VUV":                  262,
+		"WST":                  263,
+		"XAF":                  264,
+		"XAG":                  265,
+		"XAU":                  266,
+		"XBA":                  267,
+		"XBB":                  268,
+		"XBC":                  269,
+		"XBD":                  270,
+		"XCD":                  271,
+		"XDR":                  272,
+		"XEU":                  273,
+		"XFO":                  274,
+		"XFU":                  275,
+		"XOF":                  276,
+		"XPD":                  277,
+		"XPF":                  278,
+		"XPT":                  279,
+		"XRE":                  280,
+		"XSU":                  281,
+		"XTS":                  282,
+		"XUA":                  283,
+		"XXX":                  284,
+		"YDD":                  285,
+		"YER":                  286,
+		"YUD":                  287,
+		"YUM":                  288,
+		"YUN":                  289,
+		"YUR":                  290,
+		"ZAL":                  291,
+		"ZAR":                  292,
+		"ZMK":                  293,
+		"ZMW":                  294,
+		"ZRN":                  295,
+		"ZRZ":                  296,
+		"ZWD":                  297,
+		"ZWL":                  298,
+		"ZWR":                  299,
 	}
 )
 
@@ -1586,7 +1590,7 @@ type DebugInfo struct {
 	DataCenter      string                    `protobuf:"bytes,2,opt,name=data_center,json=dataCenter,proto3" json:"data_center,omitempty"`
 	Gates           map[string]*GateDebugInfo `protobuf:"bytes,3,rep,name=gates,proto3" json:"gates,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	FromCache       bool                      `protobuf:"varint,4,opt,name=from_cache,json=fromCache,proto3" json:"from_cache,omitempty"`
-	SearchStartTime int64                     `protobuf:"varint,5,opt,name=search_start_time,json=searchStartTime,proto3" json:"search_start_time,omitempty"`
+	SearchStartTime *timestamppb.Timestamp    `protobuf:"bytes,6,opt,name=search_start_time,json=searchStartTime,proto3" json:"search_start_time,omitempty"`
 }
 
 func (x *DebugInfo) Reset() {
@@ -1649,11 +1653,11 @@ func (x *DebugInfo) GetFromCache() bool {
 	return false
 }
 
-func (x *DebugInfo) GetSearchStartTime() int64 {
+func (x *DebugInfo) GetSearchStartTime() *timestamppb.Timestamp {
 	if x != nil {
 		return x.SearchStartTime
 	}
-	return 0
+	return nil
 }
 
 type GateDebugInfo struct {
@@ -3328,8 +3332,9 @@ type Amount struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CurrencyCode Currency `protobuf:"varint,1,opt,name=currency_code,json=currencyCode,proto3,enum=search_v3.Currency" json:"currency_code,omitempty"`
-	Value        float64  `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	CurrencyCode    Currency `protobuf:"varint,1,opt,name=currency_code,json=currencyCode,proto3,enum=search_v3.Currency" json:"currency_code,omitempty"`
+	Value           float64  `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	CurrencyIsoCode string   `protobuf:"bytes,3,opt,name=currency_iso_code,json=currencyIsoCode,proto3" json:"currency_iso_code,omitempty"`
 }
 
 func (x *Amount) Reset() {
@@ -3368,7 +3373,7 @@ func (x *Amount) GetCurrencyCode() Currency {
 	if x != nil {
 		return x.CurrencyCode
 	}
-	return Currency_ADP
+	return Currency_CURRENCY_UNSPECIFIED
 }
 
 func (x *Amount) GetValue() float64 {
@@ -3378,6 +3383,13 @@ func (x *Amount) GetValue() float64 {
 	return 0
 }
 
+func (x *Amount) GetCurrencyIsoCode() string {
+	if x != nil {
+		return x.CurrencyIsoCode
+	}
+	return ""
+}
+
 type ProposalDebugInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -4815,7 +4827,7 @@ type AirportInfo struct {
 	CityCode            string                      `protobuf:"bytes,3,opt,name=city_code,json=cityCode,proto3" json:"city_code,omitempty"`
 	MetroAreaCode       string                      `protobuf:"bytes,4,opt,name=metro_area_code,json=metroAreaCode,proto3" json:"metro_area_code,omitempty"`
 	Coordinates         *GeoPoint                   `protobuf:"bytes,5,opt,name=coordinates,proto3" json:"coordinates,omitempty"`
-	HasTransitZone      *OptBool                    `protobuf:"bytes,6,opt,name=has_transit_zone,json=hasTransitZone,proto3" json:"has_transit_zone,omitempty"`
+	HasTransitZone      *bool                       `protobuf:"varint,9,opt,name=has_transit_zone,json=hasTransitZone,proto3,oneof" json:"has_transit_zone,omitempty"`
 	TransitWorkHoursMin int64                       `protobuf:"varint,7,opt,name=transit_work_hours_min,json=transitWorkHoursMin,proto3" json:"transit_work_hours_min,omitempty"`
 	TransitWorkHoursMax int64                       `protobuf:"varint,8,opt,name=transit_work_hours_max,json=transitWorkHoursMax,proto3" json:"transit_work_hours_max,omitempty"`
 }
@@ -4887,11 +4899,11 @@ func (x *AirportInfo) GetCoordinates() *GeoPoint {
 	return nil
 }
 
-func (x *AirportInfo) GetHasTransitZone() *OptBool {
-	if x != nil {
-		return x.HasTransitZone
+func (x *AirportInfo) GetHasTransitZone() bool {
+	if x != nil && x.HasTransitZone != nil {
+		return *x.HasTransitZone
 	}
-	return nil
+	return false
 }
 
 func (x *AirportInfo) GetTransitWorkHoursMin() int64 {
@@ -4963,61 +4975,6 @@ func (x *GeoPoint) GetLng() float64 {
 	return 0
 }
 
-type OptBool struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Value     bool `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
-	IsUnknown bool `protobuf:"varint,2,opt,name=is_unknown,json=isUnknown,proto3" json:"is_unknown,omitempty"`
-}
-
-func (x *OptBool) Reset() {
-	*x = OptBool{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_search_v3_results_proto_msgTypes[48]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *OptBool) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*OptBool) ProtoMessage() {}
-
-func (x *OptBool) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_search_v3_results_proto_msgTypes[48]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use OptBool.ProtoReflect.Descriptor instead.
-func (*OptBool) Descriptor() ([]byte, []int) {
-	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{48}
-}
-
-func (x *OptBool) GetValue() bool {
-	if x != nil {
-		return x.Value
-	}
-	return false
-}
-
-func (x *OptBool) GetIsUnknown() bool {
-	if x != nil {
-		return x.IsUnknown
-	}
-	return false
-}
-
 type CityInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -5033,7 +4990,7 @@ type CityInfo struct {
 func (x *CityInfo) Reset() {
 	*x = CityInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_search_v3_results_proto_msgTypes[49]
+		mi := &file_protobuf_search_v3_results_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5046,7 +5003,7 @@ func (x *CityInfo) String() string {
 func (*CityInfo) ProtoMessage() {}
 
 func (x *CityInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_search_v3_results_proto_msgTypes[49]
+	mi := &file_protobuf_search_v3_results_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5059,7 +5016,7 @@ func (x *CityInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CityInfo.ProtoReflect.Descriptor instead.
 func (*CityInfo) Descriptor() ([]byte, []int) {
-	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{49}
+	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *CityInfo) GetCode() string {
@@ -5110,7 +5067,7 @@ type CountryInfo struct {
 func (x *CountryInfo) Reset() {
 	*x = CountryInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_search_v3_results_proto_msgTypes[50]
+		mi := &file_protobuf_search_v3_results_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5123,7 +5080,7 @@ func (x *CountryInfo) String() string {
 func (*CountryInfo) ProtoMessage() {}
 
 func (x *CountryInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_search_v3_results_proto_msgTypes[50]
+	mi := &file_protobuf_search_v3_results_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5136,7 +5093,7 @@ func (x *CountryInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CountryInfo.ProtoReflect.Descriptor instead.
 func (*CountryInfo) Descriptor() ([]byte, []int) {
-	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{50}
+	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *CountryInfo) GetCode() string {
@@ -5173,7 +5130,7 @@ type MetroAreaInfo struct {
 func (x *MetroAreaInfo) Reset() {
 	*x = MetroAreaInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_search_v3_results_proto_msgTypes[51]
+		mi := &file_protobuf_search_v3_results_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5186,7 +5143,7 @@ func (x *MetroAreaInfo) String() string {
 func (*MetroAreaInfo) ProtoMessage() {}
 
 func (x *MetroAreaInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_search_v3_results_proto_msgTypes[51]
+	mi := &file_protobuf_search_v3_results_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5199,7 +5156,7 @@ func (x *MetroAreaInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MetroAreaInfo.ProtoReflect.Descriptor instead.
 func (*MetroAreaInfo) Descriptor() ([]byte, []int) {
-	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{51}
+	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *MetroAreaInfo) GetCode() string {
@@ -5242,7 +5199,7 @@ type AgentInfo struct {
 func (x *AgentInfo) Reset() {
 	*x = AgentInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_search_v3_results_proto_msgTypes[52]
+		mi := &file_protobuf_search_v3_results_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5255,7 +5212,7 @@ func (x *AgentInfo) String() string {
 func (*AgentInfo) ProtoMessage() {}
 
 func (x *AgentInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_search_v3_results_proto_msgTypes[52]
+	mi := &file_protobuf_search_v3_results_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5268,7 +5225,7 @@ func (x *AgentInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AgentInfo.ProtoReflect.Descriptor instead.
 func (*AgentInfo) Descriptor() ([]byte, []int) {
-	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{52}
+	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *AgentInfo) GetId() int64 {
@@ -5346,7 +5303,7 @@ type Alliance struct {
 func (x *Alliance) Reset() {
 	*x = Alliance{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_search_v3_results_proto_msgTypes[53]
+		mi := &file_protobuf_search_v3_results_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5359,7 +5316,7 @@ func (x *Alliance) String() string {
 func (*Alliance) ProtoMessage() {}
 
 func (x *Alliance) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_search_v3_results_proto_msgTypes[53]
+	mi := &file_protobuf_search_v3_results_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5372,7 +5329,7 @@ func (x *Alliance) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Alliance.ProtoReflect.Descriptor instead.
 func (*Alliance) Descriptor() ([]byte, []int) {
-	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{53}
+	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *Alliance) GetId() int64 {
@@ -5402,7 +5359,7 @@ type Equipment struct {
 func (x *Equipment) Reset() {
 	*x = Equipment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_search_v3_results_proto_msgTypes[54]
+		mi := &file_protobuf_search_v3_results_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5415,7 +5372,7 @@ func (x *Equipment) String() string {
 func (*Equipment) ProtoMessage() {}
 
 func (x *Equipment) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_search_v3_results_proto_msgTypes[54]
+	mi := &file_protobuf_search_v3_results_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5428,7 +5385,7 @@ func (x *Equipment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Equipment.ProtoReflect.Descriptor instead.
 func (*Equipment) Descriptor() ([]byte, []int) {
-	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{54}
+	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *Equipment) GetCode() string {
@@ -5467,7 +5424,7 @@ type SearchParams struct {
 func (x *SearchParams) Reset() {
 	*x = SearchParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_search_v3_results_proto_msgTypes[55]
+		mi := &file_protobuf_search_v3_results_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5480,7 +5437,7 @@ func (x *SearchParams) String() string {
 func (*SearchParams) ProtoMessage() {}
 
 func (x *SearchParams) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_search_v3_results_proto_msgTypes[55]
+	mi := &file_protobuf_search_v3_results_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5493,7 +5450,7 @@ func (x *SearchParams) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchParams.ProtoReflect.Descriptor instead.
 func (*SearchParams) Descriptor() ([]byte, []int) {
-	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{55}
+	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *SearchParams) GetPassengers() *Passengers {
@@ -5544,7 +5501,7 @@ type Passengers struct {
 func (x *Passengers) Reset() {
 	*x = Passengers{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_search_v3_results_proto_msgTypes[56]
+		mi := &file_protobuf_search_v3_results_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5557,7 +5514,7 @@ func (x *Passengers) String() string {
 func (*Passengers) ProtoMessage() {}
 
 func (x *Passengers) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_search_v3_results_proto_msgTypes[56]
+	mi := &file_protobuf_search_v3_results_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5570,7 +5527,7 @@ func (x *Passengers) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Passengers.ProtoReflect.Descriptor instead.
 func (*Passengers) Descriptor() ([]byte, []int) {
-	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{56}
+	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *Passengers) GetAdults() uint32 {
@@ -5631,7 +5588,7 @@ type DegradedBoundaries struct {
 func (x *DegradedBoundaries) Reset() {
 	*x = DegradedBoundaries{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_search_v3_results_proto_msgTypes[57]
+		mi := &file_protobuf_search_v3_results_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5644,7 +5601,7 @@ func (x *DegradedBoundaries) String() string {
 func (*DegradedBoundaries) ProtoMessage() {}
 
 func (x *DegradedBoundaries) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_search_v3_results_proto_msgTypes[57]
+	mi := &file_protobuf_search_v3_results_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5657,7 +5614,7 @@ func (x *DegradedBoundaries) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DegradedBoundaries.ProtoReflect.Descriptor instead.
 func (*DegradedBoundaries) Descriptor() ([]byte, []int) {
-	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{57}
+	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *DegradedBoundaries) GetAgents() map[int64]*FilterPrice {
@@ -5861,7 +5818,7 @@ type FilterPrice struct {
 func (x *FilterPrice) Reset() {
 	*x = FilterPrice{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_search_v3_results_proto_msgTypes[58]
+		mi := &file_protobuf_search_v3_results_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5874,7 +5831,7 @@ func (x *FilterPrice) String() string {
 func (*FilterPrice) ProtoMessage() {}
 
 func (x *FilterPrice) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_search_v3_results_proto_msgTypes[58]
+	mi := &file_protobuf_search_v3_results_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5887,7 +5844,7 @@ func (x *FilterPrice) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FilterPrice.ProtoReflect.Descriptor instead.
 func (*FilterPrice) Descriptor() ([]byte, []int) {
-	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{58}
+	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *FilterPrice) GetEnableMinPrice() float64 {
@@ -5916,7 +5873,7 @@ type FilterBool struct {
 func (x *FilterBool) Reset() {
 	*x = FilterBool{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_search_v3_results_proto_msgTypes[59]
+		mi := &file_protobuf_search_v3_results_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5929,7 +5886,7 @@ func (x *FilterBool) String() string {
 func (*FilterBool) ProtoMessage() {}
 
 func (x *FilterBool) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_search_v3_results_proto_msgTypes[59]
+	mi := &file_protobuf_search_v3_results_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5942,7 +5899,7 @@ func (x *FilterBool) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FilterBool.ProtoReflect.Descriptor instead.
 func (*FilterBool) Descriptor() ([]byte, []int) {
-	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{59}
+	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *FilterBool) GetEnableMinPrice() float64 {
@@ -5971,7 +5928,7 @@ type DegradedAirportsBoundaries struct {
 func (x *DegradedAirportsBoundaries) Reset() {
 	*x = DegradedAirportsBoundaries{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_search_v3_results_proto_msgTypes[60]
+		mi := &file_protobuf_search_v3_results_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5984,7 +5941,7 @@ func (x *DegradedAirportsBoundaries) String() string {
 func (*DegradedAirportsBoundaries) ProtoMessage() {}
 
 func (x *DegradedAirportsBoundaries) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_search_v3_results_proto_msgTypes[60]
+	mi := &file_protobuf_search_v3_results_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5997,7 +5954,7 @@ func (x *DegradedAirportsBoundaries) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DegradedAirportsBoundaries.ProtoReflect.Descriptor instead.
 func (*DegradedAirportsBoundaries) Descriptor() ([]byte, []int) {
-	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{60}
+	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *DegradedAirportsBoundaries) GetArrival() map[string]*FilterPrice {
@@ -6027,7 +5984,7 @@ type FilterBaggageBoundaries struct {
 func (x *FilterBaggageBoundaries) Reset() {
 	*x = FilterBaggageBoundaries{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_search_v3_results_proto_msgTypes[61]
+		mi := &file_protobuf_search_v3_results_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6040,7 +5997,7 @@ func (x *FilterBaggageBoundaries) String() string {
 func (*FilterBaggageBoundaries) ProtoMessage() {}
 
 func (x *FilterBaggageBoundaries) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_search_v3_results_proto_msgTypes[61]
+	mi := &file_protobuf_search_v3_results_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6053,7 +6010,7 @@ func (x *FilterBaggageBoundaries) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FilterBaggageBoundaries.ProtoReflect.Descriptor instead.
 func (*FilterBaggageBoundaries) Descriptor() ([]byte, []int) {
-	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{61}
+	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *FilterBaggageBoundaries) GetFullBaggage() *FilterPrice {
@@ -6090,7 +6047,7 @@ type BaggageBoundaries struct {
 func (x *BaggageBoundaries) Reset() {
 	*x = BaggageBoundaries{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_search_v3_results_proto_msgTypes[62]
+		mi := &file_protobuf_search_v3_results_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6103,7 +6060,7 @@ func (x *BaggageBoundaries) String() string {
 func (*BaggageBoundaries) ProtoMessage() {}
 
 func (x *BaggageBoundaries) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_search_v3_results_proto_msgTypes[62]
+	mi := &file_protobuf_search_v3_results_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6116,7 +6073,7 @@ func (x *BaggageBoundaries) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BaggageBoundaries.ProtoReflect.Descriptor instead.
 func (*BaggageBoundaries) Descriptor() ([]byte, []int) {
-	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{62}
+	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *BaggageBoundaries) GetFullBaggage() float64 {
@@ -6152,7 +6109,7 @@ type PriceBoundaries struct {
 func (x *PriceBoundaries) Reset() {
 	*x = PriceBoundaries{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_search_v3_results_proto_msgTypes[63]
+		mi := &file_protobuf_search_v3_results_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6165,7 +6122,7 @@ func (x *PriceBoundaries) String() string {
 func (*PriceBoundaries) ProtoMessage() {}
 
 func (x *PriceBoundaries) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_search_v3_results_proto_msgTypes[63]
+	mi := &file_protobuf_search_v3_results_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6178,7 +6135,7 @@ func (x *PriceBoundaries) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PriceBoundaries.ProtoReflect.Descriptor instead.
 func (*PriceBoundaries) Descriptor() ([]byte, []int) {
-	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{63}
+	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *PriceBoundaries) GetMin() float64 {
@@ -6209,7 +6166,7 @@ type DegradedTimeBoundaries struct {
 func (x *DegradedTimeBoundaries) Reset() {
 	*x = DegradedTimeBoundaries{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_search_v3_results_proto_msgTypes[64]
+		mi := &file_protobuf_search_v3_results_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6222,7 +6179,7 @@ func (x *DegradedTimeBoundaries) String() string {
 func (*DegradedTimeBoundaries) ProtoMessage() {}
 
 func (x *DegradedTimeBoundaries) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_search_v3_results_proto_msgTypes[64]
+	mi := &file_protobuf_search_v3_results_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6235,7 +6192,7 @@ func (x *DegradedTimeBoundaries) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DegradedTimeBoundaries.ProtoReflect.Descriptor instead.
 func (*DegradedTimeBoundaries) Descriptor() ([]byte, []int) {
-	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{64}
+	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *DegradedTimeBoundaries) GetArrivalDate() map[string]*FilterPrice {
@@ -6280,7 +6237,7 @@ type DateTimeRangeBoundaries struct {
 func (x *DateTimeRangeBoundaries) Reset() {
 	*x = DateTimeRangeBoundaries{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_search_v3_results_proto_msgTypes[65]
+		mi := &file_protobuf_search_v3_results_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6293,7 +6250,7 @@ func (x *DateTimeRangeBoundaries) String() string {
 func (*DateTimeRangeBoundaries) ProtoMessage() {}
 
 func (x *DateTimeRangeBoundaries) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_search_v3_results_proto_msgTypes[65]
+	mi := &file_protobuf_search_v3_results_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6306,7 +6263,7 @@ func (x *DateTimeRangeBoundaries) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DateTimeRangeBoundaries.ProtoReflect.Descriptor instead.
 func (*DateTimeRangeBoundaries) Descriptor() ([]byte, []int) {
-	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{65}
+	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *DateTimeRangeBoundaries) GetMin() string {
@@ -6351,7 +6308,7 @@ type RangeBoundaries struct {
 func (x *RangeBoundaries) Reset() {
 	*x = RangeBoundaries{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_search_v3_results_proto_msgTypes[66]
+		mi := &file_protobuf_search_v3_results_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6364,7 +6321,7 @@ func (x *RangeBoundaries) String() string {
 func (*RangeBoundaries) ProtoMessage() {}
 
 func (x *RangeBoundaries) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_search_v3_results_proto_msgTypes[66]
+	mi := &file_protobuf_search_v3_results_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6377,7 +6334,7 @@ func (x *RangeBoundaries) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RangeBoundaries.ProtoReflect.Descriptor instead.
 func (*RangeBoundaries) Descriptor() ([]byte, []int) {
-	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{66}
+	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *RangeBoundaries) GetMin() int64 {
@@ -6420,7 +6377,7 @@ type DegradedReturnTicketBoundaries struct {
 func (x *DegradedReturnTicketBoundaries) Reset() {
 	*x = DegradedReturnTicketBoundaries{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_search_v3_results_proto_msgTypes[67]
+		mi := &file_protobuf_search_v3_results_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6433,7 +6390,7 @@ func (x *DegradedReturnTicketBoundaries) String() string {
 func (*DegradedReturnTicketBoundaries) ProtoMessage() {}
 
 func (x *DegradedReturnTicketBoundaries) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_search_v3_results_proto_msgTypes[67]
+	mi := &file_protobuf_search_v3_results_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6446,7 +6403,7 @@ func (x *DegradedReturnTicketBoundaries) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DegradedReturnTicketBoundaries.ProtoReflect.Descriptor instead.
 func (*DegradedReturnTicketBoundaries) Descriptor() ([]byte, []int) {
-	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{67}
+	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *DegradedReturnTicketBoundaries) GetAvailable() *FilterPrice {
@@ -6475,7 +6432,7 @@ type TransferDurationBoundaries struct {
 func (x *TransferDurationBoundaries) Reset() {
 	*x = TransferDurationBoundaries{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_search_v3_results_proto_msgTypes[68]
+		mi := &file_protobuf_search_v3_results_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6488,7 +6445,7 @@ func (x *TransferDurationBoundaries) String() string {
 func (*TransferDurationBoundaries) ProtoMessage() {}
 
 func (x *TransferDurationBoundaries) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_search_v3_results_proto_msgTypes[68]
+	mi := &file_protobuf_search_v3_results_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6501,7 +6458,7 @@ func (x *TransferDurationBoundaries) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransferDurationBoundaries.ProtoReflect.Descriptor instead.
 func (*TransferDurationBoundaries) Descriptor() ([]byte, []int) {
-	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{68}
+	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *TransferDurationBoundaries) GetMin() int64 {
@@ -6555,7 +6512,7 @@ type Boundaries struct {
 func (x *Boundaries) Reset() {
 	*x = Boundaries{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_search_v3_results_proto_msgTypes[69]
+		mi := &file_protobuf_search_v3_results_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6568,7 +6525,7 @@ func (x *Boundaries) String() string {
 func (*Boundaries) ProtoMessage() {}
 
 func (x *Boundaries) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_search_v3_results_proto_msgTypes[69]
+	mi := &file_protobuf_search_v3_results_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6581,7 +6538,7 @@ func (x *Boundaries) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Boundaries.ProtoReflect.Descriptor instead.
 func (*Boundaries) Descriptor() ([]byte, []int) {
-	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{69}
+	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *Boundaries) GetAgents() map[int64]float64 {
@@ -6785,7 +6742,7 @@ type ReturnBoundaries struct {
 func (x *ReturnBoundaries) Reset() {
 	*x = ReturnBoundaries{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_search_v3_results_proto_msgTypes[70]
+		mi := &file_protobuf_search_v3_results_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6798,7 +6755,7 @@ func (x *ReturnBoundaries) String() string {
 func (*ReturnBoundaries) ProtoMessage() {}
 
 func (x *ReturnBoundaries) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_search_v3_results_proto_msgTypes[70]
+	mi := &file_protobuf_search_v3_results_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6811,7 +6768,7 @@ func (x *ReturnBoundaries) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReturnBoundaries.ProtoReflect.Descriptor instead.
 func (*ReturnBoundaries) Descriptor() ([]byte, []int) {
-	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{70}
+	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *ReturnBoundaries) GetAvailable() float64 {
@@ -6840,7 +6797,7 @@ type ChangeBoundaries struct {
 func (x *ChangeBoundaries) Reset() {
 	*x = ChangeBoundaries{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_search_v3_results_proto_msgTypes[71]
+		mi := &file_protobuf_search_v3_results_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6853,7 +6810,7 @@ func (x *ChangeBoundaries) String() string {
 func (*ChangeBoundaries) ProtoMessage() {}
 
 func (x *ChangeBoundaries) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_search_v3_results_proto_msgTypes[71]
+	mi := &file_protobuf_search_v3_results_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6866,7 +6823,7 @@ func (x *ChangeBoundaries) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangeBoundaries.ProtoReflect.Descriptor instead.
 func (*ChangeBoundaries) Descriptor() ([]byte, []int) {
-	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{71}
+	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *ChangeBoundaries) GetAvailable() float64 {
@@ -6895,7 +6852,7 @@ type AirportsBoundaries struct {
 func (x *AirportsBoundaries) Reset() {
 	*x = AirportsBoundaries{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_search_v3_results_proto_msgTypes[72]
+		mi := &file_protobuf_search_v3_results_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6908,7 +6865,7 @@ func (x *AirportsBoundaries) String() string {
 func (*AirportsBoundaries) ProtoMessage() {}
 
 func (x *AirportsBoundaries) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_search_v3_results_proto_msgTypes[72]
+	mi := &file_protobuf_search_v3_results_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6921,7 +6878,7 @@ func (x *AirportsBoundaries) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AirportsBoundaries.ProtoReflect.Descriptor instead.
 func (*AirportsBoundaries) Descriptor() ([]byte, []int) {
-	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{72}
+	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *AirportsBoundaries) GetArrival() map[string]float64 {
@@ -6952,7 +6909,7 @@ type TimeBoundaries struct {
 func (x *TimeBoundaries) Reset() {
 	*x = TimeBoundaries{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_search_v3_results_proto_msgTypes[73]
+		mi := &file_protobuf_search_v3_results_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6965,7 +6922,7 @@ func (x *TimeBoundaries) String() string {
 func (*TimeBoundaries) ProtoMessage() {}
 
 func (x *TimeBoundaries) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_search_v3_results_proto_msgTypes[73]
+	mi := &file_protobuf_search_v3_results_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6978,7 +6935,7 @@ func (x *TimeBoundaries) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimeBoundaries.ProtoReflect.Descriptor instead.
 func (*TimeBoundaries) Descriptor() ([]byte, []int) {
-	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{73}
+	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *TimeBoundaries) GetArrivalDate() map[string]float64 {
@@ -7022,7 +6979,7 @@ type ResultsMeta struct {
 func (x *ResultsMeta) Reset() {
 	*x = ResultsMeta{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_search_v3_results_proto_msgTypes[74]
+		mi := &file_protobuf_search_v3_results_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7035,7 +6992,7 @@ func (x *ResultsMeta) String() string {
 func (*ResultsMeta) ProtoMessage() {}
 
 func (x *ResultsMeta) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_search_v3_results_proto_msgTypes[74]
+	mi := &file_protobuf_search_v3_results_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7048,7 +7005,7 @@ func (x *ResultsMeta) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResultsMeta.ProtoReflect.Descriptor instead.
 func (*ResultsMeta) Descriptor() ([]byte, []int) {
-	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{74}
+	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *ResultsMeta) GetFilteredTicketsCount() int64 {
@@ -7110,7 +7067,7 @@ type FilterState struct {
 func (x *FilterState) Reset() {
 	*x = FilterState{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_search_v3_results_proto_msgTypes[75]
+		mi := &file_protobuf_search_v3_results_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7123,7 +7080,7 @@ func (x *FilterState) String() string {
 func (*FilterState) ProtoMessage() {}
 
 func (x *FilterState) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_search_v3_results_proto_msgTypes[75]
+	mi := &file_protobuf_search_v3_results_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7136,7 +7093,7 @@ func (x *FilterState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FilterState.ProtoReflect.Descriptor instead.
 func (*FilterState) Descriptor() ([]byte, []int) {
-	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{75}
+	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *FilterState) GetAgents() []int64 {
@@ -7348,7 +7305,7 @@ type TimeBuckets struct {
 func (x *TimeBuckets) Reset() {
 	*x = TimeBuckets{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_search_v3_results_proto_msgTypes[76]
+		mi := &file_protobuf_search_v3_results_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7361,7 +7318,7 @@ func (x *TimeBuckets) String() string {
 func (*TimeBuckets) ProtoMessage() {}
 
 func (x *TimeBuckets) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_search_v3_results_proto_msgTypes[76]
+	mi := &file_protobuf_search_v3_results_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7374,7 +7331,7 @@ func (x *TimeBuckets) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimeBuckets.ProtoReflect.Descriptor instead.
 func (*TimeBuckets) Descriptor() ([]byte, []int) {
-	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{76}
+	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *TimeBuckets) GetArrivalTimeBucketWidth() int64 {
@@ -7414,7 +7371,7 @@ type SegmentFilter struct {
 func (x *SegmentFilter) Reset() {
 	*x = SegmentFilter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_search_v3_results_proto_msgTypes[77]
+		mi := &file_protobuf_search_v3_results_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7427,7 +7384,7 @@ func (x *SegmentFilter) String() string {
 func (*SegmentFilter) ProtoMessage() {}
 
 func (x *SegmentFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_search_v3_results_proto_msgTypes[77]
+	mi := &file_protobuf_search_v3_results_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7440,7 +7397,7 @@ func (x *SegmentFilter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SegmentFilter.ProtoReflect.Descriptor instead.
 func (*SegmentFilter) Descriptor() ([]byte, []int) {
-	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{77}
+	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *SegmentFilter) GetAirportsArrival() []string {
@@ -7497,7 +7454,7 @@ type DateTimeOrTimeRange struct {
 func (x *DateTimeOrTimeRange) Reset() {
 	*x = DateTimeOrTimeRange{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_search_v3_results_proto_msgTypes[78]
+		mi := &file_protobuf_search_v3_results_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7510,7 +7467,7 @@ func (x *DateTimeOrTimeRange) String() string {
 func (*DateTimeOrTimeRange) ProtoMessage() {}
 
 func (x *DateTimeOrTimeRange) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_search_v3_results_proto_msgTypes[78]
+	mi := &file_protobuf_search_v3_results_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7523,7 +7480,7 @@ func (x *DateTimeOrTimeRange) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DateTimeOrTimeRange.ProtoReflect.Descriptor instead.
 func (*DateTimeOrTimeRange) Descriptor() ([]byte, []int) {
-	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{78}
+	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *DateTimeOrTimeRange) GetMin() string {
@@ -7545,14 +7502,14 @@ type DateTimeRange struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Min int64 `protobuf:"varint,1,opt,name=min,proto3" json:"min,omitempty"`
-	Max int64 `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+	Min *int64 `protobuf:"varint,1,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	Max *int64 `protobuf:"varint,2,opt,name=max,proto3,oneof" json:"max,omitempty"`
 }
 
 func (x *DateTimeRange) Reset() {
 	*x = DateTimeRange{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_search_v3_results_proto_msgTypes[79]
+		mi := &file_protobuf_search_v3_results_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7565,7 +7522,7 @@ func (x *DateTimeRange) String() string {
 func (*DateTimeRange) ProtoMessage() {}
 
 func (x *DateTimeRange) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_search_v3_results_proto_msgTypes[79]
+	mi := &file_protobuf_search_v3_results_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7578,19 +7535,19 @@ func (x *DateTimeRange) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DateTimeRange.ProtoReflect.Descriptor instead.
 func (*DateTimeRange) Descriptor() ([]byte, []int) {
-	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{79}
+	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *DateTimeRange) GetMin() int64 {
-	if x != nil {
-		return x.Min
+	if x != nil && x.Min != nil {
+		return *x.Min
 	}
 	return 0
 }
 
 func (x *DateTimeRange) GetMax() int64 {
-	if x != nil {
-		return x.Max
+	if x != nil && x.Max != nil {
+		return *x.Max
 	}
 	return 0
 }
@@ -7607,7 +7564,7 @@ type Range struct {
 func (x *Range) Reset() {
 	*x = Range{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_search_v3_results_proto_msgTypes[80]
+		mi := &file_protobuf_search_v3_results_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7620,7 +7577,7 @@ func (x *Range) String() string {
 func (*Range) ProtoMessage() {}
 
 func (x *Range) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_search_v3_results_proto_msgTypes[80]
+	mi := &file_protobuf_search_v3_results_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7633,7 +7590,7 @@ func (x *Range) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Range.ProtoReflect.Descriptor instead.
 func (*Range) Descriptor() ([]byte, []int) {
-	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{80}
+	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *Range) GetMin() int64 {
@@ -7662,7 +7619,7 @@ type FloatRange struct {
 func (x *FloatRange) Reset() {
 	*x = FloatRange{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_search_v3_results_proto_msgTypes[81]
+		mi := &file_protobuf_search_v3_results_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7675,7 +7632,7 @@ func (x *FloatRange) String() string {
 func (*FloatRange) ProtoMessage() {}
 
 func (x *FloatRange) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_search_v3_results_proto_msgTypes[81]
+	mi := &file_protobuf_search_v3_results_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7688,7 +7645,7 @@ func (x *FloatRange) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FloatRange.ProtoReflect.Descriptor instead.
 func (*FloatRange) Descriptor() ([]byte, []int) {
-	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{81}
+	return file_protobuf_search_v3_results_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *FloatRange) GetMin() float64 {
@@ -7710,582 +7667,589 @@ var File_protobuf_search_v3_results_proto protoreflect.FileDescriptor
 var file_protobuf_search_v3_results_proto_rawDesc = []byte{
 	0x0a, 0x20, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63,
 	0x68, 0x2d, 0x76, 0x33, 0x2f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x09, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x22, 0x39, 0x0a,
-	0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x28,
-	0x0a, 0x06, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x43, 0x68, 0x75, 0x6e, 0x6b,
-	0x52, 0x06, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x22, 0xa1, 0x10, 0x0a, 0x05, 0x43, 0x68, 0x75,
-	0x6e, 0x6b, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x49, 0x64, 0x12, 0x32, 0x0a,
-	0x15, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6c, 0x61,
-	0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x12, 0x38, 0x0a, 0x0a, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76,
-	0x33, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x09, 0x64,
-	0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x07, 0x74,
-	0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52,
-	0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x3f, 0x0a, 0x0c, 0x73, 0x6f, 0x66, 0x74,
-	0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x53, 0x6f, 0x66, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x01, 0x52, 0x0b, 0x73, 0x6f, 0x66, 0x74, 0x54,
-	0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x0c, 0x62, 0x72, 0x61,
-	0x6e, 0x64, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x11, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54, 0x69, 0x63, 0x6b,
-	0x65, 0x74, 0x52, 0x0b, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12,
-	0x47, 0x0a, 0x0d, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73,
-	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f,
-	0x76, 0x33, 0x2e, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x54, 0x69,
-	0x63, 0x6b, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x62, 0x72, 0x61, 0x6e,
-	0x64, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x3f, 0x0a, 0x0f, 0x63, 0x68, 0x65, 0x61,
-	0x70, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54, 0x69,
-	0x63, 0x6b, 0x65, 0x74, 0x48, 0x02, 0x52, 0x0e, 0x63, 0x68, 0x65, 0x61, 0x70, 0x65, 0x73, 0x74,
-	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12, 0x50, 0x0a, 0x18, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x65, 0x61, 0x70, 0x65, 0x73, 0x74, 0x5f, 0x74,
-	0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x48, 0x03,
-	0x52, 0x16, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x43, 0x68, 0x65, 0x61, 0x70, 0x65,
-	0x73, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12, 0x6e, 0x0a, 0x28, 0x63,
-	0x68, 0x65, 0x61, 0x70, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x77,
-	0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x5f, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x70,
-	0x72, 0x65, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x74, 0x6f, 0x12, 0x09, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x1a, 0x1f, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x39,
+	0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12,
+	0x28, 0x0a, 0x06, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x43, 0x68, 0x75, 0x6e,
+	0x6b, 0x52, 0x06, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x22, 0xa1, 0x10, 0x0a, 0x05, 0x43, 0x68,
+	0x75, 0x6e, 0x6b, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x49, 0x64, 0x12, 0x32,
+	0x0a, 0x15, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6c,
+	0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x38, 0x0a, 0x0a, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f,
+	0x76, 0x33, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x09,
+	0x64, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x07,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
 	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74,
-	0x48, 0x04, 0x52, 0x24, 0x63, 0x68, 0x65, 0x61, 0x70, 0x65, 0x73, 0x74, 0x54, 0x69, 0x63, 0x6b,
-	0x65, 0x74, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74,
-	0x50, 0x72, 0x65, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x0e, 0x64,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x0b, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e,
-	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x52, 0x0d, 0x64,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x35, 0x0a, 0x0b,
-	0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x6c, 0x65, 0x67, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x46, 0x6c,
-	0x69, 0x67, 0x68, 0x74, 0x4c, 0x65, 0x67, 0x52, 0x0a, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4c,
-	0x65, 0x67, 0x73, 0x12, 0x3a, 0x0a, 0x08, 0x61, 0x69, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18,
-	0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76,
-	0x33, 0x2e, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x2e, 0x41, 0x69, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x61, 0x69, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12,
-	0x29, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x11, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x50, 0x6c, 0x61, 0x63,
-	0x65, 0x73, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x06, 0x61, 0x67,
-	0x65, 0x6e, 0x74, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x2e, 0x41, 0x67, 0x65,
-	0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73,
-	0x12, 0x3d, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x10, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e,
-	0x43, 0x68, 0x75, 0x6e, 0x6b, 0x2e, 0x41, 0x6c, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12,
-	0x40, 0x0a, 0x0a, 0x65, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x11, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e,
-	0x43, 0x68, 0x75, 0x6e, 0x6b, 0x2e, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x65, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x12, 0x3c, 0x0a, 0x0d, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x70, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x5f, 0x76, 0x33, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x52, 0x0c, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
-	0x60, 0x0a, 0x1a, 0x64, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x5f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x13, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e,
-	0x44, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69,
-	0x65, 0x73, 0x48, 0x05, 0x52, 0x18, 0x64, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x46, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x88, 0x01,
-	0x01, 0x12, 0x47, 0x0a, 0x11, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x62, 0x6f, 0x75, 0x6e,
-	0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72,
-	0x69, 0x65, 0x73, 0x48, 0x06, 0x52, 0x10, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x6f, 0x75,
-	0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x04, 0x6d, 0x65,
-	0x74, 0x61, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x5f, 0x76, 0x33, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x4d, 0x65, 0x74, 0x61,
-	0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x3e, 0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x48, 0x07, 0x52, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18,
-	0x17, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76,
-	0x33, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x26,
-	0x0a, 0x05, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e,
-	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x52,
-	0x05, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x1a, 0x52, 0x0a, 0x11, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x54,
-	0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x27, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x53, 0x0a, 0x0d, 0x41, 0x69,
-	0x72, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x41, 0x69, 0x72, 0x6c, 0x69, 0x6e, 0x65,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
-	0x4f, 0x0a, 0x0b, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x52, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x3f, 0x0a, 0x0c, 0x73, 0x6f, 0x66,
+	0x74, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x53, 0x6f, 0x66, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x01, 0x52, 0x0b, 0x73, 0x6f, 0x66, 0x74,
+	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x0c, 0x62, 0x72,
+	0x61, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x52, 0x0b, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x12, 0x47, 0x0a, 0x0d, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x5f, 0x76, 0x33, 0x2e, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x54,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x62, 0x72, 0x61,
+	0x6e, 0x64, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x3f, 0x0a, 0x0f, 0x63, 0x68, 0x65,
+	0x61, 0x70, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x48, 0x02, 0x52, 0x0e, 0x63, 0x68, 0x65, 0x61, 0x70, 0x65, 0x73,
+	0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12, 0x50, 0x0a, 0x18, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x65, 0x61, 0x70, 0x65, 0x73, 0x74, 0x5f,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x48,
+	0x03, 0x52, 0x16, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x43, 0x68, 0x65, 0x61, 0x70,
+	0x65, 0x73, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12, 0x6e, 0x0a, 0x28,
+	0x63, 0x68, 0x65, 0x61, 0x70, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x5f,
+	0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x5f, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x5f,
+	0x70, 0x72, 0x65, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x48, 0x04, 0x52, 0x24, 0x63, 0x68, 0x65, 0x61, 0x70, 0x65, 0x73, 0x74, 0x54, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72,
+	0x74, 0x50, 0x72, 0x65, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x0e,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33,
+	0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x52, 0x0d,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x35, 0x0a,
+	0x0b, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x6c, 0x65, 0x67, 0x73, 0x18, 0x0c, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x46,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x4c, 0x65, 0x67, 0x52, 0x0a, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x4c, 0x65, 0x67, 0x73, 0x12, 0x3a, 0x0a, 0x08, 0x61, 0x69, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x73,
+	0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f,
+	0x76, 0x33, 0x2e, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x2e, 0x41, 0x69, 0x72, 0x6c, 0x69, 0x6e, 0x65,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x61, 0x69, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x73,
+	0x12, 0x29, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x50, 0x6c, 0x61,
+	0x63, 0x65, 0x73, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x06, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x2e, 0x41, 0x67,
+	0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x3d, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x10,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33,
+	0x2e, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x2e, 0x41, 0x6c, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x12, 0x40, 0x0a, 0x0a, 0x65, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x11,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33,
+	0x2e, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x2e, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x65, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x3c, 0x0a, 0x0d, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x52, 0x0c, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x12, 0x60, 0x0a, 0x1a, 0x64, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x5f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x13,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33,
+	0x2e, 0x44, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72,
+	0x69, 0x65, 0x73, 0x48, 0x05, 0x52, 0x18, 0x64, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x47, 0x0a, 0x11, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x62, 0x6f, 0x75,
+	0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61,
+	0x72, 0x69, 0x65, 0x73, 0x48, 0x06, 0x52, 0x10, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x6f,
+	0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x04, 0x6d,
+	0x65, 0x74, 0x61, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x4d, 0x65, 0x74,
+	0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x3e, 0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x48, 0x07, 0x52, 0x0b, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x18, 0x17, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f,
+	0x76, 0x33, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12,
+	0x26, 0x0a, 0x05, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10,
+	0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x64,
+	0x52, 0x05, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x1a, 0x52, 0x0a, 0x11, 0x42, 0x72, 0x61, 0x6e, 0x64,
+	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x27,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x53, 0x0a, 0x0d, 0x41,
+	0x69, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x41, 0x69, 0x72, 0x6c, 0x69, 0x6e,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x4f, 0x0a, 0x0b, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x51, 0x0a, 0x0e, 0x41, 0x6c, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33,
+	0x2e, 0x41, 0x6c, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x53, 0x0a, 0x0f, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x5f, 0x76, 0x33, 0x2e, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x64, 0x65,
+	0x62, 0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x73, 0x6f, 0x66,
+	0x74, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x63, 0x68,
+	0x65, 0x61, 0x70, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x1b, 0x0a,
+	0x19, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x65, 0x61, 0x70,
+	0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x2b, 0x0a, 0x29, 0x5f, 0x63,
+	0x68, 0x65, 0x61, 0x70, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x77,
+	0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x5f, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x70,
+	0x72, 0x65, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x42, 0x1d, 0x0a, 0x1b, 0x5f, 0x64, 0x65, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x62, 0x6f, 0x75, 0x6e,
+	0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x5f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x42, 0x0f, 0x0a, 0x0d,
+	0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0xc5, 0x02,
+	0x0a, 0x09, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x64, 0x61, 0x74, 0x61, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x43, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x35, 0x0a,
+	0x05, 0x67, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e,
+	0x66, 0x6f, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x67,
+	0x61, 0x74, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x12, 0x46, 0x0a, 0x11, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x1a, 0x52, 0x0a, 0x0a, 0x47,
+	0x61, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x44, 0x65, 0x62, 0x75, 0x67,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x4a,
+	0x04, 0x08, 0x05, 0x10, 0x06, 0x22, 0x8d, 0x03, 0x0a, 0x0d, 0x47, 0x61, 0x74, 0x65, 0x44, 0x65,
+	0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x06, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x44, 0x65, 0x62, 0x75,
+	0x67, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x06, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x3a, 0x0a, 0x19, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x17, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x2a, 0x0a, 0x11,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x55, 0x75, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x17, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x1a,
+	0x54, 0x0a, 0x0b, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
 	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x14, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x41, 0x67, 0x65, 0x6e,
-	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x1a, 0x51, 0x0a, 0x0e, 0x41, 0x6c, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e,
-	0x41, 0x6c, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x1a, 0x53, 0x0a, 0x0f, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x41, 0x67, 0x65, 0x6e,
+	0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xf2, 0x05, 0x0a, 0x0e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x44,
+	0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x46, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x62,
+	0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73,
+	0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x50, 0x0a, 0x0d, 0x62, 0x61, 0x64,
+	0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2b, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x42, 0x61, 0x64, 0x50,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x62,
+	0x61, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x12, 0x5f, 0x0a, 0x12, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x5f, 0x76, 0x33, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e,
+	0x66, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x12, 0x76, 0x0a, 0x1b,
+	0x6d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x74, 0x65,
+	0x72, 0x6d, 0x73, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x37, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x41, 0x67,
+	0x65, 0x6e, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x4d, 0x65, 0x72,
+	0x67, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x18, 0x6d, 0x65, 0x72, 0x67,
+	0x65, 0x64, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x1a, 0x5a, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
 	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x5f, 0x76, 0x33, 0x2e, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x64, 0x65, 0x62,
-	0x75, 0x67, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x73, 0x6f, 0x66, 0x74,
-	0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x63, 0x68, 0x65,
-	0x61, 0x70, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x1b, 0x0a, 0x19,
-	0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x65, 0x61, 0x70, 0x65,
-	0x73, 0x74, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x2b, 0x0a, 0x29, 0x5f, 0x63, 0x68,
-	0x65, 0x61, 0x70, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x77, 0x69,
-	0x74, 0x68, 0x6f, 0x75, 0x74, 0x5f, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x70, 0x72,
-	0x65, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x42, 0x1d, 0x0a, 0x1b, 0x5f, 0x64, 0x65, 0x67, 0x72, 0x61,
-	0x64, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x62, 0x6f, 0x75, 0x6e, 0x64,
-	0x61, 0x72, 0x69, 0x65, 0x73, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x5f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
-	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0xa3, 0x02, 0x0a,
-	0x09, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64,
-	0x61, 0x74, 0x61, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x43, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x05,
-	0x67, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66,
-	0x6f, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x67, 0x61,
-	0x74, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x63, 0x61, 0x63, 0x68,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x43, 0x61, 0x63,
-	0x68, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x73,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x1a, 0x52,
-	0x0a, 0x0a, 0x47, 0x61, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2e,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
-	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x44, 0x65,
-	0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x22, 0x8d, 0x03, 0x0a, 0x0d, 0x47, 0x61, 0x74, 0x65, 0x44, 0x65, 0x62, 0x75, 0x67,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x06, 0x61, 0x67, 0x65, 0x6e,
-	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x5f, 0x76, 0x33, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e,
-	0x66, 0x6f, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06,
-	0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x3a, 0x0a, 0x19, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f,
-	0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x17, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e,
-	0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x72,
-	0x6f, 0x6d, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
-	0x66, 0x72, 0x6f, 0x6d, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x61, 0x63,
-	0x68, 0x65, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x55, 0x75, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x17, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x73,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x63, 0x61, 0x63, 0x68, 0x65, 0x53, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x1a, 0x54, 0x0a, 0x0b,
-	0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x44, 0x65,
-	0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x22, 0xf2, 0x05, 0x0a, 0x0e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x62, 0x75,
-	0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x46, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
-	0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x5f, 0x76, 0x33, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49,
-	0x6e, 0x66, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x12, 0x27, 0x0a,
-	0x0f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
-	0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x50, 0x0a, 0x0d, 0x62, 0x61, 0x64, 0x5f, 0x70, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
-	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x44,
-	0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x42, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x70,
-	0x6f, 0x73, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x62, 0x61, 0x64, 0x50,
-	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x12, 0x5f, 0x0a, 0x12, 0x66, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33,
-	0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x2e,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64,
-	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x12, 0x76, 0x0a, 0x1b, 0x6d, 0x65, 0x72,
-	0x67, 0x65, 0x64, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x73,
-	0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37,
-	0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74,
-	0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x64,
-	0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x53, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x18, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x46,
-	0x6c, 0x69, 0x67, 0x68, 0x74, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x73, 0x1a, 0x5a, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33,
-	0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3f, 0x0a,
-	0x11, 0x42, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5a,
-	0x0a, 0x16, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
-	0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x4b, 0x0a, 0x1d, 0x4d, 0x65,
-	0x72, 0x67, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3e, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x61, 0x6c, 0x73, 0x12, 0x31, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x5f, 0x76, 0x33, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x09, 0x70, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x22, 0x8f, 0x07, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x70,
-	0x6f, 0x73, 0x61, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e,
-	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x3b, 0x0a,
-	0x10, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x5f, 0x76, 0x33, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0e, 0x70, 0x72, 0x69, 0x63,
-	0x65, 0x50, 0x65, 0x72, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x67,
-	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x67,
-	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x47, 0x0a, 0x0c, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f,
-	0x74, 0x65, 0x72, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
-	0x2e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x0b, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x12, 0x3f,
-	0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x73,
-	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f,
-	0x76, 0x33, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x65, 0x72, 0x6d, 0x73,
-	0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x12,
-	0x36, 0x0a, 0x0d, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f,
-	0x76, 0x33, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0c, 0x75, 0x6e, 0x69, 0x66, 0x69,
-	0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x5f, 0x76, 0x33, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x88,
-	0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x66, 0x72,
-	0x6f, 0x6d, 0x5f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x61, 0x69, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x66, 0x72, 0x6f, 0x6d, 0x4d, 0x61, 0x69, 0x6e, 0x41,
-	0x69, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x0b,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x32, 0x0a, 0x0c, 0x6d, 0x69,
-	0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x66, 0x61, 0x72, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0f, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x46, 0x61, 0x72,
-	0x65, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x46, 0x61, 0x72, 0x65, 0x12, 0x21,
-	0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x77, 0x61, 0x72, 0x6d, 0x63, 0x61, 0x63, 0x68, 0x65, 0x18, 0x0d,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x57, 0x61, 0x72, 0x6d, 0x63, 0x61, 0x63, 0x68,
-	0x65, 0x12, 0x34, 0x0a, 0x08, 0x63, 0x61, 0x73, 0x68, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x0e, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e,
-	0x43, 0x61, 0x73, 0x68, 0x62, 0x61, 0x63, 0x6b, 0x48, 0x01, 0x52, 0x08, 0x63, 0x61, 0x73, 0x68,
-	0x62, 0x61, 0x63, 0x6b, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x13, 0x63, 0x61, 0x73, 0x68, 0x62,
-	0x61, 0x63, 0x6b, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x18, 0x0f,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33,
-	0x2e, 0x43, 0x61, 0x73, 0x68, 0x62, 0x61, 0x63, 0x6b, 0x48, 0x02, 0x52, 0x11, 0x63, 0x61, 0x73,
-	0x68, 0x62, 0x61, 0x63, 0x6b, 0x50, 0x65, 0x72, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x88, 0x01,
-	0x01, 0x12, 0x3e, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x61,
-	0x72, 0x64, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x43, 0x61,
-	0x72, 0x64, 0x52, 0x0d, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x43, 0x61, 0x72, 0x64,
-	0x73, 0x1a, 0x55, 0x0a, 0x10, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x54, 0x65, 0x72, 0x6d, 0x73,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x5f, 0x76, 0x33, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x44, 0x65, 0x62, 0x75,
+	0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x3f, 0x0a, 0x11, 0x42, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73,
 	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f,
-	0x76, 0x33, 0x2e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x54, 0x65, 0x72, 0x6d, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x63, 0x61, 0x73, 0x68, 0x62, 0x61, 0x63,
-	0x6b, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x63, 0x61, 0x73, 0x68, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x70,
-	0x65, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x22, 0x3e, 0x0a, 0x0c, 0x41, 0x63, 0x63,
-	0x65, 0x70, 0x74, 0x65, 0x64, 0x43, 0x61, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67,
-	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f,
-	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x22, 0x80, 0x01, 0x0a, 0x08, 0x43, 0x61,
-	0x73, 0x68, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x41, 0x0a, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x69,
-	0x7a, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x41, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
-	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61,
-	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x76,
-	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x6c, 0x6f, 0x63, 0x61,
-	0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa6, 0x06, 0x0a,
-	0x04, 0x46, 0x61, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x62, 0x61, 0x67,
-	0x67, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x42, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x48, 0x00,
-	0x52, 0x07, 0x62, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x08,
-	0x68, 0x61, 0x6e, 0x64, 0x62, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
-	0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x42, 0x61, 0x67, 0x67, 0x61,
-	0x67, 0x65, 0x48, 0x01, 0x52, 0x08, 0x68, 0x61, 0x6e, 0x64, 0x62, 0x61, 0x67, 0x73, 0x88, 0x01,
-	0x01, 0x12, 0x4c, 0x0a, 0x14, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x62, 0x65, 0x66, 0x6f,
-	0x72, 0x65, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x5a, 0x0a, 0x16, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x50, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x4b, 0x0a,
+	0x1d, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x54, 0x65, 0x72,
+	0x6d, 0x73, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3e, 0x0a, 0x09, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x12, 0x31, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52,
+	0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x22, 0x8f, 0x07, 0x0a, 0x08, 0x50,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f,
+	0x76, 0x33, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x3b, 0x0a, 0x10, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x70, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0e, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x12, 0x19, 0x0a,
+	0x08, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x47, 0x0a, 0x0c, 0x66, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x2e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x54, 0x65, 0x72, 0x6d,
+	0x73, 0x12, 0x3f, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x74, 0x65,
+	0x72, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x65,
+	0x72, 0x6d, 0x73, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x65, 0x72,
+	0x6d, 0x73, 0x12, 0x36, 0x0a, 0x0d, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0c, 0x75, 0x6e,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2a, 0x0a,
+	0x11, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x61, 0x69, 0x72, 0x6c, 0x69,
+	0x6e, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x66, 0x72, 0x6f, 0x6d, 0x4d, 0x61,
+	0x69, 0x6e, 0x41, 0x69, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67,
+	0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x32, 0x0a,
+	0x0c, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x66, 0x61, 0x72, 0x65, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e,
+	0x46, 0x61, 0x72, 0x65, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x46, 0x61, 0x72,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x77, 0x61, 0x72, 0x6d, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x57, 0x61, 0x72, 0x6d, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x63, 0x61, 0x73, 0x68, 0x62, 0x61, 0x63, 0x6b,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f,
+	0x76, 0x33, 0x2e, 0x43, 0x61, 0x73, 0x68, 0x62, 0x61, 0x63, 0x6b, 0x48, 0x01, 0x52, 0x08, 0x63,
+	0x61, 0x73, 0x68, 0x62, 0x61, 0x63, 0x6b, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x13, 0x63, 0x61,
+	0x73, 0x68, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x5f, 0x76, 0x33, 0x2e, 0x43, 0x61, 0x73, 0x68, 0x62, 0x61, 0x63, 0x6b, 0x48, 0x02, 0x52, 0x11,
+	0x63, 0x61, 0x73, 0x68, 0x62, 0x61, 0x63, 0x6b, 0x50, 0x65, 0x72, 0x50, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64,
+	0x5f, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65,
+	0x64, 0x43, 0x61, 0x72, 0x64, 0x52, 0x0d, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x43,
+	0x61, 0x72, 0x64, 0x73, 0x1a, 0x55, 0x0a, 0x10, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x54, 0x65,
+	0x72, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x54, 0x65, 0x72, 0x6d,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x63, 0x61, 0x73, 0x68,
+	0x62, 0x61, 0x63, 0x6b, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x63, 0x61, 0x73, 0x68, 0x62, 0x61, 0x63,
+	0x6b, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x22, 0x3e, 0x0a, 0x0c,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x43, 0x61, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x22, 0x80, 0x01, 0x0a,
+	0x08, 0x43, 0x61, 0x73, 0x68, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x41, 0x0a, 0x10, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x09,
+	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0xa6, 0x06, 0x0a, 0x04, 0x46, 0x61, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x31, 0x0a, 0x07,
+	0x62, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x42, 0x61, 0x67, 0x67, 0x61, 0x67,
+	0x65, 0x48, 0x00, 0x52, 0x07, 0x62, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x33, 0x0a, 0x08, 0x68, 0x61, 0x6e, 0x64, 0x62, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x42, 0x61,
+	0x67, 0x67, 0x61, 0x67, 0x65, 0x48, 0x01, 0x52, 0x08, 0x68, 0x61, 0x6e, 0x64, 0x62, 0x61, 0x67,
+	0x73, 0x88, 0x01, 0x01, 0x12, 0x4c, 0x0a, 0x14, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x62,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54,
+	0x61, 0x72, 0x69, 0x66, 0x66, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x02, 0x52, 0x12, 0x72, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x4a, 0x0a, 0x13, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x15, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54, 0x61, 0x72, 0x69,
-	0x66, 0x66, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x02, 0x52, 0x12, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
-	0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x88, 0x01, 0x01, 0x12,
-	0x4a, 0x0a, 0x13, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f,
-	0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73,
+	0x66, 0x66, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x03, 0x52, 0x11, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x41, 0x66, 0x74, 0x65, 0x72, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x88, 0x01, 0x01, 0x12, 0x4c,
+	0x0a, 0x14, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f,
+	0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73,
 	0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x49,
-	0x6e, 0x66, 0x6f, 0x48, 0x03, 0x52, 0x11, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x41, 0x66, 0x74,
-	0x65, 0x72, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x88, 0x01, 0x01, 0x12, 0x4c, 0x0a, 0x14, 0x63,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x66, 0x6c, 0x69,
-	0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x49, 0x6e, 0x66, 0x6f,
-	0x48, 0x04, 0x52, 0x12, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65,
-	0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x88, 0x01, 0x01, 0x12, 0x4a, 0x0a, 0x13, 0x63, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f,
-	0x76, 0x33, 0x2e, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x05, 0x52,
-	0x11, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x46, 0x6c, 0x69, 0x67,
-	0x68, 0x74, 0x88, 0x01, 0x01, 0x12, 0x44, 0x0a, 0x10, 0x73, 0x65, 0x61, 0x74, 0x5f, 0x61, 0x74,
-	0x5f, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x15, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54, 0x61, 0x72, 0x69,
-	0x66, 0x66, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x06, 0x52, 0x0e, 0x73, 0x65, 0x61, 0x74, 0x41, 0x74,
-	0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x88, 0x01, 0x01, 0x12, 0x4c, 0x0a, 0x14, 0x73,
-	0x65, 0x61, 0x74, 0x5f, 0x61, 0x74, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x61, 0x72,
+	0x6e, 0x66, 0x6f, 0x48, 0x04, 0x52, 0x12, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x88, 0x01, 0x01, 0x12, 0x4a, 0x0a, 0x13,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x66, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x61, 0x72,
 	0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x49, 0x6e, 0x66, 0x6f,
-	0x48, 0x07, 0x52, 0x12, 0x73, 0x65, 0x61, 0x74, 0x41, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x61, 0x72,
-	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x61,
-	0x72, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x69, 0x6c, 0x65, 0x73, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x6d, 0x69, 0x6c, 0x65, 0x73, 0x42, 0x0a, 0x0a, 0x08,
-	0x5f, 0x62, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x68, 0x61, 0x6e,
-	0x64, 0x62, 0x61, 0x67, 0x73, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
-	0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x42, 0x16,
-	0x0a, 0x14, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f,
-	0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x42,
-	0x16, 0x0a, 0x14, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72,
-	0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x73, 0x65, 0x61, 0x74,
-	0x5f, 0x61, 0x74, 0x5f, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x42, 0x17, 0x0a, 0x15,
-	0x5f, 0x73, 0x65, 0x61, 0x74, 0x5f, 0x61, 0x74, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc5, 0x01, 0x0a, 0x07, 0x42, 0x61, 0x67, 0x67, 0x61, 0x67,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
-	0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x57, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69,
-	0x64, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68,
-	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x6d, 0x5f,
-	0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x0c, 0x73, 0x75, 0x6d, 0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x8e, 0x01,
-	0x0a, 0x0a, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09,
-	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x70, 0x65,
-	0x6e, 0x61, 0x6c, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x00,
-	0x52, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0e,
-	0x69, 0x73, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x22, 0x39,
-	0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x26, 0x0a, 0x05, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x10, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x48, 0x6f, 0x74,
-	0x65, 0x6c, 0x52, 0x05, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x22, 0x64, 0x0a, 0x05, 0x48, 0x6f, 0x74,
-	0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09,
-	0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x72, 0x6f, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x65, 0x61,
-	0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x65, 0x61, 0x6c, 0x73, 0x22,
-	0x3e, 0x0a, 0x0d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x65, 0x72, 0x6d, 0x73,
-	0x12, 0x2d, 0x0a, 0x05, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x66, 0x65, 0x72, 0x54, 0x65, 0x72, 0x6d, 0x52, 0x05, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x22,
-	0x54, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x65, 0x72, 0x6d, 0x12,
-	0x30, 0x0a, 0x14, 0x69, 0x73, 0x5f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69,
-	0x73, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6c, 0x69, 0x6e,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0xc0, 0x06, 0x0a, 0x0a, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74,
-	0x54, 0x65, 0x72, 0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x61, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x64,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x64,
-	0x65, 0x12, 0x33, 0x0a, 0x0a, 0x74, 0x72, 0x69, 0x70, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76,
-	0x33, 0x2e, 0x54, 0x72, 0x69, 0x70, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x09, 0x74, 0x72, 0x69,
-	0x70, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x61, 0x74, 0x73, 0x5f,
-	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0e, 0x73, 0x65, 0x61, 0x74, 0x73, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12,
-	0x62, 0x0a, 0x1c, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x61, 0x72,
-	0x72, 0x69, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76,
-	0x33, 0x2e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x44, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x6f, 0x72, 0x48, 0x00, 0x52, 0x1a, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x43,
-	0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x44, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x6f, 0x72,
-	0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x07, 0x62, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33,
-	0x2e, 0x42, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x48, 0x01, 0x52, 0x07, 0x62, 0x61, 0x67, 0x67,
-	0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x08, 0x68, 0x61, 0x6e, 0x64, 0x62, 0x61,
-	0x67, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x5f, 0x76, 0x33, 0x2e, 0x42, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x48, 0x02, 0x52, 0x08,
-	0x68, 0x61, 0x6e, 0x64, 0x62, 0x61, 0x67, 0x73, 0x88, 0x01, 0x01, 0x12, 0x5a, 0x0a, 0x16, 0x61,
-	0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x74, 0x61, 0x72, 0x69, 0x66, 0x66,
-	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x61, 0x6c, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x03, 0x52, 0x14,
-	0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66,
-	0x49, 0x6e, 0x66, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x63, 0x68,
-	0x61, 0x72, 0x74, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x43,
-	0x68, 0x61, 0x72, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x09,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x46, 0x0a, 0x11, 0x6d, 0x65,
-	0x72, 0x67, 0x65, 0x64, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76,
-	0x33, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x0f, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x72, 0x0a, 0x1b, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f,
-	0x6d, 0x5f, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
-	0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x5f, 0x76, 0x33, 0x2e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x54, 0x65, 0x72, 0x6d, 0x2e, 0x4d,
-	0x65, 0x72, 0x67, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x50, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x18, 0x6d, 0x65,
-	0x72, 0x67, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x50, 0x72, 0x6f,
-	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x1a, 0x4b, 0x0a, 0x1d, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x64,
-	0x46, 0x72, 0x6f, 0x6d, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
-	0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x42, 0x1f, 0x0a, 0x1d, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x69, 0x6e,
-	0x67, 0x5f, 0x63, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x73, 0x69, 0x67, 0x6e,
-	0x61, 0x74, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x62, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65,
-	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x62, 0x61, 0x67, 0x73, 0x42, 0x19, 0x0a,
-	0x17, 0x5f, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x74, 0x61, 0x72,
-	0x69, 0x66, 0x66, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0xc9, 0x04, 0x0a, 0x0f, 0x4d, 0x65, 0x72,
-	0x67, 0x65, 0x64, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x4c, 0x0a, 0x14,
-	0x73, 0x65, 0x61, 0x74, 0x5f, 0x61, 0x74, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x4d, 0x65, 0x72,
-	0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x12, 0x73, 0x65, 0x61, 0x74, 0x41, 0x74, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x10, 0x73, 0x65,
-	0x61, 0x74, 0x5f, 0x61, 0x74, 0x5f, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33,
-	0x2e, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x0e, 0x73, 0x65, 0x61, 0x74, 0x41, 0x74, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65,
-	0x12, 0x4c, 0x0a, 0x14, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72,
-	0x65, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x48, 0x05, 0x52, 0x11, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x46,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x88, 0x01, 0x01, 0x12, 0x44, 0x0a, 0x10, 0x73, 0x65, 0x61, 0x74,
+	0x5f, 0x61, 0x74, 0x5f, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54,
+	0x61, 0x72, 0x69, 0x66, 0x66, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x06, 0x52, 0x0e, 0x73, 0x65, 0x61,
+	0x74, 0x41, 0x74, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x88, 0x01, 0x01, 0x12, 0x4c,
+	0x0a, 0x14, 0x73, 0x65, 0x61, 0x74, 0x5f, 0x61, 0x74, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x49,
+	0x6e, 0x66, 0x6f, 0x48, 0x07, 0x52, 0x12, 0x73, 0x65, 0x61, 0x74, 0x41, 0x74, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x09,
+	0x66, 0x61, 0x72, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x66, 0x61, 0x72, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x69, 0x6c,
+	0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x6d, 0x69, 0x6c, 0x65, 0x73, 0x42,
+	0x0a, 0x0a, 0x08, 0x5f, 0x62, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
+	0x68, 0x61, 0x6e, 0x64, 0x62, 0x61, 0x67, 0x73, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x72, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x66, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x73,
+	0x65, 0x61, 0x74, 0x5f, 0x61, 0x74, 0x5f, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x42,
+	0x17, 0x0a, 0x15, 0x5f, 0x73, 0x65, 0x61, 0x74, 0x5f, 0x61, 0x74, 0x5f, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc5, 0x01, 0x0a, 0x07, 0x42, 0x61, 0x67,
+	0x67, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x57,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x14, 0x0a,
+	0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x77, 0x69,
+	0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73,
+	0x75, 0x6d, 0x5f, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0c, 0x73, 0x75, 0x6d, 0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x8e, 0x01, 0x0a, 0x0a, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x30, 0x0a,
+	0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x48, 0x00, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12,
+	0x24, 0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74,
+	0x79, 0x22, 0x39, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e,
+	0x48, 0x6f, 0x74, 0x65, 0x6c, 0x52, 0x05, 0x68, 0x6f, 0x74, 0x65, 0x6c, 0x22, 0x64, 0x0a, 0x05,
+	0x48, 0x6f, 0x74, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x73, 0x12,
+	0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x6d, 0x65, 0x61, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x65, 0x61,
+	0x6c, 0x73, 0x22, 0x3e, 0x0a, 0x0d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x65,
+	0x72, 0x6d, 0x73, 0x12, 0x2d, 0x0a, 0x05, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x65, 0x72, 0x6d, 0x52, 0x05, 0x74, 0x65, 0x72,
+	0x6d, 0x73, 0x22, 0x54, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x65,
+	0x72, 0x6d, 0x12, 0x30, 0x0a, 0x14, 0x69, 0x73, 0x5f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c,
+	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x12, 0x69, 0x73, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x6c, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0xc0, 0x06, 0x0a, 0x0a, 0x46, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x54, 0x65, 0x72, 0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x61, 0x72, 0x65, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x61, 0x72, 0x65,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x33, 0x0a, 0x0a, 0x74, 0x72, 0x69, 0x70, 0x5f, 0x63, 0x6c, 0x61,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54, 0x72, 0x69, 0x70, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x09,
+	0x74, 0x72, 0x69, 0x70, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x61,
+	0x74, 0x73, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0e, 0x73, 0x65, 0x61, 0x74, 0x73, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x12, 0x62, 0x0a, 0x1c, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x5f,
+	0x63, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x5f, 0x76, 0x33, 0x2e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x44, 0x65, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x1a, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x44, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x07, 0x62, 0x61, 0x67, 0x67, 0x61, 0x67,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x5f, 0x76, 0x33, 0x2e, 0x42, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x48, 0x01, 0x52, 0x07, 0x62,
+	0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x08, 0x68, 0x61, 0x6e,
+	0x64, 0x62, 0x61, 0x67, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x42, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x48,
+	0x02, 0x52, 0x08, 0x68, 0x61, 0x6e, 0x64, 0x62, 0x61, 0x67, 0x73, 0x88, 0x01, 0x01, 0x12, 0x5a,
+	0x0a, 0x16, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x74, 0x61, 0x72,
+	0x69, 0x66, 0x66, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x41, 0x64, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x49, 0x6e, 0x66, 0x6f, 0x48,
+	0x03, 0x52, 0x14, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x54, 0x61, 0x72,
+	0x69, 0x66, 0x66, 0x49, 0x6e, 0x66, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73,
+	0x5f, 0x63, 0x68, 0x61, 0x72, 0x74, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x69, 0x73, 0x43, 0x68, 0x61, 0x72, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67,
+	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x46, 0x0a,
+	0x11, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x5f, 0x69, 0x6e,
+	0x66, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x5f, 0x76, 0x33, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x54, 0x65, 0x72, 0x6d, 0x73,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x54, 0x65, 0x72, 0x6d,
+	0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x72, 0x0a, 0x1b, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x5f,
+	0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x54, 0x65, 0x72,
+	0x6d, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x4f, 0x74, 0x68, 0x65,
+	0x72, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x18, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x4f, 0x74, 0x68, 0x65, 0x72,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x1a, 0x4b, 0x0a, 0x1d, 0x4d, 0x65, 0x72,
+	0x67, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x1f, 0x0a, 0x1d, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x62, 0x61, 0x67, 0x67,
+	0x61, 0x67, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x62, 0x61, 0x67, 0x73,
+	0x42, 0x19, 0x0a, 0x17, 0x5f, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f,
+	0x74, 0x61, 0x72, 0x69, 0x66, 0x66, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0xc9, 0x04, 0x0a, 0x0f,
+	0x4d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x4c, 0x0a, 0x14, 0x73, 0x65, 0x61, 0x74, 0x5f, 0x61, 0x74, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66,
+	0x4d, 0x65, 0x72, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x12, 0x73, 0x65, 0x61, 0x74, 0x41,
+	0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x44, 0x0a,
+	0x10, 0x73, 0x65, 0x61, 0x74, 0x5f, 0x61, 0x74, 0x5f, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x5f, 0x76, 0x33, 0x2e, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x73, 0x65, 0x61, 0x74, 0x41, 0x74, 0x50, 0x75, 0x72, 0x63, 0x68,
+	0x61, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x14, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x62, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54, 0x61,
+	0x72, 0x69, 0x66, 0x66, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x12, 0x72,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x46, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x4a, 0x0a, 0x13, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
 	0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54, 0x61, 0x72, 0x69, 0x66,
-	0x66, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x12, 0x72, 0x65, 0x74, 0x75,
-	0x72, 0x6e, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x4a,
-	0x0a, 0x13, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x66,
-	0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x65,
+	0x66, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x11, 0x72, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x41, 0x66, 0x74, 0x65, 0x72, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x4c, 0x0a,
+	0x14, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x66,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x65,
 	0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x4d, 0x65,
-	0x72, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x11, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x41,
-	0x66, 0x74, 0x65, 0x72, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x4c, 0x0a, 0x14, 0x63, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x66, 0x6c, 0x69, 0x67,
-	0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x72, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x12, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x4a, 0x0a, 0x13, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x66, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63,
 	0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x4d, 0x65, 0x72, 0x67, 0x65,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x12, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x65, 0x66, 0x6f,
-	0x72, 0x65, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x4a, 0x0a, 0x13, 0x63, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76,
-	0x33, 0x2e, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x11, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x46, 0x6c,
-	0x69, 0x67, 0x68, 0x74, 0x12, 0x35, 0x0a, 0x07, 0x62, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76,
-	0x33, 0x2e, 0x42, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x07, 0x62, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x68,
-	0x61, 0x6e, 0x64, 0x62, 0x61, 0x67, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
-	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x42, 0x61, 0x67, 0x67, 0x61, 0x67,
-	0x65, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x68, 0x61, 0x6e, 0x64,
-	0x62, 0x61, 0x67, 0x73, 0x22, 0x8f, 0x01, 0x0a, 0x0f, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x4d,
-	0x65, 0x72, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x42, 0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x66,
-	0x72, 0x6f, 0x6d, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54, 0x61, 0x72,
-	0x69, 0x66, 0x66, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x0c,
-	0x69, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x38, 0x0a, 0x08,
-	0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54, 0x61, 0x72, 0x69, 0x66,
-	0x66, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x08, 0x6d, 0x69,
-	0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x22, 0x8a, 0x01, 0x0a, 0x11, 0x54, 0x61, 0x72, 0x69, 0x66,
-	0x66, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1c, 0x0a, 0x09,
-	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x70, 0x65,
-	0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63,
-	0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x70, 0x65, 0x6e, 0x61, 0x6c,
-	0x74, 0x79, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x23,
-	0x0a, 0x0d, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x22, 0x92, 0x01, 0x0a, 0x10, 0x42, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x4d,
-	0x65, 0x72, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x43, 0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x66,
-	0x72, 0x6f, 0x6d, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1d, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x42, 0x61, 0x67,
-	0x67, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52,
-	0x0c, 0x69, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x39, 0x0a,
-	0x08, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1d, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x42, 0x61, 0x67, 0x67,
-	0x61, 0x67, 0x65, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x08,
-	0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x22, 0xd0, 0x01, 0x0a, 0x12, 0x42, 0x61, 0x67,
-	0x67, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
-	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x21, 0x0a,
-	0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67,
-	0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68,
-	0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x6d, 0x5f, 0x64, 0x69,
-	0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x73,
-	0x75, 0x6d, 0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xbd, 0x05, 0x0a, 0x14,
-	0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x4d, 0x0a, 0x15, 0x73, 0x65, 0x61, 0x74, 0x5f, 0x61, 0x74, 0x5f,
-	0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e,
-	0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x12, 0x73, 0x65,
-	0x61, 0x74, 0x41, 0x74, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x66, 0x6f,
-	0x88, 0x01, 0x01, 0x12, 0x55, 0x0a, 0x19, 0x73, 0x65, 0x61, 0x74, 0x5f, 0x61, 0x74, 0x5f, 0x72,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f,
-	0x76, 0x33, 0x2e, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x01, 0x52,
-	0x16, 0x73, 0x65, 0x61, 0x74, 0x41, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x4c, 0x0a, 0x14, 0x72, 0x65,
-	0x74, 0x75, 0x72, 0x6e, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x66, 0x6c, 0x69, 0x67,
-	0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x11, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x66, 0x74, 0x65,
+	0x72, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x35, 0x0a, 0x07, 0x62, 0x61, 0x67, 0x67, 0x61,
+	0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x5f, 0x76, 0x33, 0x2e, 0x42, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x72, 0x67,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x62, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x12, 0x37,
+	0x0a, 0x08, 0x68, 0x61, 0x6e, 0x64, 0x62, 0x61, 0x67, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x42, 0x61, 0x67,
+	0x67, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x68,
+	0x61, 0x6e, 0x64, 0x62, 0x61, 0x67, 0x73, 0x22, 0x8f, 0x01, 0x0a, 0x0f, 0x54, 0x61, 0x72, 0x69,
+	0x66, 0x66, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x42, 0x0a, 0x0e, 0x69,
+	0x73, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e,
+	0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x52, 0x0c, 0x69, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x38, 0x0a, 0x08, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54, 0x61,
+	0x72, 0x69, 0x66, 0x66, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52,
+	0x08, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x22, 0x8a, 0x01, 0x0a, 0x11, 0x54, 0x61,
+	0x72, 0x69, 0x66, 0x66, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x32, 0x0a,
+	0x15, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x70, 0x65,
+	0x6e, 0x61, 0x6c, 0x74, 0x79, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74,
+	0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x92, 0x01, 0x0a, 0x10, 0x42, 0x61, 0x67, 0x67, 0x61,
+	0x67, 0x65, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x43, 0x0a, 0x0e, 0x69,
+	0x73, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e,
+	0x42, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x52, 0x0c, 0x69, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x39, 0x0a, 0x08, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x42,
+	0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x52, 0x08, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x22, 0xd0, 0x01, 0x0a, 0x12,
+	0x42, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x57, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x6d,
+	0x5f, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0c, 0x73, 0x75, 0x6d, 0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xbd,
+	0x05, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x54, 0x61, 0x72,
+	0x69, 0x66, 0x66, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x4d, 0x0a, 0x15, 0x73, 0x65, 0x61, 0x74, 0x5f,
+	0x61, 0x74, 0x5f, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f,
+	0x76, 0x33, 0x2e, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52,
+	0x12, 0x73, 0x65, 0x61, 0x74, 0x41, 0x74, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x55, 0x0a, 0x19, 0x73, 0x65, 0x61, 0x74, 0x5f, 0x61,
+	0x74, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x49, 0x6e, 0x66, 0x6f,
+	0x48, 0x01, 0x52, 0x16, 0x73, 0x65, 0x61, 0x74, 0x41, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x4c, 0x0a,
+	0x14, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x66,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x49, 0x6e,
+	0x66, 0x6f, 0x48, 0x02, 0x52, 0x12, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x42, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x88, 0x01, 0x01, 0x12, 0x4a, 0x0a, 0x13, 0x72,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x66, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63,
 	0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x49, 0x6e, 0x66, 0x6f, 0x48,
-	0x02, 0x52, 0x12, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x46,
-	0x6c, 0x69, 0x67, 0x68, 0x74, 0x88, 0x01, 0x01, 0x12, 0x4a, 0x0a, 0x13, 0x72, 0x65, 0x74, 0x75,
-	0x72, 0x6e, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76,
-	0x33, 0x2e, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x03, 0x52, 0x11,
-	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x41, 0x66, 0x74, 0x65, 0x72, 0x46, 0x6c, 0x69, 0x67, 0x68,
-	0x74, 0x88, 0x01, 0x01, 0x12, 0x4c, 0x0a, 0x14, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x62,
-	0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x03, 0x52, 0x11, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x41, 0x66, 0x74, 0x65, 0x72, 0x46, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x88, 0x01, 0x01, 0x12, 0x4c, 0x0a, 0x14, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76,
+	0x33, 0x2e, 0x54, 0x61, 0x72, 0x69, 0x66, 0x66, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x04, 0x52, 0x12,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x46, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x88, 0x01, 0x01, 0x12, 0x4a, 0x0a, 0x13, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54,
-	0x61, 0x72, 0x69, 0x66, 0x66, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x04, 0x52, 0x12, 0x63, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x88,
-	0x01, 0x01, 0x12, 0x4a, 0x0a, 0x13, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x61, 0x66, 0x74,
-	0x65, 0x72, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x15, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54, 0x61, 0x72, 0x69,
-	0x66, 0x66, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x05, 0x52, 0x11, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x41, 0x66, 0x74, 0x65, 0x72, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1b,
-	0x0a, 0x09, 0x66, 0x61, 0x72, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x66, 0x61, 0x72, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d,
-	0x69, 0x6c, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x6d, 0x69, 0x6c, 0x65,
-	0x73, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x73, 0x65, 0x61, 0x74, 0x5f, 0x61, 0x74, 0x5f, 0x70, 0x75,
-	0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x42, 0x1c, 0x0a, 0x1a, 0x5f,
-	0x73, 0x65, 0x61, 0x74, 0x5f, 0x61, 0x74, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x72, 0x65,
-	0x74, 0x75, 0x72, 0x6e, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x66, 0x6c, 0x69, 0x67,
-	0x68, 0x74, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x61, 0x66,
-	0x74, 0x65, 0x72, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x63,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x66, 0x6c, 0x69,
-	0x67, 0x68, 0x74, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x61,
-	0x66, 0x74, 0x65, 0x72, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x22, 0x63, 0x0a, 0x10, 0x46,
-	0x6c, 0x69, 0x67, 0x68, 0x74, 0x44, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x12,
-	0x18, 0x0a, 0x07, 0x63, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x63, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x69, 0x72,
-	0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61,
-	0x69, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x22, 0x58, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x0d, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x43, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
-	0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x8b, 0x03, 0x0a, 0x11, 0x50,
+	0x61, 0x72, 0x69, 0x66, 0x66, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x05, 0x52, 0x11, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x61, 0x72, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x61, 0x72, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x6d, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x6d,
+	0x69, 0x6c, 0x65, 0x73, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x73, 0x65, 0x61, 0x74, 0x5f, 0x61, 0x74,
+	0x5f, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x42, 0x1c,
+	0x0a, 0x1a, 0x5f, 0x73, 0x65, 0x61, 0x74, 0x5f, 0x61, 0x74, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x42, 0x17, 0x0a, 0x15,
+	0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x66,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x42, 0x17, 0x0a,
+	0x15, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f,
+	0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x22, 0x63,
+	0x0a, 0x10, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x44, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a,
+	0x61, 0x69, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x61, 0x69, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x22, 0x84, 0x01, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x38,
+	0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76,
+	0x33, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2a,
+	0x0a, 0x11, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x69, 0x73, 0x6f, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x49, 0x73, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x8b, 0x03, 0x0a, 0x11, 0x50,
 	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x44, 0x65, 0x62, 0x75, 0x67, 0x49, 0x6e, 0x66, 0x6f,
 	0x12, 0x34, 0x0a, 0x0c, 0x61, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f,
@@ -8561,7 +8525,7 @@ var file_protobuf_search_v3_results_proto_rawDesc = []byte{
 	0x4d, 0x65, 0x74, 0x72, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
 	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
 	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd0, 0x03, 0x0a, 0x0b, 0x41, 0x69, 0x72, 0x70, 0x6f,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xdc, 0x03, 0x0a, 0x0b, 0x41, 0x69, 0x72, 0x70, 0x6f,
 	0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x34, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
 	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33,
 	0x2e, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x4e, 0x61, 0x6d,
@@ -8574,1087 +8538,1087 @@ var file_protobuf_search_v3_results_proto_rawDesc = []byte{
 	0x61, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x35, 0x0a, 0x0b, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e,
 	0x61, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x61,
 	0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x47, 0x65, 0x6f, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52,
-	0x0b, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x10,
+	0x0b, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x10,
 	0x68, 0x61, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x5f, 0x7a, 0x6f, 0x6e, 0x65,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f,
-	0x76, 0x33, 0x2e, 0x4f, 0x70, 0x74, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x0e, 0x68, 0x61, 0x73, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x33, 0x0a, 0x16, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x69, 0x74, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73,
-	0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x69, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x4d, 0x69, 0x6e, 0x12,
-	0x33, 0x0a, 0x16, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x5f,
-	0x68, 0x6f, 0x75, 0x72, 0x73, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x13, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x48, 0x6f, 0x75, 0x72,
-	0x73, 0x4d, 0x61, 0x78, 0x1a, 0x53, 0x0a, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0e, 0x68, 0x61, 0x73, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x69, 0x74, 0x5a, 0x6f, 0x6e, 0x65, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x16, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x68, 0x6f, 0x75, 0x72,
+	0x73, 0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x69, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x4d, 0x69, 0x6e,
+	0x12, 0x33, 0x0a, 0x16, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x5f, 0x77, 0x6f, 0x72, 0x6b,
+	0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x13, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x48, 0x6f, 0x75,
+	0x72, 0x73, 0x4d, 0x61, 0x78, 0x1a, 0x53, 0x0a, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e,
+	0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x68,
+	0x61, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x4a,
+	0x04, 0x08, 0x06, 0x10, 0x07, 0x22, 0x2e, 0x0a, 0x08, 0x47, 0x65, 0x6f, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03,
+	0x6c, 0x61, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x03, 0x6c, 0x6e, 0x67, 0x22, 0xf8, 0x01, 0x0a, 0x08, 0x43, 0x69, 0x74, 0x79, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x31, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33,
+	0x2e, 0x43, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x1a, 0x53, 0x0a, 0x09, 0x4e,
+	0x61, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0xcf, 0x01, 0x0a, 0x0b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x34, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x76, 0x69, 0x73, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x56, 0x69, 0x73, 0x61, 0x1a, 0x53, 0x0a,
+	0x09, 0x4e, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x5b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x72, 0x6f, 0x41, 0x72, 0x65, 0x61, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x69, 0x72, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x61, 0x69, 0x72, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x22,
+	0x9e, 0x03, 0x0a, 0x09, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x67, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x2e,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x6f,
+	0x62, 0x69, 0x6c, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0d, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x68, 0x69, 0x64, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x68, 0x69, 0x64, 0x65, 0x50,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x69,
+	0x73, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x73, 0x73, 0x69,
+	0x73, 0x74, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x62, 0x69, 0x6c,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x69, 0x72, 0x6c, 0x69, 0x6e, 0x65,
+	0x5f, 0x69, 0x61, 0x74, 0x61, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x69,
+	0x72, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x61, 0x74, 0x61, 0x73, 0x1a, 0x54, 0x0a, 0x0a, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x2e, 0x0a, 0x08, 0x41, 0x6c, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0x61, 0x0a, 0x09, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x12, 0x2c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x18, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x45, 0x71, 0x75, 0x69,
+	0x70, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0xe7, 0x02, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x12, 0x35, 0x0a, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x5f, 0x76, 0x33, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x52,
+	0x0a, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x12, 0x33, 0x0a, 0x0a, 0x74,
+	0x72, 0x69, 0x70, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x14, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54, 0x72, 0x69, 0x70,
+	0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x09, 0x74, 0x72, 0x69, 0x70, 0x43, 0x6c, 0x61, 0x73, 0x73,
+	0x12, 0x36, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76,
+	0x33, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x0a, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x4a, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x65,
+	0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x3e, 0x0a,
+	0x10, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72,
 	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x4d,
-	0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x2e, 0x0a, 0x08, 0x47, 0x65, 0x6f,
-	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x03, 0x6c, 0x61, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6e, 0x67, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6c, 0x6e, 0x67, 0x22, 0x3e, 0x0a, 0x07, 0x4f, 0x70, 0x74,
-	0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73,
-	0x5f, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
-	0x69, 0x73, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x22, 0xf8, 0x01, 0x0a, 0x08, 0x43, 0x69,
-	0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x31, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x5f, 0x76, 0x33, 0x2e, 0x43, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x4e, 0x61,
-	0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a,
-	0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a,
-	0x6f, 0x6e, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18,
-	0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x1a,
-	0x53, 0x0a, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x22, 0xcf, 0x01, 0x0a, 0x0b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x34, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f,
-	0x76, 0x33, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x4e,
-	0x61, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21,
-	0x0a, 0x0c, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x76, 0x69, 0x73, 0x61, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75, 0x6e, 0x69, 0x66, 0x69, 0x65, 0x64, 0x56, 0x69, 0x73,
-	0x61, 0x1a, 0x53, 0x0a, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x4d, 0x61, 0x70, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x72, 0x6f, 0x41,
-	0x72, 0x65, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61,
-	0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x61,
-	0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a,
-	0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a,
-	0x6f, 0x6e, 0x65, 0x22, 0x9e, 0x03, 0x0a, 0x09, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x67, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x35,
-	0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
-	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x49,
-	0x6e, 0x66, 0x6f, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05,
-	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e,
-	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x12, 0x25,
-	0x0a, 0x0e, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x68, 0x69, 0x64, 0x65, 0x5f, 0x70, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x68,
-	0x69, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x12, 0x1a, 0x0a, 0x08,
-	0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
-	0x61, 0x73, 0x73, 0x69, 0x73, 0x74, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x6f, 0x62, 0x69,
-	0x6c, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d,
-	0x6f, 0x62, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x69, 0x72,
-	0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x61, 0x74, 0x61, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x0c, 0x61, 0x69, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x61, 0x74, 0x61, 0x73, 0x1a, 0x54,
-	0x0a, 0x0a, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x22, 0x2e, 0x0a, 0x08, 0x41, 0x6c, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x61, 0x0a, 0x09, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e,
-	0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xe7, 0x02, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x35, 0x0a, 0x0a, 0x70, 0x61, 0x73, 0x73,
-	0x65, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67,
-	0x65, 0x72, 0x73, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x12,
-	0x33, 0x0a, 0x0a, 0x74, 0x72, 0x69, 0x70, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e,
-	0x54, 0x72, 0x69, 0x70, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x09, 0x74, 0x72, 0x69, 0x70, 0x43,
-	0x6c, 0x61, 0x73, 0x73, 0x12, 0x36, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6b,
-	0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4b, 0x69, 0x6e, 0x64,
-	0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x4a, 0x0a, 0x0b,
-	0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x28, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x53, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x45, 0x78, 0x70, 0x65, 0x72,
-	0x69, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x65, 0x78, 0x70,
-	0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x0e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x1a, 0x3e, 0x0a, 0x10, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x22, 0x5a, 0x0a, 0x0a, 0x50, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x12,
-	0x16, 0x0a, 0x06, 0x61, 0x64, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x06, 0x61, 0x64, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64,
-	0x72, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64,
-	0x72, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x66, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x69, 0x6e, 0x66, 0x61, 0x6e, 0x74, 0x73, 0x22, 0x9f, 0x1d,
-	0x0a, 0x12, 0x44, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61,
-	0x72, 0x69, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x06, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33,
-	0x2e, 0x44, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72,
-	0x69, 0x65, 0x73, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x06, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x47, 0x0a, 0x08, 0x61, 0x69, 0x72, 0x6c, 0x69,
-	0x6e, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x44, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x42, 0x6f,
-	0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x41, 0x69, 0x72, 0x6c, 0x69, 0x6e, 0x65,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x61, 0x69, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x73,
-	0x12, 0x4a, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e,
-	0x44, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69,
-	0x65, 0x73, 0x2e, 0x41, 0x6c, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x0e,
-	0x68, 0x61, 0x73, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33,
-	0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6c, 0x48, 0x00, 0x52, 0x0d, 0x68,
-	0x61, 0x73, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12,
-	0x3d, 0x0a, 0x0c, 0x68, 0x61, 0x73, 0x5f, 0x6c, 0x6f, 0x77, 0x63, 0x6f, 0x73, 0x74, 0x73, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76,
-	0x33, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6c, 0x48, 0x01, 0x52, 0x0b,
-	0x68, 0x61, 0x73, 0x4c, 0x6f, 0x77, 0x63, 0x6f, 0x73, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x47,
-	0x0a, 0x08, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x2b, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x44, 0x65, 0x67,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5a, 0x0a,
+	0x0a, 0x50, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x64, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x61, 0x64, 0x75,
+	0x6c, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x69, 0x6e, 0x66, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x07, 0x69, 0x6e, 0x66, 0x61, 0x6e, 0x74, 0x73, 0x22, 0x9f, 0x1d, 0x0a, 0x12, 0x44, 0x65,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x41, 0x0a, 0x06, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x29, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x44, 0x65, 0x67,
 	0x72, 0x61, 0x64, 0x65, 0x64, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2e,
-	0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x61,
-	0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x83, 0x01, 0x0a, 0x1e, 0x73, 0x61, 0x6d, 0x65,
-	0x5f, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x61, 0x72, 0x72, 0x69, 0x76,
-	0x61, 0x6c, 0x5f, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x3e, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x44, 0x65, 0x67,
+	0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x47, 0x0a, 0x08, 0x61, 0x69, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76,
+	0x33, 0x2e, 0x44, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61,
+	0x72, 0x69, 0x65, 0x73, 0x2e, 0x41, 0x69, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x08, 0x61, 0x69, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x4a, 0x0a, 0x09,
+	0x61, 0x6c, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2c, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x44, 0x65, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x64, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x41,
+	0x6c, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x61,
+	0x6c, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x0e, 0x68, 0x61, 0x73, 0x5f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6c, 0x48, 0x00, 0x52, 0x0d, 0x68, 0x61, 0x73, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x0c, 0x68,
+	0x61, 0x73, 0x5f, 0x6c, 0x6f, 0x77, 0x63, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6c, 0x48, 0x01, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x4c,
+	0x6f, 0x77, 0x63, 0x6f, 0x73, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x08, 0x61, 0x69,
+	0x72, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x44, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x64, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x41, 0x69, 0x72, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x61, 0x69, 0x72, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x12, 0x83, 0x01, 0x0a, 0x1e, 0x73, 0x61, 0x6d, 0x65, 0x5f, 0x64, 0x65, 0x70,
+	0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x5f, 0x61,
+	0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x44, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x64, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x53, 0x61, 0x6d, 0x65,
+	0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c,
+	0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x1b, 0x73, 0x61,
+	0x6d, 0x65, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x41, 0x72, 0x72, 0x69, 0x76,
+	0x61, 0x6c, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x41, 0x0a, 0x07, 0x62, 0x61, 0x67,
+	0x67, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x61, 0x67,
+	0x67, 0x61, 0x67, 0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x48, 0x02,
+	0x52, 0x07, 0x62, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x4d, 0x0a, 0x0a,
+	0x65, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2d, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x44, 0x65, 0x67,
 	0x72, 0x61, 0x64, 0x65, 0x64, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2e,
-	0x53, 0x61, 0x6d, 0x65, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x41, 0x72, 0x72,
-	0x69, 0x76, 0x61, 0x6c, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x1b, 0x73, 0x61, 0x6d, 0x65, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x41,
-	0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x41, 0x0a,
-	0x07, 0x62, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
-	0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x42, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69,
-	0x65, 0x73, 0x48, 0x02, 0x52, 0x07, 0x62, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01,
-	0x12, 0x4d, 0x0a, 0x0a, 0x65, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x09,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33,
+	0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0a, 0x65, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x5a, 0x0a, 0x0f, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x0a,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33,
 	0x2e, 0x44, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72,
-	0x69, 0x65, 0x73, 0x2e, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x0a, 0x65, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
-	0x5a, 0x0a, 0x0f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f,
-	0x64, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x5f, 0x76, 0x33, 0x2e, 0x44, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x42, 0x6f, 0x75,
-	0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d,
-	0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x70, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x05, 0x70,
-	0x72, 0x69, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x6f, 0x75, 0x6e,
-	0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x6d, 0x0a,
-	0x16, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x61, 0x72, 0x72, 0x69, 0x76,
-	0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e,
-	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x44, 0x65, 0x67, 0x72, 0x61, 0x64,
-	0x65, 0x64, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x70,
-	0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x54, 0x69, 0x6d,
-	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x14, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72,
-	0x65, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x53, 0x0a, 0x0d,
-	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x0d, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e,
-	0x44, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x54, 0x69,
-	0x63, 0x6b, 0x65, 0x74, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x48, 0x03,
-	0x52, 0x0c, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x88, 0x01,
-	0x01, 0x12, 0x53, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x74, 0x69, 0x63, 0x6b,
-	0x65, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x5f, 0x76, 0x33, 0x2e, 0x44, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x52, 0x65, 0x74,
-	0x75, 0x72, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72,
-	0x69, 0x65, 0x73, 0x48, 0x04, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x69, 0x63,
-	0x6b, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12, 0x5a, 0x0a, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x65, 0x72, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x31, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x44, 0x65, 0x67, 0x72,
-	0x61, 0x64, 0x65, 0x64, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x54, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x5f,
-	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
-	0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x66, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6f, 0x75, 0x6e, 0x64,
-	0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73,
-	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x63, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x66, 0x65, 0x72, 0x73, 0x5f, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x11,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33,
-	0x2e, 0x44, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72,
-	0x69, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x41, 0x69, 0x72,
-	0x70, 0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x66, 0x65, 0x72, 0x73, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x66, 0x0a,
-	0x13, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x72, 0x69, 0x65, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x73, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x44, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x42,
-	0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x64, 0x0a, 0x21, 0x68, 0x61, 0x73, 0x5f, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x61, 0x69, 0x72, 0x70,
-	0x6f, 0x72, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b,
+	0x69, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f,
+	0x76, 0x33, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69,
+	0x65, 0x73, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x6d, 0x0a, 0x16, 0x64, 0x65, 0x70,
+	0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x44, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x42, 0x6f,
+	0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75,
+	0x72, 0x65, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x14, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x41, 0x72, 0x72,
+	0x69, 0x76, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x53, 0x0a, 0x0d, 0x72, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x29, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x44, 0x65, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x64, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x48, 0x03, 0x52, 0x0c, 0x72, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12, 0x53, 0x0a,
+	0x0d, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33,
+	0x2e, 0x44, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x54,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x48,
+	0x04, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x5a, 0x0a, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x44, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64,
+	0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x54,
+	0x0a, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x5f, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65,
+	0x73, 0x52, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x63, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x73, 0x5f, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x34, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x44, 0x65, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x64, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x73, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x66, 0x0a, 0x13, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f,
+	0x76, 0x33, 0x2e, 0x44, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x42, 0x6f, 0x75, 0x6e, 0x64,
+	0x61, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x12, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x64, 0x0a, 0x21, 0x68, 0x61, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x5f,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42,
+	0x6f, 0x6f, 0x6c, 0x48, 0x05, 0x52, 0x1d, 0x68, 0x61, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x66, 0x0a, 0x22, 0x68, 0x61, 0x73, 0x5f, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x62, 0x61,
+	0x67, 0x67, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6c, 0x48, 0x06, 0x52, 0x1e, 0x68, 0x61,
+	0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x42, 0x61,
+	0x67, 0x67, 0x61, 0x67, 0x65, 0x52, 0x65, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x88, 0x01, 0x01, 0x12,
+	0x51, 0x0a, 0x17, 0x68, 0x61, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73,
+	0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x76, 0x69, 0x73, 0x61, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x15, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x46, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6c, 0x48, 0x05, 0x52, 0x1d, 0x68, 0x61, 0x73, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x41, 0x69, 0x72, 0x70, 0x6f,
-	0x72, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x66, 0x0a, 0x22, 0x68,
-	0x61, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x5f, 0x77, 0x69, 0x74,
-	0x68, 0x5f, 0x62, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x68, 0x65, 0x63,
-	0x6b, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x5f, 0x76, 0x33, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6c, 0x48, 0x06,
-	0x52, 0x1e, 0x68, 0x61, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x57, 0x69,
-	0x74, 0x68, 0x42, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x52, 0x65, 0x63, 0x68, 0x65, 0x63, 0x6b,
-	0x88, 0x01, 0x01, 0x12, 0x51, 0x0a, 0x17, 0x68, 0x61, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x66, 0x65, 0x72, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x76, 0x69, 0x73, 0x61, 0x18, 0x15,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33,
-	0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6c, 0x48, 0x07, 0x52, 0x14, 0x68,
-	0x61, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x56,
-	0x69, 0x73, 0x61, 0x88, 0x01, 0x01, 0x12, 0x6a, 0x0a, 0x24, 0x68, 0x61, 0x73, 0x5f, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x76, 0x69, 0x72,
-	0x74, 0x75, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x16,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33,
-	0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6c, 0x48, 0x08, 0x52, 0x20, 0x68,
-	0x61, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x56,
-	0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x88,
-	0x01, 0x01, 0x12, 0x50, 0x0a, 0x16, 0x68, 0x61, 0x73, 0x5f, 0x63, 0x6f, 0x76, 0x69, 0x64, 0x5f,
-	0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x17, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x46,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6c, 0x48, 0x09, 0x52, 0x14, 0x68, 0x61, 0x73,
-	0x43, 0x6f, 0x76, 0x69, 0x64, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x88, 0x01, 0x01, 0x12, 0x4a, 0x0a, 0x13, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x69, 0x67, 0x68,
-	0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x18, 0x20, 0x01, 0x28,
+	0x74, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6c, 0x48, 0x07, 0x52, 0x14, 0x68, 0x61, 0x73, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x56, 0x69, 0x73, 0x61, 0x88,
+	0x01, 0x01, 0x12, 0x6a, 0x0a, 0x24, 0x68, 0x61, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c,
+	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6c, 0x48, 0x08, 0x52, 0x20, 0x68, 0x61, 0x73, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x56, 0x69, 0x72, 0x74, 0x75,
+	0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x88, 0x01, 0x01, 0x12, 0x50,
+	0x0a, 0x16, 0x68, 0x61, 0x73, 0x5f, 0x63, 0x6f, 0x76, 0x69, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x74,
+	0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x42, 0x6f, 0x6f, 0x6c, 0x48, 0x09, 0x52, 0x14, 0x68, 0x61, 0x73, 0x43, 0x6f, 0x76, 0x69,
+	0x64, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x88, 0x01, 0x01,
+	0x12, 0x4a, 0x0a, 0x13, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x42, 0x6f, 0x6f, 0x6c, 0x48, 0x0a, 0x52, 0x11, 0x68, 0x61, 0x73, 0x4e, 0x69, 0x67, 0x68, 0x74,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x88, 0x01, 0x01, 0x12, 0x54, 0x0a, 0x18,
+	0x68, 0x61, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x6e, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x42, 0x6f, 0x6f, 0x6c, 0x48, 0x0b, 0x52, 0x16, 0x68, 0x61, 0x73, 0x43, 0x6f, 0x6e, 0x76,
+	0x65, 0x6e, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x59, 0x0a, 0x1b, 0x68, 0x61, 0x73, 0x5f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f,
+	0x6c, 0x61, 0x79, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x73, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x5f, 0x76, 0x33, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6c, 0x48, 0x0c,
+	0x52, 0x18, 0x68, 0x61, 0x73, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x4c, 0x61, 0x79, 0x6f, 0x76, 0x65,
+	0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x88, 0x01, 0x01, 0x12, 0x57, 0x0a,
+	0x1a, 0x68, 0x61, 0x73, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x5f, 0x6c, 0x61, 0x79, 0x6f, 0x76, 0x65,
+	0x72, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x1b, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x46, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6c, 0x48, 0x0a, 0x52, 0x11, 0x68, 0x61, 0x73, 0x4e,
-	0x69, 0x67, 0x68, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x88, 0x01, 0x01,
-	0x12, 0x54, 0x0a, 0x18, 0x68, 0x61, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x6e, 0x69, 0x65,
-	0x6e, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x19, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x46,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6c, 0x48, 0x0b, 0x52, 0x16, 0x68, 0x61, 0x73,
-	0x43, 0x6f, 0x6e, 0x76, 0x65, 0x6e, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x65, 0x72, 0x73, 0x88, 0x01, 0x01, 0x12, 0x59, 0x0a, 0x1b, 0x68, 0x61, 0x73, 0x5f, 0x73, 0x68,
-	0x6f, 0x72, 0x74, 0x5f, 0x6c, 0x61, 0x79, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x6f,
-	0x6f, 0x6c, 0x48, 0x0c, 0x52, 0x18, 0x68, 0x61, 0x73, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x4c, 0x61,
-	0x79, 0x6f, 0x76, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x88, 0x01,
-	0x01, 0x12, 0x57, 0x0a, 0x1a, 0x68, 0x61, 0x73, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x5f, 0x6c, 0x61,
-	0x79, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18,
-	0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76,
-	0x33, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6c, 0x48, 0x0d, 0x52, 0x17,
-	0x68, 0x61, 0x73, 0x4c, 0x6f, 0x6e, 0x67, 0x4c, 0x61, 0x79, 0x6f, 0x76, 0x65, 0x72, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x88, 0x01, 0x01, 0x1a, 0x51, 0x0a, 0x0b, 0x41, 0x67,
-	0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x50, 0x72, 0x69,
-	0x63, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x53, 0x0a,
-	0x0d, 0x41, 0x69, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x6c, 0x74, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6c, 0x48, 0x0d, 0x52, 0x17, 0x68, 0x61, 0x73, 0x4c,
+	0x6f, 0x6e, 0x67, 0x4c, 0x61, 0x79, 0x6f, 0x76, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x73, 0x88, 0x01, 0x01, 0x1a, 0x51, 0x0a, 0x0b, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f,
+	0x76, 0x33, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x53, 0x0a, 0x0d, 0x41, 0x69, 0x72,
+	0x6c, 0x69, 0x6e, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x54,
+	0x0a, 0x0e, 0x41, 0x6c, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x62, 0x0a, 0x0d, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f,
+	0x76, 0x33, 0x2e, 0x44, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x41, 0x69, 0x72, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x66, 0x0a, 0x20, 0x53, 0x61, 0x6d, 0x65,
+	0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c,
+	0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x55, 0x0a, 0x0f, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33,
+	0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x59, 0x0a, 0x13, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
 	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
 	0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x16, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x46, 0x69, 0x6c, 0x74,
 	0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x1a, 0x54, 0x0a, 0x0e, 0x41, 0x6c, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76,
-	0x33, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x62, 0x0a, 0x0d, 0x41, 0x69, 0x72, 0x70,
-	0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3b, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x44, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x41,
-	0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65,
-	0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x66, 0x0a, 0x20,
-	0x53, 0x61, 0x6d, 0x65, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x41, 0x72, 0x72,
-	0x69, 0x76, 0x61, 0x6c, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x46, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x55, 0x0a, 0x0f, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x5f, 0x76, 0x33, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x59, 0x0a, 0x13, 0x50,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x45, 0x6e, 0x74,
+	0x38, 0x01, 0x1a, 0x6a, 0x0a, 0x19, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x41,
+	0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x37, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x44, 0x65, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72,
+	0x69, 0x65, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x59,
+	0x0a, 0x13, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f,
+	0x76, 0x33, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5c, 0x0a, 0x16, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33,
+	0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5d, 0x0a, 0x17, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74,
 	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e,
 	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x6a, 0x0a, 0x19, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74,
-	0x75, 0x72, 0x65, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x37, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33,
-	0x2e, 0x44, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x6f, 0x75,
-	0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x1a, 0x59, 0x0a, 0x13, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x50, 0x72, 0x69,
-	0x63, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5c, 0x0a,
-	0x16, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72,
-	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x5f, 0x76, 0x33, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5d, 0x0a, 0x17, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x5f, 0x76, 0x33, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x68,
-	0x61, 0x73, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x42, 0x0f, 0x0a,
-	0x0d, 0x5f, 0x68, 0x61, 0x73, 0x5f, 0x6c, 0x6f, 0x77, 0x63, 0x6f, 0x73, 0x74, 0x73, 0x42, 0x0a,
-	0x0a, 0x08, 0x5f, 0x62, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x72,
-	0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x10, 0x0a, 0x0e,
-	0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x24,
-	0x0a, 0x22, 0x5f, 0x68, 0x61, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73,
-	0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x63, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x42, 0x25, 0x0a, 0x23, 0x5f, 0x68, 0x61, 0x73, 0x5f, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x62, 0x61, 0x67, 0x67,
-	0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x42, 0x1a, 0x0a, 0x18, 0x5f,
-	0x68, 0x61, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x5f, 0x77, 0x69,
-	0x74, 0x68, 0x5f, 0x76, 0x69, 0x73, 0x61, 0x42, 0x27, 0x0a, 0x25, 0x5f, 0x68, 0x61, 0x73, 0x5f,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x68, 0x61, 0x73, 0x5f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x68, 0x61,
+	0x73, 0x5f, 0x6c, 0x6f, 0x77, 0x63, 0x6f, 0x73, 0x74, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x62,
+	0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x24, 0x0a, 0x22, 0x5f, 0x68,
+	0x61, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x5f, 0x77, 0x69, 0x74,
+	0x68, 0x5f, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x42, 0x25, 0x0a, 0x23, 0x5f, 0x68, 0x61, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x62, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x5f,
+	0x72, 0x65, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x42, 0x1a, 0x0a, 0x18, 0x5f, 0x68, 0x61, 0x73, 0x5f,
 	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x76,
-	0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x65,
-	0x42, 0x19, 0x0a, 0x17, 0x5f, 0x68, 0x61, 0x73, 0x5f, 0x63, 0x6f, 0x76, 0x69, 0x64, 0x5f, 0x72,
-	0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x16, 0x0a, 0x14, 0x5f,
-	0x68, 0x61, 0x73, 0x5f, 0x6e, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x65, 0x72, 0x73, 0x42, 0x1b, 0x0a, 0x19, 0x5f, 0x68, 0x61, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x76,
-	0x65, 0x6e, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73,
-	0x42, 0x1e, 0x0a, 0x1c, 0x5f, 0x68, 0x61, 0x73, 0x5f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x6c,
-	0x61, 0x79, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73,
-	0x42, 0x1d, 0x0a, 0x1b, 0x5f, 0x68, 0x61, 0x73, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x5f, 0x6c, 0x61,
-	0x79, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x22,
-	0x63, 0x0a, 0x0b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x28,
+	0x69, 0x73, 0x61, 0x42, 0x27, 0x0a, 0x25, 0x5f, 0x68, 0x61, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x76, 0x69, 0x72, 0x74, 0x75,
+	0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x42, 0x19, 0x0a, 0x17,
+	0x5f, 0x68, 0x61, 0x73, 0x5f, 0x63, 0x6f, 0x76, 0x69, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x72,
+	0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x68, 0x61, 0x73, 0x5f,
+	0x6e, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x42,
+	0x1b, 0x0a, 0x19, 0x5f, 0x68, 0x61, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x6e, 0x69, 0x65,
+	0x6e, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x42, 0x1e, 0x0a, 0x1c,
+	0x5f, 0x68, 0x61, 0x73, 0x5f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x6c, 0x61, 0x79, 0x6f, 0x76,
+	0x65, 0x72, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x42, 0x1d, 0x0a, 0x1b,
+	0x5f, 0x68, 0x61, 0x73, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x5f, 0x6c, 0x61, 0x79, 0x6f, 0x76, 0x65,
+	0x72, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x22, 0x63, 0x0a, 0x0b, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x69, 0x6e, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f,
+	0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x22, 0x62, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x28,
 	0x0a, 0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x69,
 	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
 	0x4d, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x64, 0x69, 0x73, 0x61,
 	0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x01, 0x52, 0x0f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x69, 0x6e, 0x50,
-	0x72, 0x69, 0x63, 0x65, 0x22, 0x62, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x6f,
-	0x6f, 0x6c, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x69, 0x6e,
-	0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x65, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x4d, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x2a, 0x0a, 0x11,
-	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x69, 0x63,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
-	0x4d, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0xe8, 0x02, 0x0a, 0x1a, 0x44, 0x65, 0x67,
-	0x72, 0x61, 0x64, 0x65, 0x64, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x42, 0x6f, 0x75,
-	0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x4c, 0x0a, 0x07, 0x61, 0x72, 0x72, 0x69, 0x76,
-	0x61, 0x6c, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x5f, 0x76, 0x33, 0x2e, 0x44, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x41, 0x69, 0x72,
-	0x70, 0x6f, 0x72, 0x74, 0x73, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2e,
-	0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x61, 0x72,
-	0x72, 0x69, 0x76, 0x61, 0x6c, 0x12, 0x52, 0x0a, 0x09, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75,
-	0x72, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x5f, 0x76, 0x33, 0x2e, 0x44, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x41, 0x69, 0x72,
-	0x70, 0x6f, 0x72, 0x74, 0x73, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2e,
-	0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09,
-	0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x1a, 0x52, 0x0a, 0x0c, 0x41, 0x72, 0x72,
-	0x69, 0x76, 0x61, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x61,
+	0x72, 0x69, 0x63, 0x65, 0x22, 0xe8, 0x02, 0x0a, 0x1a, 0x44, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x64, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x4c, 0x0a, 0x07, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33,
+	0x2e, 0x44, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x41, 0x72, 0x72, 0x69,
+	0x76, 0x61, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61,
+	0x6c, 0x12, 0x52, 0x0a, 0x09, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33,
+	0x2e, 0x44, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x70, 0x61,
+	0x72, 0x74, 0x75, 0x72, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x64, 0x65, 0x70, 0x61,
+	0x72, 0x74, 0x75, 0x72, 0x65, 0x1a, 0x52, 0x0a, 0x0c, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f,
+	0x76, 0x33, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x54, 0x0a, 0x0e, 0x44, 0x65, 0x70,
+	0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x89, 0x02, 0x0a, 0x17, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x61, 0x67, 0x67, 0x61, 0x67,
+	0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x0c, 0x66,
+	0x75, 0x6c, 0x6c, 0x5f, 0x62, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x66, 0x75, 0x6c,
+	0x6c, 0x42, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3a, 0x0a, 0x0a, 0x6e,
+	0x6f, 0x5f, 0x62, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48, 0x01, 0x52, 0x09, 0x6e, 0x6f, 0x42, 0x61, 0x67,
+	0x67, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0d, 0x6c, 0x61, 0x72, 0x67, 0x65,
+	0x5f, 0x68, 0x61, 0x6e, 0x64, 0x62, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48, 0x02, 0x52, 0x0c, 0x6c, 0x61, 0x72, 0x67, 0x65, 0x48,
+	0x61, 0x6e, 0x64, 0x62, 0x61, 0x67, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x66, 0x75,
+	0x6c, 0x6c, 0x5f, 0x62, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6e,
+	0x6f, 0x5f, 0x62, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6c, 0x61,
+	0x72, 0x67, 0x65, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x62, 0x61, 0x67, 0x22, 0x7a, 0x0a, 0x11, 0x42,
+	0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x62, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x66, 0x75, 0x6c, 0x6c, 0x42, 0x61, 0x67, 0x67,
+	0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x6f, 0x5f, 0x62, 0x61, 0x67, 0x67, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6e, 0x6f, 0x42, 0x61, 0x67, 0x67, 0x61,
+	0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x61, 0x72, 0x67, 0x65, 0x5f, 0x68, 0x61, 0x6e, 0x64,
+	0x62, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x6c, 0x61, 0x72, 0x67, 0x65,
+	0x48, 0x61, 0x6e, 0x64, 0x62, 0x61, 0x67, 0x22, 0x35, 0x0a, 0x0f, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03,
+	0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x22, 0xdf,
+	0x03, 0x0a, 0x16, 0x44, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x42,
+	0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x55, 0x0a, 0x0c, 0x61, 0x72, 0x72,
+	0x69, 0x76, 0x61, 0x6c, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x32, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x44, 0x65, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69,
+	0x65, 0x73, 0x2e, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x65, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0b, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x65,
+	0x12, 0x4a, 0x0a, 0x0c, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f,
+	0x76, 0x33, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x48, 0x00, 0x52, 0x0b, 0x61, 0x72,
+	0x72, 0x69, 0x76, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x4e, 0x0a, 0x0e,
+	0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33,
+	0x2e, 0x44, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x6f,
+	0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x48, 0x01, 0x52, 0x0d, 0x64, 0x65, 0x70, 0x61,
+	0x72, 0x74, 0x75, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x44, 0x0a, 0x0d,
+	0x74, 0x72, 0x69, 0x70, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x48,
+	0x02, 0x52, 0x0c, 0x74, 0x72, 0x69, 0x70, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88,
+	0x01, 0x01, 0x1a, 0x56, 0x0a, 0x10, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x44, 0x61, 0x74,
+	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x5f, 0x76, 0x33, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x61,
+	0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f,
+	0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x10,
+	0x0a, 0x0e, 0x5f, 0x74, 0x72, 0x69, 0x70, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0xe7, 0x01, 0x0a, 0x17, 0x44, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x10, 0x0a, 0x03,
+	0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10,
+	0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x61, 0x78,
+	0x12, 0x49, 0x0a, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2f, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x44, 0x61,
+	0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64,
+	0x61, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0b, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x57, 0x69, 0x64, 0x74, 0x68, 0x1a, 0x3a,
+	0x0a, 0x0c, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd7, 0x01, 0x0a, 0x0f, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x10,
+	0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6d, 0x69, 0x6e,
+	0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6d,
+	0x61, 0x78, 0x12, 0x41, 0x0a, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2e,
+	0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x62, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f,
+	0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x62, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x57, 0x69, 0x64, 0x74, 0x68, 0x1a, 0x3a, 0x0a, 0x0c, 0x42, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0xa3, 0x01, 0x0a, 0x1e, 0x44, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x64, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x6f, 0x75,
+	0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x61,
 	0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x50, 0x72, 0x69,
-	0x63, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x54, 0x0a,
-	0x0e, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x63, 0x65, 0x48, 0x00, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x2f, 0x0a, 0x04, 0x66, 0x72, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x16, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x46, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0x89, 0x02, 0x0a, 0x17, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x61,
-	0x67, 0x67, 0x61, 0x67, 0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12,
-	0x3e, 0x0a, 0x0c, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x62, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76,
-	0x33, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48, 0x00, 0x52,
-	0x0b, 0x66, 0x75, 0x6c, 0x6c, 0x42, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12,
-	0x3a, 0x0a, 0x0a, 0x6e, 0x6f, 0x5f, 0x62, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48, 0x01, 0x52, 0x09, 0x6e,
-	0x6f, 0x42, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0d, 0x6c,
-	0x61, 0x72, 0x67, 0x65, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x62, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x46,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48, 0x02, 0x52, 0x0c, 0x6c, 0x61,
-	0x72, 0x67, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x62, 0x61, 0x67, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a,
-	0x0d, 0x5f, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x62, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x42, 0x0d,
-	0x0a, 0x0b, 0x5f, 0x6e, 0x6f, 0x5f, 0x62, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x42, 0x10, 0x0a,
-	0x0e, 0x5f, 0x6c, 0x61, 0x72, 0x67, 0x65, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x62, 0x61, 0x67, 0x22,
-	0x7a, 0x0a, 0x11, 0x42, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61,
-	0x72, 0x69, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x62, 0x61, 0x67,
-	0x67, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x66, 0x75, 0x6c, 0x6c,
-	0x42, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x6f, 0x5f, 0x62, 0x61,
-	0x67, 0x67, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6e, 0x6f, 0x42,
-	0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x61, 0x72, 0x67, 0x65, 0x5f,
-	0x68, 0x61, 0x6e, 0x64, 0x62, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x6c,
-	0x61, 0x72, 0x67, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x62, 0x61, 0x67, 0x22, 0x35, 0x0a, 0x0f, 0x50,
-	0x72, 0x69, 0x63, 0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x10,
-	0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x69, 0x6e,
-	0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d,
-	0x61, 0x78, 0x22, 0xdf, 0x03, 0x0a, 0x16, 0x44, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x54,
-	0x69, 0x6d, 0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x55, 0x0a,
-	0x0c, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e,
-	0x44, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x6f, 0x75, 0x6e,
-	0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x44, 0x61,
-	0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c,
-	0x44, 0x61, 0x74, 0x65, 0x12, 0x4a, 0x0a, 0x0c, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x52,
-	0x61, 0x6e, 0x67, 0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x48, 0x00,
-	0x52, 0x0b, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01,
-	0x12, 0x4e, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x5f, 0x76, 0x33, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e,
-	0x67, 0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x48, 0x01, 0x52, 0x0d,
-	0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01,
-	0x12, 0x44, 0x0a, 0x0d, 0x74, 0x72, 0x69, 0x70, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x5f, 0x76, 0x33, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72,
-	0x69, 0x65, 0x73, 0x48, 0x02, 0x52, 0x0c, 0x74, 0x72, 0x69, 0x70, 0x44, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x1a, 0x56, 0x0a, 0x10, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61,
-	0x6c, 0x44, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x50, 0x72,
-	0x69, 0x63, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0f,
-	0x0a, 0x0d, 0x5f, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x42,
-	0x11, 0x0a, 0x0f, 0x5f, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x72, 0x69, 0x70, 0x5f, 0x64, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xe7, 0x01, 0x0a, 0x17, 0x44, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
-	0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73,
-	0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d,
-	0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6d, 0x61, 0x78, 0x12, 0x49, 0x0a, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76,
-	0x33, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x42,
-	0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12,
-	0x21, 0x0a, 0x0c, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x57, 0x69, 0x64,
-	0x74, 0x68, 0x1a, 0x3a, 0x0a, 0x0c, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd7,
-	0x01, 0x0a, 0x0f, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69,
-	0x65, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x41, 0x0a, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x5f, 0x76, 0x33, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72,
-	0x69, 0x65, 0x73, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x75, 0x63,
-	0x6b, 0x65, 0x74, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x0b, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x57, 0x69, 0x64, 0x74, 0x68, 0x1a, 0x3a, 0x0a, 0x0c,
-	0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa3, 0x01, 0x0a, 0x1e, 0x44, 0x65, 0x67,
-	0x72, 0x61, 0x64, 0x65, 0x64, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65,
-	0x74, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x09, 0x61,
-	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
-	0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48, 0x00, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
-	0x62, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x04, 0x66, 0x72, 0x65, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33,
-	0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48, 0x01, 0x52, 0x04,
-	0x66, 0x72, 0x65, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x61, 0x76, 0x61, 0x69,
-	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x66, 0x72, 0x65, 0x65, 0x22, 0x40,
-	0x0a, 0x1a, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x10, 0x0a, 0x03,
-	0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10,
-	0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6d, 0x61, 0x78,
-	0x22, 0x98, 0x15, 0x0a, 0x0a, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12,
-	0x39, 0x0a, 0x06, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x21, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x42, 0x6f, 0x75, 0x6e,
-	0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x06, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x3f, 0x0a, 0x08, 0x61, 0x69,
-	0x72, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72,
-	0x69, 0x65, 0x73, 0x2e, 0x41, 0x69, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x08, 0x61, 0x69, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x09, 0x61,
-	0x6c, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
+	0x74, 0x65, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48, 0x01, 0x52, 0x04, 0x66, 0x72, 0x65, 0x65,
+	0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x66, 0x72, 0x65, 0x65, 0x22, 0x40, 0x0a, 0x1a, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6f,
+	0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61,
+	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x22, 0x98, 0x15, 0x0a,
+	0x0a, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69,
+	0x65, 0x73, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x3f, 0x0a, 0x08, 0x61, 0x69, 0x72, 0x6c, 0x69, 0x6e,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x5f, 0x76, 0x33, 0x2e, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2e,
+	0x41, 0x69, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x61,
+	0x69, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x69, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65,
+	0x73, 0x2e, 0x41, 0x6c, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x09, 0x61, 0x6c, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x68,
+	0x61, 0x73, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0d, 0x68, 0x61, 0x73, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6c, 0x69, 0x6e,
+	0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x61, 0x73, 0x5f, 0x6c, 0x6f, 0x77, 0x63, 0x6f, 0x73,
+	0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x4c, 0x6f, 0x77,
+	0x63, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x3f, 0x0a, 0x08, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x5f, 0x76, 0x33, 0x2e, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x41,
+	0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x61, 0x69,
+	0x72, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x7b, 0x0a, 0x1e, 0x73, 0x61, 0x6d, 0x65, 0x5f, 0x64,
+	0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c,
+	0x5f, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36,
 	0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x42, 0x6f, 0x75, 0x6e, 0x64,
-	0x61, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x41, 0x6c, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12,
-	0x25, 0x0a, 0x0e, 0x68, 0x61, 0x73, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x65,
-	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x68, 0x61, 0x73, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x61, 0x73, 0x5f, 0x6c, 0x6f,
-	0x77, 0x63, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x61,
-	0x73, 0x4c, 0x6f, 0x77, 0x63, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x3f, 0x0a, 0x08, 0x61, 0x69, 0x72,
-	0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x65,
+	0x61, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x53, 0x61, 0x6d, 0x65, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74,
+	0x75, 0x72, 0x65, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72,
+	0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x1b, 0x73, 0x61, 0x6d, 0x65, 0x44, 0x65, 0x70, 0x61,
+	0x72, 0x74, 0x75, 0x72, 0x65, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x41, 0x69, 0x72, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x36, 0x0a, 0x07, 0x62, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33,
+	0x2e, 0x42, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69,
+	0x65, 0x73, 0x52, 0x07, 0x62, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x65,
+	0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x42, 0x6f, 0x75, 0x6e,
+	0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x65, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x52, 0x0a, 0x0f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x65,
 	0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69,
-	0x65, 0x73, 0x2e, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x08, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x7b, 0x0a, 0x1e, 0x73, 0x61,
-	0x6d, 0x65, 0x5f, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x61, 0x72, 0x72,
-	0x69, 0x76, 0x61, 0x6c, 0x5f, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x07, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x36, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x42,
-	0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x53, 0x61, 0x6d, 0x65, 0x44, 0x65,
-	0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x41, 0x69,
-	0x72, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x1b, 0x73, 0x61, 0x6d, 0x65,
+	0x65, 0x73, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76,
+	0x33, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65,
+	0x73, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x65, 0x0a, 0x16, 0x64, 0x65, 0x70, 0x61,
+	0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x5f, 0x76, 0x33, 0x2e, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2e,
 	0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c,
-	0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x36, 0x0a, 0x07, 0x62, 0x61, 0x67, 0x67, 0x61,
-	0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x5f, 0x76, 0x33, 0x2e, 0x42, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x42, 0x6f, 0x75, 0x6e,
-	0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x07, 0x62, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x12,
-	0x45, 0x0a, 0x0a, 0x65, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e,
-	0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x45, 0x71, 0x75, 0x69, 0x70,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x65, 0x71, 0x75, 0x69,
-	0x70, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x52, 0x0a, 0x0f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x29, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x42, 0x6f, 0x75, 0x6e,
-	0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65,
-	0x74, 0x68, 0x6f, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x70, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x05, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64,
-	0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x65, 0x0a, 0x16,
-	0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61,
-	0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x73,
+	0x54, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x14, 0x64, 0x65, 0x70, 0x61, 0x72,
+	0x74, 0x75, 0x72, 0x65, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x40, 0x0a, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f,
+	0x76, 0x33, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72,
+	0x69, 0x65, 0x73, 0x52, 0x0c, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x12, 0x40, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x5f, 0x76, 0x33, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64,
+	0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x12, 0x52, 0x0a, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73,
 	0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72,
-	0x69, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x41, 0x72, 0x72,
-	0x69, 0x76, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x14, 0x64,
-	0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x54,
-	0x69, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x74, 0x69,
-	0x63, 0x6b, 0x65, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x42, 0x6f, 0x75,
-	0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x0c, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x54,
-	0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x40, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f,
-	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42,
-	0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x52, 0x0a, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x66, 0x65, 0x72, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x29, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x42, 0x6f, 0x75,
-	0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
-	0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x59, 0x0a, 0x12, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x5f, 0x76, 0x33, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x48, 0x00,
-	0x52, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x44, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x5b, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x65, 0x72, 0x73, 0x5f, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x11, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x42,
-	0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x65, 0x72, 0x73, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x41, 0x69, 0x72, 0x70, 0x6f,
-	0x72, 0x74, 0x73, 0x12, 0x5e, 0x0a, 0x13, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73,
-	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x2d, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x42, 0x6f, 0x75,
-	0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
-	0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72,
-	0x69, 0x65, 0x73, 0x12, 0x48, 0x0a, 0x21, 0x68, 0x61, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x66, 0x65, 0x72, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72,
-	0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1d,
-	0x68, 0x61, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68,
-	0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x4a, 0x0a,
-	0x22, 0x68, 0x61, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x5f, 0x77,
-	0x69, 0x74, 0x68, 0x5f, 0x62, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x68,
-	0x65, 0x63, 0x6b, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1e, 0x68, 0x61, 0x73, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x42, 0x61, 0x67, 0x67, 0x61,
-	0x67, 0x65, 0x52, 0x65, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x35, 0x0a, 0x17, 0x68, 0x61, 0x73,
+	0x69, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x59, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x73, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x48, 0x00, 0x52, 0x11, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88,
+	0x01, 0x01, 0x12, 0x5b, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x5f,
+	0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c,
+	0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x42, 0x6f, 0x75, 0x6e, 0x64,
+	0x61, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x41,
+	0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12,
+	0x5e, 0x0a, 0x13, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72,
+	0x69, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x12, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x48, 0x0a, 0x21, 0x68, 0x61, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73,
+	0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1d, 0x68, 0x61, 0x73, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x41, 0x69, 0x72, 0x70,
+	0x6f, 0x72, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x4a, 0x0a, 0x22, 0x68, 0x61, 0x73,
 	0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f,
-	0x76, 0x69, 0x73, 0x61, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x68, 0x61, 0x73, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x56, 0x69, 0x73, 0x61,
-	0x12, 0x4e, 0x0a, 0x24, 0x68, 0x61, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
-	0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x52, 0x20,
-	0x68, 0x61, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68,
-	0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x65,
-	0x12, 0x34, 0x0a, 0x16, 0x68, 0x61, 0x73, 0x5f, 0x63, 0x6f, 0x76, 0x69, 0x64, 0x5f, 0x72, 0x65,
-	0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x17, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x14, 0x68, 0x61, 0x73, 0x43, 0x6f, 0x76, 0x69, 0x64, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x69,
-	0x67, 0x68, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x18, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x11, 0x68, 0x61, 0x73, 0x4e, 0x69, 0x67, 0x68, 0x74, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x68, 0x61, 0x73, 0x5f, 0x63, 0x6f,
-	0x6e, 0x76, 0x65, 0x6e, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
-	0x72, 0x73, 0x18, 0x19, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x68, 0x61, 0x73, 0x43, 0x6f, 0x6e,
-	0x76, 0x65, 0x6e, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73,
-	0x12, 0x3d, 0x0a, 0x1b, 0x68, 0x61, 0x73, 0x5f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x6c, 0x61,
-	0x79, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18,
-	0x1a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x68, 0x61, 0x73, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x4c,
-	0x61, 0x79, 0x6f, 0x76, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12,
-	0x3b, 0x0a, 0x1a, 0x68, 0x61, 0x73, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x5f, 0x6c, 0x61, 0x79, 0x6f,
-	0x76, 0x65, 0x72, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x1b, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x17, 0x68, 0x61, 0x73, 0x4c, 0x6f, 0x6e, 0x67, 0x4c, 0x61, 0x79, 0x6f,
-	0x76, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x1a, 0x39, 0x0a, 0x0b,
-	0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3b, 0x0a, 0x0d, 0x41, 0x69, 0x72, 0x6c, 0x69,
-	0x6e, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3c, 0x0a, 0x0e, 0x41, 0x6c, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x1a, 0x5a, 0x0a, 0x0d, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x33, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33,
-	0x2e, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72,
-	0x69, 0x65, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x4e,
-	0x0a, 0x20, 0x53, 0x61, 0x6d, 0x65, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x41,
-	0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3d,
-	0x0a, 0x0f, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a,
-	0x13, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x45,
+	0x62, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1e, 0x68, 0x61, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x42, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x35, 0x0a, 0x17, 0x68, 0x61, 0x73, 0x5f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x76, 0x69, 0x73, 0x61,
+	0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x68, 0x61, 0x73, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x56, 0x69, 0x73, 0x61, 0x12, 0x4e, 0x0a, 0x24,
+	0x68, 0x61, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x5f, 0x77, 0x69,
+	0x74, 0x68, 0x5f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6c, 0x69, 0x6e, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x52, 0x20, 0x68, 0x61, 0x73, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x56, 0x69, 0x72, 0x74,
+	0x75, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x34, 0x0a, 0x16,
+	0x68, 0x61, 0x73, 0x5f, 0x63, 0x6f, 0x76, 0x69, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x17, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x68, 0x61,
+	0x73, 0x43, 0x6f, 0x76, 0x69, 0x64, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x68, 0x61, 0x73, 0x5f, 0x6e, 0x69, 0x67, 0x68, 0x74, 0x5f,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x18, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x11, 0x68, 0x61, 0x73, 0x4e, 0x69, 0x67, 0x68, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x68, 0x61, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x6e,
+	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x19,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x68, 0x61, 0x73, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x6e, 0x69,
+	0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12, 0x3d, 0x0a, 0x1b,
+	0x68, 0x61, 0x73, 0x5f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x6c, 0x61, 0x79, 0x6f, 0x76, 0x65,
+	0x72, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x1a, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x18, 0x68, 0x61, 0x73, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x4c, 0x61, 0x79, 0x6f, 0x76,
+	0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12, 0x3b, 0x0a, 0x1a, 0x68,
+	0x61, 0x73, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x5f, 0x6c, 0x61, 0x79, 0x6f, 0x76, 0x65, 0x72, 0x5f,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x17, 0x68, 0x61, 0x73, 0x4c, 0x6f, 0x6e, 0x67, 0x4c, 0x61, 0x79, 0x6f, 0x76, 0x65, 0x72, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x41, 0x67, 0x65, 0x6e,
+	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x3b, 0x0a, 0x0d, 0x41, 0x69, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x45,
 	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
 	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x1a, 0x62, 0x0a, 0x19, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x41, 0x72, 0x72,
-	0x69, 0x76, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x42,
-	0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a, 0x13, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
-	0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x44, 0x0a, 0x16, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x66, 0x65, 0x72, 0x73, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x45, 0x0a,
-	0x17, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72,
-	0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
-	0x72, 0x73, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x44, 0x0a, 0x10, 0x52,
-	0x65, 0x74, 0x75, 0x72, 0x6e, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12,
-	0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x66, 0x72, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x66, 0x72, 0x65,
-	0x65, 0x22, 0x44, 0x0a, 0x10, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64,
-	0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
-	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
-	0x62, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x04, 0x66, 0x72, 0x65, 0x65, 0x22, 0xa0, 0x02, 0x0a, 0x12, 0x41, 0x69, 0x72, 0x70,
-	0x6f, 0x72, 0x74, 0x73, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x44,
-	0x0a, 0x07, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x2a, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x41, 0x69, 0x72, 0x70,
-	0x6f, 0x72, 0x74, 0x73, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x41,
-	0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x61, 0x72, 0x72,
-	0x69, 0x76, 0x61, 0x6c, 0x12, 0x4a, 0x0a, 0x09, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72,
-	0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x5f, 0x76, 0x33, 0x2e, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x42, 0x6f, 0x75, 0x6e,
-	0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65,
-	0x1a, 0x3a, 0x0a, 0x0c, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3c, 0x0a, 0x0e,
-	0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x1a, 0x3c, 0x0a, 0x0e, 0x41, 0x6c, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5a,
+	0x0a, 0x0d, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x33, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x41, 0x69, 0x72,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x4e, 0x0a, 0x20, 0x53, 0x61,
+	0x6d, 0x65, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x41, 0x72, 0x72, 0x69, 0x76,
+	0x61, 0x6c, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
 	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
 	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xf2, 0x02, 0x0a, 0x0e, 0x54,
-	0x69, 0x6d, 0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x4d, 0x0a,
-	0x0c, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x41,
-	0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x0b, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x65, 0x12, 0x45, 0x0a, 0x0c,
-	0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x44,
-	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x6f, 0x75, 0x6e,
-	0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x0b, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x54,
-	0x69, 0x6d, 0x65, 0x12, 0x49, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x65,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3d, 0x0a, 0x0f, 0x45, 0x71,
+	0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a, 0x13, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x62, 0x0a, 0x19,
+	0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c,
+	0x54, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64,
+	0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x41, 0x0a, 0x13, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x44, 0x0a, 0x16, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73,
+	0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x45, 0x0a, 0x17, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x42, 0x15, 0x0a, 0x13, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x5f, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x44, 0x0a, 0x10, 0x52, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09,
+	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x65,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x66, 0x72, 0x65, 0x65, 0x22, 0x44, 0x0a,
+	0x10, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x66, 0x72, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x66,
+	0x72, 0x65, 0x65, 0x22, 0xa0, 0x02, 0x0a, 0x12, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x07, 0x61, 0x72,
+	0x72, 0x69, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x41, 0x72, 0x72, 0x69, 0x76,
+	0x61, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c,
+	0x12, 0x4a, 0x0a, 0x09, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e,
+	0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69,
+	0x65, 0x73, 0x2e, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x09, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x1a, 0x3a, 0x0a, 0x0c,
+	0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3c, 0x0a, 0x0e, 0x44, 0x65, 0x70, 0x61,
+	0x72, 0x74, 0x75, 0x72, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xf2, 0x02, 0x0a, 0x0e, 0x54, 0x69, 0x6d, 0x65, 0x42,
+	0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x4d, 0x0a, 0x0c, 0x61, 0x72, 0x72,
+	0x69, 0x76, 0x61, 0x6c, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2a, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x41, 0x72, 0x72, 0x69, 0x76,
+	0x61, 0x6c, 0x44, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x61, 0x72, 0x72,
+	0x69, 0x76, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x65, 0x12, 0x45, 0x0a, 0x0c, 0x61, 0x72, 0x72, 0x69,
+	0x76, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69,
+	0x65, 0x73, 0x52, 0x0b, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x49, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x5f, 0x76, 0x33, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x0d, 0x64, 0x65, 0x70,
+	0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x0d, 0x74, 0x72,
+	0x69, 0x70, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x0c, 0x74,
+	0x72, 0x69, 0x70, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x3e, 0x0a, 0x10, 0x41,
+	0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa5, 0x01, 0x0a, 0x0b,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x34, 0x0a, 0x16, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x65, 0x64, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x2e, 0x0a, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x30, 0x0a, 0x14, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x12, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x8b, 0x0c, 0x0a, 0x0b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x03, 0x52, 0x06, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61,
+	0x69, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x61,
+	0x69, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x69, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x69,
+	0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74,
+	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x11, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6c,
+	0x69, 0x6e, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x5f,
+	0x6c, 0x6f, 0x77, 0x63, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
+	0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x4c, 0x6f, 0x77, 0x63, 0x6f, 0x73, 0x74, 0x73, 0x12,
+	0x40, 0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x4c, 0x0a, 0x23, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x73, 0x61, 0x6d, 0x65, 0x5f, 0x64,
+	0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c,
+	0x5f, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x1f,
+	0x77, 0x69, 0x74, 0x68, 0x53, 0x61, 0x6d, 0x65, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72,
+	0x65, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x65, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x27, 0x0a, 0x0f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x70, 0x69, 0x6e, 0x5f,
+	0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x70, 0x69, 0x6e, 0x46, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x03,
+	0x28, 0x03, 0x52, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x5f,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x20, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73,
+	0x5f, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x5f, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74,
+	0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1d, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x41,
+	0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x49, 0x0a, 0x21,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75,
+	0x74, 0x5f, 0x62, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x42, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x34, 0x0a, 0x16, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x5f, 0x76, 0x69, 0x73,
+	0x61, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x56, 0x69, 0x73, 0x61, 0x12, 0x4d, 0x0a,
+	0x23, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x6f,
+	0x75, 0x74, 0x5f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6c, 0x69, 0x6e, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x20, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x56, 0x69, 0x72, 0x74,
+	0x75, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x31, 0x0a, 0x14,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x6e, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x6e, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12,
+	0x36, 0x0a, 0x17, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x5f, 0x6e, 0x69, 0x67, 0x68, 0x74,
+	0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x15, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x4e, 0x69, 0x67, 0x68, 0x74, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x77, 0x69, 0x74, 0x68, 0x6f,
+	0x75, 0x74, 0x5f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x6c, 0x61, 0x79, 0x6f, 0x76, 0x65, 0x72,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x53,
+	0x68, 0x6f, 0x72, 0x74, 0x4c, 0x61, 0x79, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x14, 0x77,
+	0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x5f, 0x6c, 0x61, 0x79, 0x6f,
+	0x76, 0x65, 0x72, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x77, 0x69, 0x74, 0x68, 0x6f,
+	0x75, 0x74, 0x4c, 0x6f, 0x6e, 0x67, 0x4c, 0x61, 0x79, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x2d, 0x0a,
+	0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x5f, 0x61, 0x69, 0x72, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x18, 0x16, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x73, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x2f, 0x0a, 0x13,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x17, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x3c, 0x0a,
+	0x1a, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x5f, 0x63, 0x6f, 0x76, 0x69, 0x64, 0x5f, 0x72,
+	0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x18, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x18, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x43, 0x6f, 0x76, 0x69, 0x64, 0x52,
+	0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x62,
+	0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x18, 0x19, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61,
+	0x67, 0x67, 0x61, 0x67, 0x65, 0x12, 0x3e, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x62, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f,
+	0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x1b, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x42, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x1c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x1a, 0x55, 0x0a, 0x0d, 0x53, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x22, 0xcd, 0x01, 0x0a, 0x0b, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x12, 0x39, 0x0a, 0x19, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x54, 0x69, 0x6d,
+	0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x3d, 0x0a, 0x1b,
+	0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x18, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x44, 0x0a, 0x1f, 0x74,
+	0x72, 0x69, 0x70, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x1b, 0x74, 0x72, 0x69, 0x70, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x57, 0x69, 0x64, 0x74,
+	0x68, 0x22, 0xc7, 0x02, 0x0a, 0x0d, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f,
+	0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x61,
+	0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x12, 0x2d,
+	0x0a, 0x12, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x64, 0x65, 0x70, 0x61, 0x72,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x61, 0x69, 0x72, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x12, 0x41, 0x0a,
+	0x0c, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e,
+	0x44, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x0b, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x5f, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x3f, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65,
 	0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
-	0x52, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52,
-	0x0d, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3f,
-	0x0a, 0x0d, 0x74, 0x72, 0x69, 0x70, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76,
-	0x33, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65,
-	0x73, 0x52, 0x0c, 0x74, 0x72, 0x69, 0x70, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a,
-	0x3e, 0x0a, 0x10, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x65, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
-	0xa5, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x12,
-	0x34, 0x0a, 0x16, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x63, 0x6b,
-	0x65, 0x74, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x14, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74,
-	0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f,
-	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x12, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65,
-	0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x8b, 0x0c, 0x0a, 0x0b, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x67, 0x65, 0x6e, 0x74,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x06, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x12,
-	0x1a, 0x0a, 0x08, 0x61, 0x69, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x08, 0x61, 0x69, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61,
-	0x6c, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x09,
-	0x61, 0x6c, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x77, 0x69, 0x74,
-	0x68, 0x6f, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x77, 0x69, 0x74, 0x68,
-	0x6f, 0x75, 0x74, 0x5f, 0x6c, 0x6f, 0x77, 0x63, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0f, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x4c, 0x6f, 0x77, 0x63, 0x6f,
-	0x73, 0x74, 0x73, 0x12, 0x40, 0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
-	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76,
-	0x33, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x65,
-	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x73, 0x65, 0x67,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x4c, 0x0a, 0x23, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x73, 0x61,
-	0x6d, 0x65, 0x5f, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x61, 0x72, 0x72,
-	0x69, 0x76, 0x61, 0x6c, 0x5f, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x07, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x1f, 0x77, 0x69, 0x74, 0x68, 0x53, 0x61, 0x6d, 0x65, 0x44, 0x65, 0x70, 0x61,
-	0x72, 0x74, 0x75, 0x72, 0x65, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x41, 0x69, 0x72, 0x70,
-	0x6f, 0x72, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d,
-	0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x15,
-	0x70, 0x69, 0x6e, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x70, 0x69, 0x6e,
-	0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73,
-	0x12, 0x2b, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x15, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x46, 0x6c, 0x6f, 0x61,
-	0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x27, 0x0a,
-	0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x0c, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
-	0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x65, 0x72, 0x73, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x52,
-	0x61, 0x6e, 0x67, 0x65, 0x52, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x44,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x20, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x66, 0x65, 0x72, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x5f, 0x61, 0x69, 0x72,
-	0x70, 0x6f, 0x72, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x1d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68,
-	0x6f, 0x75, 0x74, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x12, 0x49, 0x0a, 0x21, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x5f, 0x77, 0x69,
-	0x74, 0x68, 0x6f, 0x75, 0x74, 0x5f, 0x62, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65,
-	0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1e, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x42, 0x61, 0x67,
-	0x67, 0x61, 0x67, 0x65, 0x52, 0x65, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x34, 0x0a, 0x16, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74,
-	0x5f, 0x76, 0x69, 0x73, 0x61, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x56, 0x69, 0x73,
-	0x61, 0x12, 0x4d, 0x0a, 0x23, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x5f, 0x77,
-	0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x5f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x20,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74,
-	0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6c, 0x69, 0x6e, 0x65,
-	0x12, 0x31, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x6e, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13,
-	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x6e, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x65, 0x72, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x5f, 0x6e,
-	0x69, 0x67, 0x68, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x13,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x4e, 0x69, 0x67,
-	0x68, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x77,
-	0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x6c, 0x61, 0x79,
-	0x6f, 0x76, 0x65, 0x72, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x77, 0x69, 0x74, 0x68,
-	0x6f, 0x75, 0x74, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x4c, 0x61, 0x79, 0x6f, 0x76, 0x65, 0x72, 0x12,
-	0x30, 0x0a, 0x14, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x5f,
-	0x6c, 0x61, 0x79, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x77,
-	0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x4c, 0x6f, 0x6e, 0x67, 0x4c, 0x61, 0x79, 0x6f, 0x76, 0x65,
-	0x72, 0x12, 0x2d, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x5f, 0x61,
-	0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x16, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x41, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73,
-	0x12, 0x2f, 0x0a, 0x13, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x5f, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x17, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x69, 0x65,
-	0x73, 0x12, 0x3c, 0x0a, 0x1a, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x5f, 0x63, 0x6f, 0x76,
-	0x69, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x18, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x43, 0x6f,
-	0x76, 0x69, 0x64, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x18, 0x0a, 0x07, 0x62, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x18, 0x19, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x07, 0x62, 0x61, 0x67, 0x67, 0x61, 0x67, 0x65, 0x12, 0x3e, 0x0a, 0x0c, 0x74, 0x69, 0x6d,
-	0x65, 0x5f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x42,
-	0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x65, 0x74,
-	0x75, 0x72, 0x6e, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68,
-	0x74, 0x18, 0x1b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x42,
-	0x65, 0x66, 0x6f, 0x72, 0x65, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x63,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x66, 0x6c, 0x69,
-	0x67, 0x68, 0x74, 0x18, 0x1c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x63, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x1a, 0x55, 0x0a,
-	0x0d, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x2e, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x53, 0x65, 0x67, 0x6d,
-	0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x62, 0x75,
-	0x63, 0x6b, 0x65, 0x74, 0x73, 0x22, 0xcd, 0x01, 0x0a, 0x0b, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x75,
-	0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x19, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x77, 0x69, 0x64,
-	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61,
-	0x6c, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x57, 0x69, 0x64, 0x74, 0x68,
-	0x12, 0x3d, 0x0a, 0x1b, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x5f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x18, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65,
-	0x54, 0x69, 0x6d, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12,
-	0x44, 0x0a, 0x1f, 0x74, 0x72, 0x69, 0x70, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x77, 0x69, 0x64,
-	0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1b, 0x74, 0x72, 0x69, 0x70, 0x44, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74,
-	0x57, 0x69, 0x64, 0x74, 0x68, 0x22, 0xc7, 0x02, 0x0a, 0x0d, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
-	0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x69, 0x72, 0x70, 0x6f,
-	0x72, 0x74, 0x73, 0x5f, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x0f, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x41, 0x72, 0x72, 0x69, 0x76,
-	0x61, 0x6c, 0x12, 0x2d, 0x0a, 0x12, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x64,
-	0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11,
-	0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72,
-	0x65, 0x12, 0x41, 0x0a, 0x0c, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x5f, 0x76, 0x33, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x72, 0x54, 0x69,
-	0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0b, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c,
-	0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x5f,
-	0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x72, 0x72, 0x69,
-	0x76, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3f, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x61, 0x72,
-	0x74, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x44, 0x61, 0x74, 0x65,
-	0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0d, 0x64, 0x65, 0x70, 0x61, 0x72,
-	0x74, 0x75, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x0d, 0x74, 0x72, 0x69, 0x70,
-	0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x10, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x52, 0x61, 0x6e, 0x67,
-	0x65, 0x52, 0x0c, 0x74, 0x72, 0x69, 0x70, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
-	0x39, 0x0a, 0x13, 0x44, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x72, 0x54, 0x69, 0x6d,
-	0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x22, 0x33, 0x0a, 0x0d, 0x44, 0x61,
-	0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d,
-	0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a,
-	0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x22,
-	0x2b, 0x0a, 0x05, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61,
-	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x22, 0x30, 0x0a, 0x0a,
-	0x46, 0x6c, 0x6f, 0x61, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03,
-	0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x2a, 0x66,
-	0x0a, 0x0a, 0x54, 0x65, 0x72, 0x6d, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x08, 0x0a, 0x04,
-	0x47, 0x41, 0x54, 0x45, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x4f, 0x55, 0x54, 0x45, 0x5f,
-	0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x46,
-	0x41, 0x43, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x49,
-	0x4e, 0x47, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x49, 0x52, 0x4c, 0x49, 0x4e, 0x45, 0x5f,
-	0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x46,
-	0x41, 0x55, 0x4c, 0x54, 0x10, 0x04, 0x2a, 0x45, 0x0a, 0x0d, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d,
-	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
-	0x57, 0x4e, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x4c, 0x41, 0x4e, 0x45, 0x10, 0x01, 0x12,
-	0x09, 0x0a, 0x05, 0x54, 0x52, 0x41, 0x49, 0x4e, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x55,
-	0x53, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x4f, 0x41, 0x54, 0x10, 0x04, 0x2a, 0x66, 0x0a,
-	0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x09, 0x0a, 0x05, 0x4f,
-	0x54, 0x48, 0x45, 0x52, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x57, 0x45, 0x42, 0x10, 0x01, 0x12,
-	0x0a, 0x0a, 0x06, 0x4d, 0x4f, 0x42, 0x49, 0x4c, 0x45, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x4d,
-	0x4f, 0x42, 0x49, 0x4c, 0x45, 0x5f, 0x57, 0x45, 0x42, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x57,
-	0x48, 0x49, 0x54, 0x45, 0x5f, 0x4c, 0x41, 0x42, 0x45, 0x4c, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06,
-	0x54, 0x50, 0x5f, 0x41, 0x50, 0x49, 0x10, 0x05, 0x12, 0x0b, 0x0a, 0x07, 0x42, 0x32, 0x42, 0x5f,
-	0x57, 0x45, 0x42, 0x10, 0x06, 0x2a, 0x9d, 0x01, 0x0a, 0x05, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12,
-	0x08, 0x0a, 0x04, 0x42, 0x45, 0x53, 0x54, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x48, 0x45,
-	0x41, 0x50, 0x45, 0x53, 0x54, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x44, 0x45, 0x50, 0x41, 0x52,
-	0x54, 0x55, 0x52, 0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x41,
-	0x52, 0x52, 0x49, 0x56, 0x41, 0x4c, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x03, 0x12, 0x15, 0x0a,
-	0x11, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x5f, 0x44, 0x55, 0x52, 0x41, 0x54, 0x49,
-	0x4f, 0x4e, 0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x52, 0x49, 0x50, 0x5f, 0x44, 0x55, 0x52,
-	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x05, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x41, 0x54, 0x49, 0x4e,
-	0x47, 0x10, 0x06, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x4f, 0x50, 0x55, 0x4c, 0x41, 0x52, 0x49, 0x54,
-	0x59, 0x10, 0x07, 0x12, 0x10, 0x0a, 0x0c, 0x42, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x43, 0x4f, 0x52,
-	0x49, 0x4e, 0x47, 0x10, 0x08, 0x2a, 0x17, 0x0a, 0x05, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x06,
-	0x0a, 0x02, 0x41, 0x53, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x57, 0x41, 0x10, 0x01, 0x2a, 0xc2,
-	0x16, 0x0a, 0x08, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x07, 0x0a, 0x03, 0x41,
-	0x44, 0x50, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x45, 0x44, 0x10, 0x01, 0x12, 0x07, 0x0a,
-	0x03, 0x41, 0x46, 0x41, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x46, 0x4e, 0x10, 0x03, 0x12,
-	0x07, 0x0a, 0x03, 0x41, 0x4c, 0x4b, 0x10, 0x04, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4c, 0x4c, 0x10,
-	0x05, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4d, 0x44, 0x10, 0x06, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4e,
-	0x47, 0x10, 0x07, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4f, 0x41, 0x10, 0x08, 0x12, 0x07, 0x0a, 0x03,
-	0x41, 0x4f, 0x4b, 0x10, 0x09, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4f, 0x4e, 0x10, 0x0a, 0x12, 0x07,
-	0x0a, 0x03, 0x41, 0x4f, 0x52, 0x10, 0x0b, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x52, 0x41, 0x10, 0x0c,
-	0x12, 0x07, 0x0a, 0x03, 0x41, 0x52, 0x4c, 0x10, 0x0d, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x52, 0x4d,
-	0x10, 0x0e, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x52, 0x50, 0x10, 0x0f, 0x12, 0x07, 0x0a, 0x03, 0x41,
-	0x52, 0x53, 0x10, 0x10, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x54, 0x53, 0x10, 0x11, 0x12, 0x07, 0x0a,
-	0x03, 0x41, 0x55, 0x44, 0x10, 0x12, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x57, 0x47, 0x10, 0x13, 0x12,
-	0x07, 0x0a, 0x03, 0x41, 0x5a, 0x4d, 0x10, 0x14, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x5a, 0x4e, 0x10,
-	0x15, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x41, 0x44, 0x10, 0x16, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x41,
-	0x4d, 0x10, 0x17, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x41, 0x4e, 0x10, 0x18, 0x12, 0x07, 0x0a, 0x03,
-	0x42, 0x42, 0x44, 0x10, 0x19, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x44, 0x54, 0x10, 0x1a, 0x12, 0x07,
-	0x0a, 0x03, 0x42, 0x45, 0x43, 0x10, 0x1b, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x45, 0x46, 0x10, 0x1c,
-	0x12, 0x07, 0x0a, 0x03, 0x42, 0x45, 0x4c, 0x10, 0x1d, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x47, 0x4c,
-	0x10, 0x1e, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x47, 0x4d, 0x10, 0x1f, 0x12, 0x07, 0x0a, 0x03, 0x42,
-	0x47, 0x4e, 0x10, 0x20, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x47, 0x4f, 0x10, 0x21, 0x12, 0x07, 0x0a,
-	0x03, 0x42, 0x48, 0x44, 0x10, 0x22, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x49, 0x46, 0x10, 0x23, 0x12,
-	0x07, 0x0a, 0x03, 0x42, 0x4d, 0x44, 0x10, 0x24, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x4e, 0x44, 0x10,
-	0x25, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x4f, 0x42, 0x10, 0x26, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x4f,
-	0x4c, 0x10, 0x27, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x4f, 0x50, 0x10, 0x28, 0x12, 0x07, 0x0a, 0x03,
-	0x42, 0x4f, 0x56, 0x10, 0x29, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x52, 0x42, 0x10, 0x2a, 0x12, 0x07,
-	0x0a, 0x03, 0x42, 0x52, 0x43, 0x10, 0x2b, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x52, 0x45, 0x10, 0x2c,
-	0x12, 0x07, 0x0a, 0x03, 0x42, 0x52, 0x4c, 0x10, 0x2d, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x52, 0x4e,
-	0x10, 0x2e, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x52, 0x52, 0x10, 0x2f, 0x12, 0x07, 0x0a, 0x03, 0x42,
-	0x52, 0x5a, 0x10, 0x30, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x53, 0x44, 0x10, 0x31, 0x12, 0x07, 0x0a,
-	0x03, 0x42, 0x54, 0x4e, 0x10, 0x32, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x55, 0x4b, 0x10, 0x33, 0x12,
-	0x07, 0x0a, 0x03, 0x42, 0x57, 0x50, 0x10, 0x34, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x59, 0x42, 0x10,
-	0x35, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x59, 0x4e, 0x10, 0x36, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x59,
-	0x52, 0x10, 0x37, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x5a, 0x44, 0x10, 0x38, 0x12, 0x07, 0x0a, 0x03,
-	0x43, 0x41, 0x44, 0x10, 0x39, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x44, 0x46, 0x10, 0x3a, 0x12, 0x07,
-	0x0a, 0x03, 0x43, 0x48, 0x45, 0x10, 0x3b, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x48, 0x46, 0x10, 0x3c,
-	0x12, 0x07, 0x0a, 0x03, 0x43, 0x48, 0x57, 0x10, 0x3d, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x4c, 0x45,
-	0x10, 0x3e, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x4c, 0x46, 0x10, 0x3f, 0x12, 0x07, 0x0a, 0x03, 0x43,
-	0x4c, 0x50, 0x10, 0x40, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x4e, 0x48, 0x10, 0x41, 0x12, 0x07, 0x0a,
-	0x03, 0x43, 0x4e, 0x58, 0x10, 0x42, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x4e, 0x59, 0x10, 0x43, 0x12,
-	0x07, 0x0a, 0x03, 0x43, 0x4f, 0x50, 0x10, 0x44, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x4f, 0x55, 0x10,
-	0x45, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x52, 0x43, 0x10, 0x46, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x53,
-	0x44, 0x10, 0x47, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x53, 0x4b, 0x10, 0x48, 0x12, 0x07, 0x0a, 0x03,
-	0x43, 0x55, 0x43, 0x10, 0x49, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x55, 0x50, 0x10, 0x4a, 0x12, 0x07,
-	0x0a, 0x03, 0x43, 0x56, 0x45, 0x10, 0x4b, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x59, 0x50, 0x10, 0x4c,
-	0x12, 0x07, 0x0a, 0x03, 0x43, 0x5a, 0x4b, 0x10, 0x4d, 0x12, 0x07, 0x0a, 0x03, 0x44, 0x44, 0x4d,
-	0x10, 0x4e, 0x12, 0x07, 0x0a, 0x03, 0x44, 0x45, 0x4d, 0x10, 0x4f, 0x12, 0x07, 0x0a, 0x03, 0x44,
-	0x4a, 0x46, 0x10, 0x50, 0x12, 0x07, 0x0a, 0x03, 0x44, 0x4b, 0x4b, 0x10, 0x51, 0x12, 0x07, 0x0a,
-	0x03, 0x44, 0x4f, 0x50, 0x10, 0x52, 0x12, 0x07, 0x0a, 0x03, 0x44, 0x5a, 0x44, 0x10, 0x53, 0x12,
-	0x07, 0x0a, 0x03, 0x45, 0x43, 0x53, 0x10, 0x54, 0x12, 0x07, 0x0a, 0x03, 0x45, 0x43, 0x56, 0x10,
-	0x55, 0x12, 0x07, 0x0a, 0x03, 0x45, 0x45, 0x4b, 0x10, 0x56, 0x12, 0x07, 0x0a, 0x03, 0x45, 0x47,
-	0x50, 0x10, 0x57, 0x12, 0x07, 0x0a, 0x03, 0x45, 0x52, 0x4e, 0x10, 0x58, 0x12, 0x07, 0x0a, 0x03,
-	0x45, 0x53, 0x41, 0x10, 0x59, 0x12, 0x07, 0x0a, 0x03, 0x45, 0x53, 0x42, 0x10, 0x5a, 0x12, 0x07,
-	0x0a, 0x03, 0x45, 0x53, 0x50, 0x10, 0x5b, 0x12, 0x07, 0x0a, 0x03, 0x45, 0x54, 0x42, 0x10, 0x5c,
-	0x12, 0x07, 0x0a, 0x03, 0x45, 0x55, 0x52, 0x10, 0x5d, 0x12, 0x07, 0x0a, 0x03, 0x46, 0x49, 0x4d,
-	0x10, 0x5e, 0x12, 0x07, 0x0a, 0x03, 0x46, 0x4a, 0x44, 0x10, 0x5f, 0x12, 0x07, 0x0a, 0x03, 0x46,
-	0x4b, 0x50, 0x10, 0x60, 0x12, 0x07, 0x0a, 0x03, 0x46, 0x52, 0x46, 0x10, 0x61, 0x12, 0x07, 0x0a,
-	0x03, 0x47, 0x42, 0x50, 0x10, 0x62, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x45, 0x4b, 0x10, 0x63, 0x12,
-	0x07, 0x0a, 0x03, 0x47, 0x45, 0x4c, 0x10, 0x64, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x48, 0x43, 0x10,
-	0x65, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x48, 0x53, 0x10, 0x66, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x49,
-	0x50, 0x10, 0x67, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x4d, 0x44, 0x10, 0x68, 0x12, 0x07, 0x0a, 0x03,
-	0x47, 0x4e, 0x46, 0x10, 0x69, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x4e, 0x53, 0x10, 0x6a, 0x12, 0x07,
-	0x0a, 0x03, 0x47, 0x51, 0x45, 0x10, 0x6b, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x52, 0x44, 0x10, 0x6c,
-	0x12, 0x07, 0x0a, 0x03, 0x47, 0x54, 0x51, 0x10, 0x6d, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x57, 0x45,
-	0x10, 0x6e, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x57, 0x50, 0x10, 0x6f, 0x12, 0x07, 0x0a, 0x03, 0x47,
-	0x59, 0x44, 0x10, 0x70, 0x12, 0x07, 0x0a, 0x03, 0x48, 0x4b, 0x44, 0x10, 0x71, 0x12, 0x07, 0x0a,
-	0x03, 0x48, 0x4e, 0x4c, 0x10, 0x72, 0x12, 0x07, 0x0a, 0x03, 0x48, 0x52, 0x44, 0x10, 0x73, 0x12,
-	0x07, 0x0a, 0x03, 0x48, 0x52, 0x4b, 0x10, 0x74, 0x12, 0x07, 0x0a, 0x03, 0x48, 0x54, 0x47, 0x10,
-	0x75, 0x12, 0x07, 0x0a, 0x03, 0x48, 0x55, 0x46, 0x10, 0x76, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x44,
-	0x52, 0x10, 0x77, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x45, 0x50, 0x10, 0x78, 0x12, 0x07, 0x0a, 0x03,
-	0x49, 0x4c, 0x50, 0x10, 0x79, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x4c, 0x52, 0x10, 0x7a, 0x12, 0x07,
-	0x0a, 0x03, 0x49, 0x4c, 0x53, 0x10, 0x7b, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x4e, 0x52, 0x10, 0x7c,
-	0x12, 0x07, 0x0a, 0x03, 0x49, 0x51, 0x44, 0x10, 0x7d, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x52, 0x52,
-	0x10, 0x7e, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x53, 0x4a, 0x10, 0x7f, 0x12, 0x08, 0x0a, 0x03, 0x49,
-	0x53, 0x4b, 0x10, 0x80, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x49, 0x54, 0x4c, 0x10, 0x81, 0x01, 0x12,
-	0x08, 0x0a, 0x03, 0x4a, 0x4d, 0x44, 0x10, 0x82, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4a, 0x4f, 0x44,
-	0x10, 0x83, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4a, 0x50, 0x59, 0x10, 0x84, 0x01, 0x12, 0x08, 0x0a,
-	0x03, 0x4b, 0x45, 0x53, 0x10, 0x85, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4b, 0x47, 0x53, 0x10, 0x86,
-	0x01, 0x12, 0x08, 0x0a, 0x03, 0x4b, 0x48, 0x52, 0x10, 0x87, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4b,
-	0x4d, 0x46, 0x10, 0x88, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4b, 0x50, 0x57, 0x10, 0x89, 0x01, 0x12,
-	0x08, 0x0a, 0x03, 0x4b, 0x52, 0x48, 0x10, 0x8a, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4b, 0x52, 0x4f,
-	0x10, 0x8b, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4b, 0x52, 0x57, 0x10, 0x8c, 0x01, 0x12, 0x08, 0x0a,
-	0x03, 0x4b, 0x57, 0x44, 0x10, 0x8d, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4b, 0x59, 0x44, 0x10, 0x8e,
-	0x01, 0x12, 0x08, 0x0a, 0x03, 0x4b, 0x5a, 0x54, 0x10, 0x8f, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4c,
-	0x41, 0x4b, 0x10, 0x90, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4c, 0x42, 0x50, 0x10, 0x91, 0x01, 0x12,
-	0x08, 0x0a, 0x03, 0x4c, 0x4b, 0x52, 0x10, 0x92, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4c, 0x52, 0x44,
-	0x10, 0x93, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4c, 0x53, 0x4c, 0x10, 0x94, 0x01, 0x12, 0x08, 0x0a,
-	0x03, 0x4c, 0x54, 0x4c, 0x10, 0x95, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4c, 0x54, 0x54, 0x10, 0x96,
-	0x01, 0x12, 0x08, 0x0a, 0x03, 0x4c, 0x55, 0x43, 0x10, 0x97, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4c,
-	0x55, 0x46, 0x10, 0x98, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4c, 0x55, 0x4c, 0x10, 0x99, 0x01, 0x12,
-	0x08, 0x0a, 0x03, 0x4c, 0x56, 0x4c, 0x10, 0x9a, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4c, 0x56, 0x52,
-	0x10, 0x9b, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4c, 0x59, 0x44, 0x10, 0x9c, 0x01, 0x12, 0x08, 0x0a,
-	0x03, 0x4d, 0x41, 0x44, 0x10, 0x9d, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4d, 0x41, 0x46, 0x10, 0x9e,
-	0x01, 0x12, 0x08, 0x0a, 0x03, 0x4d, 0x43, 0x46, 0x10, 0x9f, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4d,
-	0x44, 0x43, 0x10, 0xa0, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4d, 0x44, 0x4c, 0x10, 0xa1, 0x01, 0x12,
-	0x08, 0x0a, 0x03, 0x4d, 0x47, 0x41, 0x10, 0xa2, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4d, 0x47, 0x46,
-	0x10, 0xa3, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4d, 0x4b, 0x44, 0x10, 0xa4, 0x01, 0x12, 0x08, 0x0a,
-	0x03, 0x4d, 0x4b, 0x4e, 0x10, 0xa5, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4d, 0x4c, 0x46, 0x10, 0xa6,
-	0x01, 0x12, 0x08, 0x0a, 0x03, 0x4d, 0x4d, 0x4b, 0x10, 0xa7, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4d,
-	0x4e, 0x54, 0x10, 0xa8, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4d, 0x4f, 0x50, 0x10, 0xa9, 0x01, 0x12,
-	0x08, 0x0a, 0x03, 0x4d, 0x52, 0x4f, 0x10, 0xaa, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4d, 0x54, 0x4c,
-	0x10, 0xab, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4d, 0x54, 0x50, 0x10, 0xac, 0x01, 0x12, 0x08, 0x0a,
-	0x03, 0x4d, 0x55, 0x52, 0x10, 0xad, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4d, 0x56, 0x50, 0x10, 0xae,
-	0x01, 0x12, 0x08, 0x0a, 0x03, 0x4d, 0x56, 0x52, 0x10, 0xaf, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4d,
-	0x57, 0x4b, 0x10, 0xb0, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4d, 0x58, 0x4e, 0x10, 0xb1, 0x01, 0x12,
-	0x08, 0x0a, 0x03, 0x4d, 0x58, 0x50, 0x10, 0xb2, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4d, 0x58, 0x56,
-	0x10, 0xb3, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4d, 0x59, 0x52, 0x10, 0xb4, 0x01, 0x12, 0x08, 0x0a,
-	0x03, 0x4d, 0x5a, 0x45, 0x10, 0xb5, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4d, 0x5a, 0x4d, 0x10, 0xb6,
-	0x01, 0x12, 0x08, 0x0a, 0x03, 0x4d, 0x5a, 0x4e, 0x10, 0xb7, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4e,
-	0x41, 0x44, 0x10, 0xb8, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4e, 0x47, 0x4e, 0x10, 0xb9, 0x01, 0x12,
-	0x08, 0x0a, 0x03, 0x4e, 0x49, 0x43, 0x10, 0xba, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4e, 0x49, 0x4f,
-	0x10, 0xbb, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4e, 0x4c, 0x47, 0x10, 0xbc, 0x01, 0x12, 0x08, 0x0a,
-	0x03, 0x4e, 0x4f, 0x4b, 0x10, 0xbd, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4e, 0x50, 0x52, 0x10, 0xbe,
-	0x01, 0x12, 0x08, 0x0a, 0x03, 0x4e, 0x5a, 0x44, 0x10, 0xbf, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4f,
-	0x4d, 0x52, 0x10, 0xc0, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x50, 0x41, 0x42, 0x10, 0xc1, 0x01, 0x12,
-	0x08, 0x0a, 0x03, 0x50, 0x45, 0x49, 0x10, 0xc2, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x50, 0x45, 0x4e,
-	0x10, 0xc3, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x50, 0x45, 0x53, 0x10, 0xc4, 0x01, 0x12, 0x08, 0x0a,
-	0x03, 0x50, 0x47, 0x4b, 0x10, 0xc5, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x50, 0x48, 0x50, 0x10, 0xc6,
-	0x01, 0x12, 0x08, 0x0a, 0x03, 0x50, 0x4b, 0x52, 0x10, 0xc7, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x50,
-	0x4c, 0x4e, 0x10, 0xc8, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x50, 0x4c, 0x5a, 0x10, 0xc9, 0x01, 0x12,
-	0x08, 0x0a, 0x03, 0x50, 0x54, 0x45, 0x10, 0xca, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x50, 0x59, 0x47,
-	0x10, 0xcb, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x51, 0x41, 0x52, 0x10, 0xcc, 0x01, 0x12, 0x08, 0x0a,
-	0x03, 0x52, 0x48, 0x44, 0x10, 0xcd, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x52, 0x4f, 0x4c, 0x10, 0xce,
-	0x01, 0x12, 0x08, 0x0a, 0x03, 0x52, 0x4f, 0x4e, 0x10, 0xcf, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x52,
-	0x53, 0x44, 0x10, 0xd0, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x52, 0x55, 0x42, 0x10, 0xd1, 0x01, 0x12,
-	0x08, 0x0a, 0x03, 0x52, 0x55, 0x52, 0x10, 0xd2, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x52, 0x57, 0x46,
-	0x10, 0xd3, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x53, 0x41, 0x52, 0x10, 0xd4, 0x01, 0x12, 0x08, 0x0a,
-	0x03, 0x53, 0x42, 0x44, 0x10, 0xd5, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x53, 0x43, 0x52, 0x10, 0xd6,
-	0x01, 0x12, 0x08, 0x0a, 0x03, 0x53, 0x44, 0x44, 0x10, 0xd7, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x53,
-	0x44, 0x47, 0x10, 0xd8, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x53, 0x44, 0x50, 0x10, 0xd9, 0x01, 0x12,
-	0x08, 0x0a, 0x03, 0x53, 0x45, 0x4b, 0x10, 0xda, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x53, 0x47, 0x44,
-	0x10, 0xdb, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x53, 0x48, 0x50, 0x10, 0xdc, 0x01, 0x12, 0x08, 0x0a,
-	0x03, 0x53, 0x49, 0x54, 0x10, 0xdd, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x53, 0x4b, 0x4b, 0x10, 0xde,
-	0x01, 0x12, 0x08, 0x0a, 0x03, 0x53, 0x4c, 0x4c, 0x10, 0xdf, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x53,
-	0x4f, 0x53, 0x10, 0xe0, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x53, 0x52, 0x44, 0x10, 0xe1, 0x01, 0x12,
-	0x08, 0x0a, 0x03, 0x53, 0x52, 0x47, 0x10, 0xe2, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x53, 0x53, 0x50,
-	0x10, 0xe3, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x53, 0x54, 0x44, 0x10, 0xe4, 0x01, 0x12, 0x08, 0x0a,
-	0x03, 0x53, 0x54, 0x4e, 0x10, 0xe5, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x53, 0x55, 0x52, 0x10, 0xe6,
-	0x01, 0x12, 0x08, 0x0a, 0x03, 0x53, 0x56, 0x43, 0x10, 0xe7, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x53,
-	0x59, 0x50, 0x10, 0xe8, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x53, 0x5a, 0x4c, 0x10, 0xe9, 0x01, 0x12,
-	0x08, 0x0a, 0x03, 0x54, 0x48, 0x42, 0x10, 0xea, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x54, 0x4a, 0x52,
-	0x10, 0xeb, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x54, 0x4a, 0x53, 0x10, 0xec, 0x01, 0x12, 0x08, 0x0a,
-	0x03, 0x54, 0x4d, 0x4d, 0x10, 0xed, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x54, 0x4d, 0x54, 0x10, 0xee,
-	0x01, 0x12, 0x08, 0x0a, 0x03, 0x54, 0x4e, 0x44, 0x10, 0xef, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x54,
-	0x4f, 0x50, 0x10, 0xf0, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x54, 0x50, 0x45, 0x10, 0xf1, 0x01, 0x12,
-	0x08, 0x0a, 0x03, 0x54, 0x52, 0x4c, 0x10, 0xf2, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x54, 0x52, 0x59,
-	0x10, 0xf3, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x54, 0x54, 0x44, 0x10, 0xf4, 0x01, 0x12, 0x08, 0x0a,
-	0x03, 0x54, 0x57, 0x44, 0x10, 0xf5, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x54, 0x5a, 0x53, 0x10, 0xf6,
-	0x01, 0x12, 0x08, 0x0a, 0x03, 0x55, 0x41, 0x48, 0x10, 0xf7, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x55,
-	0x41, 0x4b, 0x10, 0xf8, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x55, 0x47, 0x53, 0x10, 0xf9, 0x01, 0x12,
-	0x08, 0x0a, 0x03, 0x55, 0x47, 0x58, 0x10, 0xfa, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x55, 0x53, 0x44,
-	0x10, 0xfb, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x55, 0x53, 0x4e, 0x10, 0xfc, 0x01, 0x12, 0x08, 0x0a,
-	0x03, 0x55, 0x53, 0x53, 0x10, 0xfd, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x55, 0x59, 0x49, 0x10, 0xfe,
-	0x01, 0x12, 0x08, 0x0a, 0x03, 0x55, 0x59, 0x50, 0x10, 0xff, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x55,
-	0x59, 0x55, 0x10, 0x80, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x55, 0x5a, 0x53, 0x10, 0x81, 0x02, 0x12,
-	0x08, 0x0a, 0x03, 0x56, 0x45, 0x42, 0x10, 0x82, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x56, 0x45, 0x46,
-	0x10, 0x83, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x56, 0x4e, 0x44, 0x10, 0x84, 0x02, 0x12, 0x08, 0x0a,
-	0x03, 0x56, 0x4e, 0x4e, 0x10, 0x85, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x56, 0x55, 0x56, 0x10, 0x86,
-	0x02, 0x12, 0x08, 0x0a, 0x03, 0x57, 0x53, 0x54, 0x10, 0x87, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x58,
-	0x41, 0x46, 0x10, 0x88, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x58, 0x41, 0x47, 0x10, 0x89, 0x02, 0x12,
-	0x08, 0x0a, 0x03, 0x58, 0x41, 0x55, 0x10, 0x8a, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x58, 0x42, 0x41,
-	0x10, 0x8b, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x58, 0x42, 0x42, 0x10, 0x8c, 0x02, 0x12, 0x08, 0x0a,
-	0x03, 0x58, 0x42, 0x43, 0x10, 0x8d, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x58, 0x42, 0x44, 0x10, 0x8e,
-	0x02, 0x12, 0x08, 0x0a, 0x03, 0x58, 0x43, 0x44, 0x10, 0x8f, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x58,
-	0x44, 0x52, 0x10, 0x90, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x58, 0x45, 0x55, 0x10, 0x91, 0x02, 0x12,
-	0x08, 0x0a, 0x03, 0x58, 0x46, 0x4f, 0x10, 0x92, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x58, 0x46, 0x55,
-	0x10, 0x93, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x58, 0x4f, 0x46, 0x10, 0x94, 0x02, 0x12, 0x08, 0x0a,
-	0x03, 0x58, 0x50, 0x44, 0x10, 0x95, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x58, 0x50, 0x46, 0x10, 0x96,
-	0x02, 0x12, 0x08, 0x0a, 0x03, 0x58, 0x50, 0x54, 0x10, 0x97, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x58,
-	0x52, 0x45, 0x10, 0x98, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x58, 0x53, 0x55, 0x10, 0x99, 0x02, 0x12,
-	0x08, 0x0a, 0x03, 0x58, 0x54, 0x53, 0x10, 0x9a, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x58, 0x55, 0x41,
-	0x10, 0x9b, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x58, 0x58, 0x58, 0x10, 0x9c, 0x02, 0x12, 0x08, 0x0a,
-	0x03, 0x59, 0x44, 0x44, 0x10, 0x9d, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x59, 0x45, 0x52, 0x10, 0x9e,
-	0x02, 0x12, 0x08, 0x0a, 0x03, 0x59, 0x55, 0x44, 0x10, 0x9f, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x59,
-	0x55, 0x4d, 0x10, 0xa0, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x59, 0x55, 0x4e, 0x10, 0xa1, 0x02, 0x12,
-	0x08, 0x0a, 0x03, 0x59, 0x55, 0x52, 0x10, 0xa2, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x5a, 0x41, 0x4c,
-	0x10, 0xa3, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x5a, 0x41, 0x52, 0x10, 0xa4, 0x02, 0x12, 0x08, 0x0a,
-	0x03, 0x5a, 0x4d, 0x4b, 0x10, 0xa5, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x5a, 0x4d, 0x57, 0x10, 0xa6,
-	0x02, 0x12, 0x08, 0x0a, 0x03, 0x5a, 0x52, 0x4e, 0x10, 0xa7, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x5a,
-	0x52, 0x5a, 0x10, 0xa8, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x5a, 0x57, 0x44, 0x10, 0xa9, 0x02, 0x12,
-	0x08, 0x0a, 0x03, 0x5a, 0x57, 0x4c, 0x10, 0xaa, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x5a, 0x57, 0x52,
-	0x10, 0xab, 0x02, 0x2a, 0x36, 0x0a, 0x09, 0x54, 0x72, 0x69, 0x70, 0x43, 0x6c, 0x61, 0x73, 0x73,
-	0x12, 0x0d, 0x0a, 0x09, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x00, 0x12,
-	0x05, 0x0a, 0x01, 0x59, 0x10, 0x01, 0x12, 0x05, 0x0a, 0x01, 0x43, 0x10, 0x02, 0x12, 0x05, 0x0a,
-	0x01, 0x46, 0x10, 0x03, 0x12, 0x05, 0x0a, 0x01, 0x57, 0x10, 0x04, 0x42, 0x0e, 0x5a, 0x0c, 0x2e,
-	0x2e, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2d, 0x76, 0x33, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0d, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x0d, 0x74, 0x72, 0x69, 0x70, 0x5f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0c, 0x74,
+	0x72, 0x69, 0x70, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x39, 0x0a, 0x13, 0x44,
+	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x22, 0x4d, 0x0a, 0x0d, 0x44, 0x61, 0x74, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x15, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x15,
+	0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x03, 0x6d,
+	0x61, 0x78, 0x88, 0x01, 0x01, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6d, 0x69, 0x6e, 0x42, 0x06, 0x0a,
+	0x04, 0x5f, 0x6d, 0x61, 0x78, 0x22, 0x2b, 0x0a, 0x05, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6d, 0x69, 0x6e,
+	0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6d,
+	0x61, 0x78, 0x22, 0x30, 0x0a, 0x0a, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d,
+	0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x03, 0x6d, 0x61, 0x78, 0x2a, 0x66, 0x0a, 0x0a, 0x54, 0x65, 0x72, 0x6d, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x47, 0x41, 0x54, 0x45, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f,
+	0x52, 0x4f, 0x55, 0x54, 0x45, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x49, 0x4e, 0x47, 0x10,
+	0x01, 0x12, 0x17, 0x0a, 0x13, 0x46, 0x41, 0x43, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x54,
+	0x41, 0x52, 0x47, 0x45, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x49,
+	0x52, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x03, 0x12,
+	0x0b, 0x0a, 0x07, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x04, 0x2a, 0x45, 0x0a, 0x0d,
+	0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a,
+	0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x4c,
+	0x41, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x52, 0x41, 0x49, 0x4e, 0x10, 0x02,
+	0x12, 0x07, 0x0a, 0x03, 0x42, 0x55, 0x53, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x4f, 0x41,
+	0x54, 0x10, 0x04, 0x2a, 0x66, 0x0a, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4b, 0x69, 0x6e,
+	0x64, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03,
+	0x57, 0x45, 0x42, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x4f, 0x42, 0x49, 0x4c, 0x45, 0x10,
+	0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x4d, 0x4f, 0x42, 0x49, 0x4c, 0x45, 0x5f, 0x57, 0x45, 0x42, 0x10,
+	0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x57, 0x48, 0x49, 0x54, 0x45, 0x5f, 0x4c, 0x41, 0x42, 0x45, 0x4c,
+	0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x54, 0x50, 0x5f, 0x41, 0x50, 0x49, 0x10, 0x05, 0x12, 0x0b,
+	0x0a, 0x07, 0x42, 0x32, 0x42, 0x5f, 0x57, 0x45, 0x42, 0x10, 0x06, 0x2a, 0x9d, 0x01, 0x0a, 0x05,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x45, 0x53, 0x54, 0x10, 0x00, 0x12,
+	0x0c, 0x0a, 0x08, 0x43, 0x48, 0x45, 0x41, 0x50, 0x45, 0x53, 0x54, 0x10, 0x01, 0x12, 0x12, 0x0a,
+	0x0e, 0x44, 0x45, 0x50, 0x41, 0x52, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10,
+	0x02, 0x12, 0x10, 0x0a, 0x0c, 0x41, 0x52, 0x52, 0x49, 0x56, 0x41, 0x4c, 0x5f, 0x54, 0x49, 0x4d,
+	0x45, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x5f,
+	0x44, 0x55, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x52,
+	0x49, 0x50, 0x5f, 0x44, 0x55, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x05, 0x12, 0x0a, 0x0a,
+	0x06, 0x52, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x06, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x4f, 0x50,
+	0x55, 0x4c, 0x41, 0x52, 0x49, 0x54, 0x59, 0x10, 0x07, 0x12, 0x10, 0x0a, 0x0c, 0x42, 0x45, 0x53,
+	0x54, 0x5f, 0x53, 0x43, 0x4f, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x08, 0x2a, 0x17, 0x0a, 0x05, 0x42,
+	0x72, 0x61, 0x6e, 0x64, 0x12, 0x06, 0x0a, 0x02, 0x41, 0x53, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02,
+	0x57, 0x41, 0x10, 0x01, 0x2a, 0xdd, 0x16, 0x0a, 0x08, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x03, 0x41,
+	0x44, 0x50, 0x10, 0xac, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x45, 0x44, 0x10, 0x01, 0x12, 0x07,
+	0x0a, 0x03, 0x41, 0x46, 0x41, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x46, 0x4e, 0x10, 0x03,
+	0x12, 0x07, 0x0a, 0x03, 0x41, 0x4c, 0x4b, 0x10, 0x04, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4c, 0x4c,
+	0x10, 0x05, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4d, 0x44, 0x10, 0x06, 0x12, 0x07, 0x0a, 0x03, 0x41,
+	0x4e, 0x47, 0x10, 0x07, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4f, 0x41, 0x10, 0x08, 0x12, 0x07, 0x0a,
+	0x03, 0x41, 0x4f, 0x4b, 0x10, 0x09, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4f, 0x4e, 0x10, 0x0a, 0x12,
+	0x07, 0x0a, 0x03, 0x41, 0x4f, 0x52, 0x10, 0x0b, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x52, 0x41, 0x10,
+	0x0c, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x52, 0x4c, 0x10, 0x0d, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x52,
+	0x4d, 0x10, 0x0e, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x52, 0x50, 0x10, 0x0f, 0x12, 0x07, 0x0a, 0x03,
+	0x41, 0x52, 0x53, 0x10, 0x10, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x54, 0x53, 0x10, 0x11, 0x12, 0x07,
+	0x0a, 0x03, 0x41, 0x55, 0x44, 0x10, 0x12, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x57, 0x47, 0x10, 0x13,
+	0x12, 0x07, 0x0a, 0x03, 0x41, 0x5a, 0x4d, 0x10, 0x14, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x5a, 0x4e,
+	0x10, 0x15, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x41, 0x44, 0x10, 0x16, 0x12, 0x07, 0x0a, 0x03, 0x42,
+	0x41, 0x4d, 0x10, 0x17, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x41, 0x4e, 0x10, 0x18, 0x12, 0x07, 0x0a,
+	0x03, 0x42, 0x42, 0x44, 0x10, 0x19, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x44, 0x54, 0x10, 0x1a, 0x12,
+	0x07, 0x0a, 0x03, 0x42, 0x45, 0x43, 0x10, 0x1b, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x45, 0x46, 0x10,
+	0x1c, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x45, 0x4c, 0x10, 0x1d, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x47,
+	0x4c, 0x10, 0x1e, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x47, 0x4d, 0x10, 0x1f, 0x12, 0x07, 0x0a, 0x03,
+	0x42, 0x47, 0x4e, 0x10, 0x20, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x47, 0x4f, 0x10, 0x21, 0x12, 0x07,
+	0x0a, 0x03, 0x42, 0x48, 0x44, 0x10, 0x22, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x49, 0x46, 0x10, 0x23,
+	0x12, 0x07, 0x0a, 0x03, 0x42, 0x4d, 0x44, 0x10, 0x24, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x4e, 0x44,
+	0x10, 0x25, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x4f, 0x42, 0x10, 0x26, 0x12, 0x07, 0x0a, 0x03, 0x42,
+	0x4f, 0x4c, 0x10, 0x27, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x4f, 0x50, 0x10, 0x28, 0x12, 0x07, 0x0a,
+	0x03, 0x42, 0x4f, 0x56, 0x10, 0x29, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x52, 0x42, 0x10, 0x2a, 0x12,
+	0x07, 0x0a, 0x03, 0x42, 0x52, 0x43, 0x10, 0x2b, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x52, 0x45, 0x10,
+	0x2c, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x52, 0x4c, 0x10, 0x2d, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x52,
+	0x4e, 0x10, 0x2e, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x52, 0x52, 0x10, 0x2f, 0x12, 0x07, 0x0a, 0x03,
+	0x42, 0x52, 0x5a, 0x10, 0x30, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x53, 0x44, 0x10, 0x31, 0x12, 0x07,
+	0x0a, 0x03, 0x42, 0x54, 0x4e, 0x10, 0x32, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x55, 0x4b, 0x10, 0x33,
+	0x12, 0x07, 0x0a, 0x03, 0x42, 0x57, 0x50, 0x10, 0x34, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x59, 0x42,
+	0x10, 0x35, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x59, 0x4e, 0x10, 0x36, 0x12, 0x07, 0x0a, 0x03, 0x42,
+	0x59, 0x52, 0x10, 0x37, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x5a, 0x44, 0x10, 0x38, 0x12, 0x07, 0x0a,
+	0x03, 0x43, 0x41, 0x44, 0x10, 0x39, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x44, 0x46, 0x10, 0x3a, 0x12,
+	0x07, 0x0a, 0x03, 0x43, 0x48, 0x45, 0x10, 0x3b, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x48, 0x46, 0x10,
+	0x3c, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x48, 0x57, 0x10, 0x3d, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x4c,
+	0x45, 0x10, 0x3e, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x4c, 0x46, 0x10, 0x3f, 0x12, 0x07, 0x0a, 0x03,
+	0x43, 0x4c, 0x50, 0x10, 0x40, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x4e, 0x48, 0x10, 0x41, 0x12, 0x07,
+	0x0a, 0x03, 0x43, 0x4e, 0x58, 0x10, 0x42, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x4e, 0x59, 0x10, 0x43,
+	0x12, 0x07, 0x0a, 0x03, 0x43, 0x4f, 0x50, 0x10, 0x44, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x4f, 0x55,
+	0x10, 0x45, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x52, 0x43, 0x10, 0x46, 0x12, 0x07, 0x0a, 0x03, 0x43,
+	0x53, 0x44, 0x10, 0x47, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x53, 0x4b, 0x10, 0x48, 0x12, 0x07, 0x0a,
+	0x03, 0x43, 0x55, 0x43, 0x10, 0x49, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x55, 0x50, 0x10, 0x4a, 0x12,
+	0x07, 0x0a, 0x03, 0x43, 0x56, 0x45, 0x10, 0x4b, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x59, 0x50, 0x10,
+	0x4c, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x5a, 0x4b, 0x10, 0x4d, 0x12, 0x07, 0x0a, 0x03, 0x44, 0x44,
+	0x4d, 0x10, 0x4e, 0x12, 0x07, 0x0a, 0x03, 0x44, 0x45, 0x4d, 0x10, 0x4f, 0x12, 0x07, 0x0a, 0x03,
+	0x44, 0x4a, 0x46, 0x10, 0x50, 0x12, 0x07, 0x0a, 0x03, 0x44, 0x4b, 0x4b, 0x10, 0x51, 0x12, 0x07,
+	0x0a, 0x03, 0x44, 0x4f, 0x50, 0x10, 0x52, 0x12, 0x07, 0x0a, 0x03, 0x44, 0x5a, 0x44, 0x10, 0x53,
+	0x12, 0x07, 0x0a, 0x03, 0x45, 0x43, 0x53, 0x10, 0x54, 0x12, 0x07, 0x0a, 0x03, 0x45, 0x43, 0x56,
+	0x10, 0x55, 0x12, 0x07, 0x0a, 0x03, 0x45, 0x45, 0x4b, 0x10, 0x56, 0x12, 0x07, 0x0a, 0x03, 0x45,
+	0x47, 0x50, 0x10, 0x57, 0x12, 0x07, 0x0a, 0x03, 0x45, 0x52, 0x4e, 0x10, 0x58, 0x12, 0x07, 0x0a,
+	0x03, 0x45, 0x53, 0x41, 0x10, 0x59, 0x12, 0x07, 0x0a, 0x03, 0x45, 0x53, 0x42, 0x10, 0x5a, 0x12,
+	0x07, 0x0a, 0x03, 0x45, 0x53, 0x50, 0x10, 0x5b, 0x12, 0x07, 0x0a, 0x03, 0x45, 0x54, 0x42, 0x10,
+	0x5c, 0x12, 0x07, 0x0a, 0x03, 0x45, 0x55, 0x52, 0x10, 0x5d, 0x12, 0x07, 0x0a, 0x03, 0x46, 0x49,
+	0x4d, 0x10, 0x5e, 0x12, 0x07, 0x0a, 0x03, 0x46, 0x4a, 0x44, 0x10, 0x5f, 0x12, 0x07, 0x0a, 0x03,
+	0x46, 0x4b, 0x50, 0x10, 0x60, 0x12, 0x07, 0x0a, 0x03, 0x46, 0x52, 0x46, 0x10, 0x61, 0x12, 0x07,
+	0x0a, 0x03, 0x47, 0x42, 0x50, 0x10, 0x62, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x45, 0x4b, 0x10, 0x63,
+	0x12, 0x07, 0x0a, 0x03, 0x47, 0x45, 0x4c, 0x10, 0x64, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x48, 0x43,
+	0x10, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x48, 0x53, 0x10, 0x66, 0x12, 0x07, 0x0a, 0x03, 0x47,
+	0x49, 0x50, 0x10, 0x67, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x4d, 0x44, 0x10, 0x68, 0x12, 0x07, 0x0a,
+	0x03, 0x47, 0x4e, 0x46, 0x10, 0x69, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x4e, 0x53, 0x10, 0x6a, 0x12,
+	0x07, 0x0a, 0x03, 0x47, 0x51, 0x45, 0x10, 0x6b, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x52, 0x44, 0x10,
+	0x6c, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x54, 0x51, 0x10, 0x6d, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x57,
+	0x45, 0x10, 0x6e, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x57, 0x50, 0x10, 0x6f, 0x12, 0x07, 0x0a, 0x03,
+	0x47, 0x59, 0x44, 0x10, 0x70, 0x12, 0x07, 0x0a, 0x03, 0x48, 0x4b, 0x44, 0x10, 0x71, 0x12, 0x07,
+	0x0a, 0x03, 0x48, 0x4e, 0x4c, 0x10, 0x72, 0x12, 0x07, 0x0a, 0x03, 0x48, 0x52, 0x44, 0x10, 0x73,
+	0x12, 0x07, 0x0a, 0x03, 0x48, 0x52, 0x4b, 0x10, 0x74, 0x12, 0x07, 0x0a, 0x03, 0x48, 0x54, 0x47,
+	0x10, 0x75, 0x12, 0x07, 0x0a, 0x03, 0x48, 0x55, 0x46, 0x10, 0x76, 0x12, 0x07, 0x0a, 0x03, 0x49,
+	0x44, 0x52, 0x10, 0x77, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x45, 0x50, 0x10, 0x78, 0x12, 0x07, 0x0a,
+	0x03, 0x49, 0x4c, 0x50, 0x10, 0x79, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x4c, 0x52, 0x10, 0x7a, 0x12,
+	0x07, 0x0a, 0x03, 0x49, 0x4c, 0x53, 0x10, 0x7b, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x4e, 0x52, 0x10,
+	0x7c, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x51, 0x44, 0x10, 0x7d, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x52,
+	0x52, 0x10, 0x7e, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x53, 0x4a, 0x10, 0x7f, 0x12, 0x08, 0x0a, 0x03,
+	0x49, 0x53, 0x4b, 0x10, 0x80, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x49, 0x54, 0x4c, 0x10, 0x81, 0x01,
+	0x12, 0x08, 0x0a, 0x03, 0x4a, 0x4d, 0x44, 0x10, 0x82, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4a, 0x4f,
+	0x44, 0x10, 0x83, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4a, 0x50, 0x59, 0x10, 0x84, 0x01, 0x12, 0x08,
+	0x0a, 0x03, 0x4b, 0x45, 0x53, 0x10, 0x85, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4b, 0x47, 0x53, 0x10,
+	0x86, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4b, 0x48, 0x52, 0x10, 0x87, 0x01, 0x12, 0x08, 0x0a, 0x03,
+	0x4b, 0x4d, 0x46, 0x10, 0x88, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4b, 0x50, 0x57, 0x10, 0x89, 0x01,
+	0x12, 0x08, 0x0a, 0x03, 0x4b, 0x52, 0x48, 0x10, 0x8a, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4b, 0x52,
+	0x4f, 0x10, 0x8b, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4b, 0x52, 0x57, 0x10, 0x8c, 0x01, 0x12, 0x08,
+	0x0a, 0x03, 0x4b, 0x57, 0x44, 0x10, 0x8d, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4b, 0x59, 0x44, 0x10,
+	0x8e, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4b, 0x5a, 0x54, 0x10, 0x8f, 0x01, 0x12, 0x08, 0x0a, 0x03,
+	0x4c, 0x41, 0x4b, 0x10, 0x90, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4c, 0x42, 0x50, 0x10, 0x91, 0x01,
+	0x12, 0x08, 0x0a, 0x03, 0x4c, 0x4b, 0x52, 0x10, 0x92, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4c, 0x52,
+	0x44, 0x10, 0x93, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4c, 0x53, 0x4c, 0x10, 0x94, 0x01, 0x12, 0x08,
+	0x0a, 0x03, 0x4c, 0x54, 0x4c, 0x10, 0x95, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4c, 0x54, 0x54, 0x10,
+	0x96, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4c, 0x55, 0x43, 0x10, 0x97, 0x01, 0x12, 0x08, 0x0a, 0x03,
+	0x4c, 0x55, 0x46, 0x10, 0x98, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4c, 0x55, 0x4c, 0x10, 0x99, 0x01,
+	0x12, 0x08, 0x0a, 0x03, 0x4c, 0x56, 0x4c, 0x10, 0x9a, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4c, 0x56,
+	0x52, 0x10, 0x9b, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4c, 0x59, 0x44, 0x10, 0x9c, 0x01, 0x12, 0x08,
+	0x0a, 0x03, 0x4d, 0x41, 0x44, 0x10, 0x9d, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4d, 0x41, 0x46, 0x10,
+	0x9e, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4d, 0x43, 0x46, 0x10, 0x9f, 0x01, 0x12, 0x08, 0x0a, 0x03,
+	0x4d, 0x44, 0x43, 0x10, 0xa0, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4d, 0x44, 0x4c, 0x10, 0xa1, 0x01,
+	0x12, 0x08, 0x0a, 0x03, 0x4d, 0x47, 0x41, 0x10, 0xa2, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4d, 0x47,
+	0x46, 0x10, 0xa3, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4d, 0x4b, 0x44, 0x10, 0xa4, 0x01, 0x12, 0x08,
+	0x0a, 0x03, 0x4d, 0x4b, 0x4e, 0x10, 0xa5, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4d, 0x4c, 0x46, 0x10,
+	0xa6, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4d, 0x4d, 0x4b, 0x10, 0xa7, 0x01, 0x12, 0x08, 0x0a, 0x03,
+	0x4d, 0x4e, 0x54, 0x10, 0xa8, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4d, 0x4f, 0x50, 0x10, 0xa9, 0x01,
+	0x12, 0x08, 0x0a, 0x03, 0x4d, 0x52, 0x4f, 0x10, 0xaa, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4d, 0x54,
+	0x4c, 0x10, 0xab, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4d, 0x54, 0x50, 0x10, 0xac, 0x01, 0x12, 0x08,
+	0x0a, 0x03, 0x4d, 0x55, 0x52, 0x10, 0xad, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4d, 0x56, 0x50, 0x10,
+	0xae, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4d, 0x56, 0x52, 0x10, 0xaf, 0x01, 0x12, 0x08, 0x0a, 0x03,
+	0x4d, 0x57, 0x4b, 0x10, 0xb0, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4d, 0x58, 0x4e, 0x10, 0xb1, 0x01,
+	0x12, 0x08, 0x0a, 0x03, 0x4d, 0x58, 0x50, 0x10, 0xb2, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4d, 0x58,
+	0x56, 0x10, 0xb3, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4d, 0x59, 0x52, 0x10, 0xb4, 0x01, 0x12, 0x08,
+	0x0a, 0x03, 0x4d, 0x5a, 0x45, 0x10, 0xb5, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4d, 0x5a, 0x4d, 0x10,
+	0xb6, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4d, 0x5a, 0x4e, 0x10, 0xb7, 0x01, 0x12, 0x08, 0x0a, 0x03,
+	0x4e, 0x41, 0x44, 0x10, 0xb8, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4e, 0x47, 0x4e, 0x10, 0xb9, 0x01,
+	0x12, 0x08, 0x0a, 0x03, 0x4e, 0x49, 0x43, 0x10, 0xba, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4e, 0x49,
+	0x4f, 0x10, 0xbb, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4e, 0x4c, 0x47, 0x10, 0xbc, 0x01, 0x12, 0x08,
+	0x0a, 0x03, 0x4e, 0x4f, 0x4b, 0x10, 0xbd, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4e, 0x50, 0x52, 0x10,
+	0xbe, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x4e, 0x5a, 0x44, 0x10, 0xbf, 0x01, 0x12, 0x08, 0x0a, 0x03,
+	0x4f, 0x4d, 0x52, 0x10, 0xc0, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x50, 0x41, 0x42, 0x10, 0xc1, 0x01,
+	0x12, 0x08, 0x0a, 0x03, 0x50, 0x45, 0x49, 0x10, 0xc2, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x50, 0x45,
+	0x4e, 0x10, 0xc3, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x50, 0x45, 0x53, 0x10, 0xc4, 0x01, 0x12, 0x08,
+	0x0a, 0x03, 0x50, 0x47, 0x4b, 0x10, 0xc5, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x50, 0x48, 0x50, 0x10,
+	0xc6, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x50, 0x4b, 0x52, 0x10, 0xc7, 0x01, 0x12, 0x08, 0x0a, 0x03,
+	0x50, 0x4c, 0x4e, 0x10, 0xc8, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x50, 0x4c, 0x5a, 0x10, 0xc9, 0x01,
+	0x12, 0x08, 0x0a, 0x03, 0x50, 0x54, 0x45, 0x10, 0xca, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x50, 0x59,
+	0x47, 0x10, 0xcb, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x51, 0x41, 0x52, 0x10, 0xcc, 0x01, 0x12, 0x08,
+	0x0a, 0x03, 0x52, 0x48, 0x44, 0x10, 0xcd, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x52, 0x4f, 0x4c, 0x10,
+	0xce, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x52, 0x4f, 0x4e, 0x10, 0xcf, 0x01, 0x12, 0x08, 0x0a, 0x03,
+	0x52, 0x53, 0x44, 0x10, 0xd0, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x52, 0x55, 0x42, 0x10, 0xd1, 0x01,
+	0x12, 0x08, 0x0a, 0x03, 0x52, 0x55, 0x52, 0x10, 0xd2, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x52, 0x57,
+	0x46, 0x10, 0xd3, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x53, 0x41, 0x52, 0x10, 0xd4, 0x01, 0x12, 0x08,
+	0x0a, 0x03, 0x53, 0x42, 0x44, 0x10, 0xd5, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x53, 0x43, 0x52, 0x10,
+	0xd6, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x53, 0x44, 0x44, 0x10, 0xd7, 0x01, 0x12, 0x08, 0x0a, 0x03,
+	0x53, 0x44, 0x47, 0x10, 0xd8, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x53, 0x44, 0x50, 0x10, 0xd9, 0x01,
+	0x12, 0x08, 0x0a, 0x03, 0x53, 0x45, 0x4b, 0x10, 0xda, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x53, 0x47,
+	0x44, 0x10, 0xdb, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x53, 0x48, 0x50, 0x10, 0xdc, 0x01, 0x12, 0x08,
+	0x0a, 0x03, 0x53, 0x49, 0x54, 0x10, 0xdd, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x53, 0x4b, 0x4b, 0x10,
+	0xde, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x53, 0x4c, 0x4c, 0x10, 0xdf, 0x01, 0x12, 0x08, 0x0a, 0x03,
+	0x53, 0x4f, 0x53, 0x10, 0xe0, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x53, 0x52, 0x44, 0x10, 0xe1, 0x01,
+	0x12, 0x08, 0x0a, 0x03, 0x53, 0x52, 0x47, 0x10, 0xe2, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x53, 0x53,
+	0x50, 0x10, 0xe3, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x53, 0x54, 0x44, 0x10, 0xe4, 0x01, 0x12, 0x08,
+	0x0a, 0x03, 0x53, 0x54, 0x4e, 0x10, 0xe5, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x53, 0x55, 0x52, 0x10,
+	0xe6, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x53, 0x56, 0x43, 0x10, 0xe7, 0x01, 0x12, 0x08, 0x0a, 0x03,
+	0x53, 0x59, 0x50, 0x10, 0xe8, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x53, 0x5a, 0x4c, 0x10, 0xe9, 0x01,
+	0x12, 0x08, 0x0a, 0x03, 0x54, 0x48, 0x42, 0x10, 0xea, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x54, 0x4a,
+	0x52, 0x10, 0xeb, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x54, 0x4a, 0x53, 0x10, 0xec, 0x01, 0x12, 0x08,
+	0x0a, 0x03, 0x54, 0x4d, 0x4d, 0x10, 0xed, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x54, 0x4d, 0x54, 0x10,
+	0xee, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x54, 0x4e, 0x44, 0x10, 0xef, 0x01, 0x12, 0x08, 0x0a, 0x03,
+	0x54, 0x4f, 0x50, 0x10, 0xf0, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x54, 0x50, 0x45, 0x10, 0xf1, 0x01,
+	0x12, 0x08, 0x0a, 0x03, 0x54, 0x52, 0x4c, 0x10, 0xf2, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x54, 0x52,
+	0x59, 0x10, 0xf3, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x54, 0x54, 0x44, 0x10, 0xf4, 0x01, 0x12, 0x08,
+	0x0a, 0x03, 0x54, 0x57, 0x44, 0x10, 0xf5, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x54, 0x5a, 0x53, 0x10,
+	0xf6, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x55, 0x41, 0x48, 0x10, 0xf7, 0x01, 0x12, 0x08, 0x0a, 0x03,
+	0x55, 0x41, 0x4b, 0x10, 0xf8, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x55, 0x47, 0x53, 0x10, 0xf9, 0x01,
+	0x12, 0x08, 0x0a, 0x03, 0x55, 0x47, 0x58, 0x10, 0xfa, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x55, 0x53,
+	0x44, 0x10, 0xfb, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x55, 0x53, 0x4e, 0x10, 0xfc, 0x01, 0x12, 0x08,
+	0x0a, 0x03, 0x55, 0x53, 0x53, 0x10, 0xfd, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x55, 0x59, 0x49, 0x10,
+	0xfe, 0x01, 0x12, 0x08, 0x0a, 0x03, 0x55, 0x59, 0x50, 0x10, 0xff, 0x01, 0x12, 0x08, 0x0a, 0x03,
+	0x55, 0x59, 0x55, 0x10, 0x80, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x55, 0x5a, 0x53, 0x10, 0x81, 0x02,
+	0x12, 0x08, 0x0a, 0x03, 0x56, 0x45, 0x42, 0x10, 0x82, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x56, 0x45,
+	0x46, 0x10, 0x83, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x56, 0x4e, 0x44, 0x10, 0x84, 0x02, 0x12, 0x08,
+	0x0a, 0x03, 0x56, 0x4e, 0x4e, 0x10, 0x85, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x56, 0x55, 0x56, 0x10,
+	0x86, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x57, 0x53, 0x54, 0x10, 0x87, 0x02, 0x12, 0x08, 0x0a, 0x03,
+	0x58, 0x41, 0x46, 0x10, 0x88, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x58, 0x41, 0x47, 0x10, 0x89, 0x02,
+	0x12, 0x08, 0x0a, 0x03, 0x58, 0x41, 0x55, 0x10, 0x8a, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x58, 0x42,
+	0x41, 0x10, 0x8b, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x58, 0x42, 0x42, 0x10, 0x8c, 0x02, 0x12, 0x08,
+	0x0a, 0x03, 0x58, 0x42, 0x43, 0x10, 0x8d, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x58, 0x42, 0x44, 0x10,
+	0x8e, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x58, 0x43, 0x44, 0x10, 0x8f, 0x02, 0x12, 0x08, 0x0a, 0x03,
+	0x58, 0x44, 0x52, 0x10, 0x90, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x58, 0x45, 0x55, 0x10, 0x91, 0x02,
+	0x12, 0x08, 0x0a, 0x03, 0x58, 0x46, 0x4f, 0x10, 0x92, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x58, 0x46,
+	0x55, 0x10, 0x93, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x58, 0x4f, 0x46, 0x10, 0x94, 0x02, 0x12, 0x08,
+	0x0a, 0x03, 0x58, 0x50, 0x44, 0x10, 0x95, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x58, 0x50, 0x46, 0x10,
+	0x96, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x58, 0x50, 0x54, 0x10, 0x97, 0x02, 0x12, 0x08, 0x0a, 0x03,
+	0x58, 0x52, 0x45, 0x10, 0x98, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x58, 0x53, 0x55, 0x10, 0x99, 0x02,
+	0x12, 0x08, 0x0a, 0x03, 0x58, 0x54, 0x53, 0x10, 0x9a, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x58, 0x55,
+	0x41, 0x10, 0x9b, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x58, 0x58, 0x58, 0x10, 0x9c, 0x02, 0x12, 0x08,
+	0x0a, 0x03, 0x59, 0x44, 0x44, 0x10, 0x9d, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x59, 0x45, 0x52, 0x10,
+	0x9e, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x59, 0x55, 0x44, 0x10, 0x9f, 0x02, 0x12, 0x08, 0x0a, 0x03,
+	0x59, 0x55, 0x4d, 0x10, 0xa0, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x59, 0x55, 0x4e, 0x10, 0xa1, 0x02,
+	0x12, 0x08, 0x0a, 0x03, 0x59, 0x55, 0x52, 0x10, 0xa2, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x5a, 0x41,
+	0x4c, 0x10, 0xa3, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x5a, 0x41, 0x52, 0x10, 0xa4, 0x02, 0x12, 0x08,
+	0x0a, 0x03, 0x5a, 0x4d, 0x4b, 0x10, 0xa5, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x5a, 0x4d, 0x57, 0x10,
+	0xa6, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x5a, 0x52, 0x4e, 0x10, 0xa7, 0x02, 0x12, 0x08, 0x0a, 0x03,
+	0x5a, 0x52, 0x5a, 0x10, 0xa8, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x5a, 0x57, 0x44, 0x10, 0xa9, 0x02,
+	0x12, 0x08, 0x0a, 0x03, 0x5a, 0x57, 0x4c, 0x10, 0xaa, 0x02, 0x12, 0x08, 0x0a, 0x03, 0x5a, 0x57,
+	0x52, 0x10, 0xab, 0x02, 0x2a, 0x36, 0x0a, 0x09, 0x54, 0x72, 0x69, 0x70, 0x43, 0x6c, 0x61, 0x73,
+	0x73, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x05, 0x0a, 0x01, 0x59, 0x10, 0x01, 0x12, 0x05, 0x0a, 0x01, 0x43, 0x10, 0x02, 0x12, 0x05,
+	0x0a, 0x01, 0x46, 0x10, 0x03, 0x12, 0x05, 0x0a, 0x01, 0x57, 0x10, 0x04, 0x42, 0x0e, 0x5a, 0x0c,
+	0x2e, 0x2e, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2d, 0x76, 0x33, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -9670,7 +9634,7 @@ func file_protobuf_search_v3_results_proto_rawDescGZIP() []byte {
 }
 
 var file_protobuf_search_v3_results_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
-var file_protobuf_search_v3_results_proto_msgTypes = make([]protoimpl.MessageInfo, 141)
+var file_protobuf_search_v3_results_proto_msgTypes = make([]protoimpl.MessageInfo, 140)
 var file_protobuf_search_v3_results_proto_goTypes = []interface{}{
 	(TermSource)(0),                        // 0: search_v3.TermSource
 	(EquipmentType)(0),                     // 1: search_v3.EquipmentType
@@ -9727,99 +9691,99 @@ var file_protobuf_search_v3_results_proto_goTypes = []interface{}{
 	(*Places)(nil),                         // 52: search_v3.Places
 	(*AirportInfo)(nil),                    // 53: search_v3.AirportInfo
 	(*GeoPoint)(nil),                       // 54: search_v3.GeoPoint
-	(*OptBool)(nil),                        // 55: search_v3.OptBool
-	(*CityInfo)(nil),                       // 56: search_v3.CityInfo
-	(*CountryInfo)(nil),                    // 57: search_v3.CountryInfo
-	(*MetroAreaInfo)(nil),                  // 58: search_v3.MetroAreaInfo
-	(*AgentInfo)(nil),                      // 59: search_v3.AgentInfo
-	(*Alliance)(nil),                       // 60: search_v3.Alliance
-	(*Equipment)(nil),                      // 61: search_v3.Equipment
-	(*SearchParams)(nil),                   // 62: search_v3.SearchParams
-	(*Passengers)(nil),                     // 63: search_v3.Passengers
-	(*DegradedBoundaries)(nil),             // 64: search_v3.DegradedBoundaries
-	(*FilterPrice)(nil),                    // 65: search_v3.FilterPrice
-	(*FilterBool)(nil),                     // 66: search_v3.FilterBool
-	(*DegradedAirportsBoundaries)(nil),     // 67: search_v3.DegradedAirportsBoundaries
-	(*FilterBaggageBoundaries)(nil),        // 68: search_v3.FilterBaggageBoundaries
-	(*BaggageBoundaries)(nil),              // 69: search_v3.BaggageBoundaries
-	(*PriceBoundaries)(nil),                // 70: search_v3.PriceBoundaries
-	(*DegradedTimeBoundaries)(nil),         // 71: search_v3.DegradedTimeBoundaries
-	(*DateTimeRangeBoundaries)(nil),        // 72: search_v3.DateTimeRangeBoundaries
-	(*RangeBoundaries)(nil),                // 73: search_v3.RangeBoundaries
-	(*DegradedReturnTicketBoundaries)(nil), // 74: search_v3.DegradedReturnTicketBoundaries
-	(*TransferDurationBoundaries)(nil),     // 75: search_v3.TransferDurationBoundaries
-	(*Boundaries)(nil),                     // 76: search_v3.Boundaries
-	(*ReturnBoundaries)(nil),               // 77: search_v3.ReturnBoundaries
-	(*ChangeBoundaries)(nil),               // 78: search_v3.ChangeBoundaries
-	(*AirportsBoundaries)(nil),             // 79: search_v3.AirportsBoundaries
-	(*TimeBoundaries)(nil),                 // 80: search_v3.TimeBoundaries
-	(*ResultsMeta)(nil),                    // 81: search_v3.ResultsMeta
-	(*FilterState)(nil),                    // 82: search_v3.FilterState
-	(*TimeBuckets)(nil),                    // 83: search_v3.TimeBuckets
-	(*SegmentFilter)(nil),                  // 84: search_v3.SegmentFilter
-	(*DateTimeOrTimeRange)(nil),            // 85: search_v3.DateTimeOrTimeRange
-	(*DateTimeRange)(nil),                  // 86: search_v3.DateTimeRange
-	(*Range)(nil),                          // 87: search_v3.Range
-	(*FloatRange)(nil),                     // 88: search_v3.FloatRange
-	nil,                                    // 89: search_v3.Chunk.BrandTicketsEntry
-	nil,                                    // 90: search_v3.Chunk.AirlinesEntry
-	nil,                                    // 91: search_v3.Chunk.AgentsEntry
-	nil,                                    // 92: search_v3.Chunk.AlliancesEntry
-	nil,                                    // 93: search_v3.Chunk.EquipmentsEntry
-	nil,                                    // 94: search_v3.DebugInfo.GatesEntry
-	nil,                                    // 95: search_v3.GateDebugInfo.AgentsEntry
-	nil,                                    // 96: search_v3.AgentDebugInfo.ProposalsEntry
-	nil,                                    // 97: search_v3.AgentDebugInfo.BadProposalsEntry
-	nil,                                    // 98: search_v3.AgentDebugInfo.FilteredProposalsEntry
-	nil,                                    // 99: search_v3.AgentDebugInfo.MergedFlightTermsSourcesEntry
-	nil,                                    // 100: search_v3.Proposal.FlightTermsEntry
-	nil,                                    // 101: search_v3.FlightTerm.MergedFromOtherProposalsEntry
-	nil,                                    // 102: search_v3.ProposalDebugInfo.FlightTermsEntry
-	nil,                                    // 103: search_v3.Ticket.ExtraFaresEntry
-	nil,                                    // 104: search_v3.BadgeInfoMeta.NameEntry
-	nil,                                    // 105: search_v3.AirlineInfo.NameEntry
-	nil,                                    // 106: search_v3.MapStringString.MapEntry
-	nil,                                    // 107: search_v3.Places.AirportsEntry
-	nil,                                    // 108: search_v3.Places.CitiesEntry
-	nil,                                    // 109: search_v3.Places.CountriesEntry
-	nil,                                    // 110: search_v3.Places.MetroAreasEntry
-	nil,                                    // 111: search_v3.Places.AirportsToMetroEntry
-	nil,                                    // 112: search_v3.AirportInfo.NameEntry
-	nil,                                    // 113: search_v3.CityInfo.NameEntry
-	nil,                                    // 114: search_v3.CountryInfo.NameEntry
-	nil,                                    // 115: search_v3.AgentInfo.LabelEntry
-	nil,                                    // 116: search_v3.SearchParams.ExperimentsEntry
-	nil,                                    // 117: search_v3.DegradedBoundaries.AgentsEntry
-	nil,                                    // 118: search_v3.DegradedBoundaries.AirlinesEntry
-	nil,                                    // 119: search_v3.DegradedBoundaries.AlliancesEntry
-	nil,                                    // 120: search_v3.DegradedBoundaries.AirportsEntry
-	nil,                                    // 121: search_v3.DegradedBoundaries.SameDepartureArrivalAirportEntry
-	nil,                                    // 122: search_v3.DegradedBoundaries.EquipmentsEntry
-	nil,                                    // 123: search_v3.DegradedBoundaries.PaymentMethodsEntry
-	nil,                                    // 124: search_v3.DegradedBoundaries.DepartureArrivalTimeEntry
-	nil,                                    // 125: search_v3.DegradedBoundaries.TransfersCountEntry
-	nil,                                    // 126: search_v3.DegradedBoundaries.TransfersAirportsEntry
-	nil,                                    // 127: search_v3.DegradedBoundaries.TransfersCountriesEntry
-	nil,                                    // 128: search_v3.DegradedAirportsBoundaries.ArrivalEntry
-	nil,                                    // 129: search_v3.DegradedAirportsBoundaries.DepartureEntry
-	nil,                                    // 130: search_v3.DegradedTimeBoundaries.ArrivalDateEntry
-	nil,                                    // 131: search_v3.DateTimeRangeBoundaries.BucketsEntry
-	nil,                                    // 132: search_v3.RangeBoundaries.BucketsEntry
-	nil,                                    // 133: search_v3.Boundaries.AgentsEntry
-	nil,                                    // 134: search_v3.Boundaries.AirlinesEntry
-	nil,                                    // 135: search_v3.Boundaries.AlliancesEntry
-	nil,                                    // 136: search_v3.Boundaries.AirportsEntry
-	nil,                                    // 137: search_v3.Boundaries.SameDepartureArrivalAirportEntry
-	nil,                                    // 138: search_v3.Boundaries.EquipmentsEntry
-	nil,                                    // 139: search_v3.Boundaries.PaymentMethodsEntry
-	nil,                                    // 140: search_v3.Boundaries.DepartureArrivalTimeEntry
-	nil,                                    // 141: search_v3.Boundaries.TransfersCountEntry
-	nil,                                    // 142: search_v3.Boundaries.TransfersAirportsEntry
-	nil,                                    // 143: search_v3.Boundaries.TransfersCountriesEntry
-	nil,                                    // 144: search_v3.AirportsBoundaries.ArrivalEntry
-	nil,                                    // 145: search_v3.AirportsBoundaries.DepartureEntry
-	nil,                                    // 146: search_v3.TimeBoundaries.ArrivalDateEntry
-	nil,                                    // 147: search_v3.FilterState.SegmentsEntry
+	(*CityInfo)(nil),                       // 55: search_v3.CityInfo
+	(*CountryInfo)(nil),                    // 56: search_v3.CountryInfo
+	(*MetroAreaInfo)(nil),                  // 57: search_v3.MetroAreaInfo
+	(*AgentInfo)(nil),                      // 58: search_v3.AgentInfo
+	(*Alliance)(nil),                       // 59: search_v3.Alliance
+	(*Equipment)(nil),                      // 60: search_v3.Equipment
+	(*SearchParams)(nil),                   // 61: search_v3.SearchParams
+	(*Passengers)(nil),                     // 62: search_v3.Passengers
+	(*DegradedBoundaries)(nil),             // 63: search_v3.DegradedBoundaries
+	(*FilterPrice)(nil),                    // 64: search_v3.FilterPrice
+	(*FilterBool)(nil),                     // 65: search_v3.FilterBool
+	(*DegradedAirportsBoundaries)(nil),     // 66: search_v3.DegradedAirportsBoundaries
+	(*FilterBaggageBoundaries)(nil),        // 67: search_v3.FilterBaggageBoundaries
+	(*BaggageBoundaries)(nil),              // 68: search_v3.BaggageBoundaries
+	(*PriceBoundaries)(nil),                // 69: search_v3.PriceBoundaries
+	(*DegradedTimeBoundaries)(nil),         // 70: search_v3.DegradedTimeBoundaries
+	(*DateTimeRangeBoundaries)(nil),        // 71: search_v3.DateTimeRangeBoundaries
+	(*RangeBoundaries)(nil),                // 72: search_v3.RangeBoundaries
+	(*DegradedReturnTicketBoundaries)(nil), // 73: search_v3.DegradedReturnTicketBoundaries
+	(*TransferDurationBoundaries)(nil),     // 74: search_v3.TransferDurationBoundaries
+	(*Boundaries)(nil),                     // 75: search_v3.Boundaries
+	(*ReturnBoundaries)(nil),               // 76: search_v3.ReturnBoundaries
+	(*ChangeBoundaries)(nil),               // 77: search_v3.ChangeBoundaries
+	(*AirportsBoundaries)(nil),             // 78: search_v3.AirportsBoundaries
+	(*TimeBoundaries)(nil),                 // 79: search_v3.TimeBoundaries
+	(*ResultsMeta)(nil),                    // 80: search_v3.ResultsMeta
+	(*FilterState)(nil),                    // 81: search_v3.FilterState
+	(*TimeBuckets)(nil),                    // 82: search_v3.TimeBuckets
+	(*SegmentFilter)(nil),                  // 83: search_v3.SegmentFilter
+	(*DateTimeOrTimeRange)(nil),            // 84: search_v3.DateTimeOrTimeRange
+	(*DateTimeRange)(nil),                  // 85: search_v3.DateTimeRange
+	(*Range)(nil),                          // 86: search_v3.Range
+	(*FloatRange)(nil),                     // 87: search_v3.FloatRange
+	nil,                                    // 88: search_v3.Chunk.BrandTicketsEntry
+	nil,                                    // 89: search_v3.Chunk.AirlinesEntry
+	nil,                                    // 90: search_v3.Chunk.AgentsEntry
+	nil,                                    // 91: search_v3.Chunk.AlliancesEntry
+	nil,                                    // 92: search_v3.Chunk.EquipmentsEntry
+	nil,                                    // 93: search_v3.DebugInfo.GatesEntry
+	nil,                                    // 94: search_v3.GateDebugInfo.AgentsEntry
+	nil,                                    // 95: search_v3.AgentDebugInfo.ProposalsEntry
+	nil,                                    // 96: search_v3.AgentDebugInfo.BadProposalsEntry
+	nil,                                    // 97: search_v3.AgentDebugInfo.FilteredProposalsEntry
+	nil,                                    // 98: search_v3.AgentDebugInfo.MergedFlightTermsSourcesEntry
+	nil,                                    // 99: search_v3.Proposal.FlightTermsEntry
+	nil,                                    // 100: search_v3.FlightTerm.MergedFromOtherProposalsEntry
+	nil,                                    // 101: search_v3.ProposalDebugInfo.FlightTermsEntry
+	nil,                                    // 102: search_v3.Ticket.ExtraFaresEntry
+	nil,                                    // 103: search_v3.BadgeInfoMeta.NameEntry
+	nil,                                    // 104: search_v3.AirlineInfo.NameEntry
+	nil,                                    // 105: search_v3.MapStringString.MapEntry
+	nil,                                    // 106: search_v3.Places.AirportsEntry
+	nil,                                    // 107: search_v3.Places.CitiesEntry
+	nil,                                    // 108: search_v3.Places.CountriesEntry
+	nil,                                    // 109: search_v3.Places.MetroAreasEntry
+	nil,                                    // 110: search_v3.Places.AirportsToMetroEntry
+	nil,                                    // 111: search_v3.AirportInfo.NameEntry
+	nil,                                    // 112: search_v3.CityInfo.NameEntry
+	nil,                                    // 113: search_v3.CountryInfo.NameEntry
+	nil,                                    // 114: search_v3.AgentInfo.LabelEntry
+	nil,                                    // 115: search_v3.SearchParams.ExperimentsEntry
+	nil,                                    // 116: search_v3.DegradedBoundaries.AgentsEntry
+	nil,                                    // 117: search_v3.DegradedBoundaries.AirlinesEntry
+	nil,                                    // 118: search_v3.DegradedBoundaries.AlliancesEntry
+	nil,                                    // 119: search_v3.DegradedBoundaries.AirportsEntry
+	nil,                                    // 120: search_v3.DegradedBoundaries.SameDepartureArrivalAirportEntry
+	nil,                                    // 121: search_v3.DegradedBoundaries.EquipmentsEntry
+	nil,                                    // 122: search_v3.DegradedBoundaries.PaymentMethodsEntry
+	nil,                                    // 123: search_v3.DegradedBoundaries.DepartureArrivalTimeEntry
+	nil,                                    // 124: search_v3.DegradedBoundaries.TransfersCountEntry
+	nil,                                    // 125: search_v3.DegradedBoundaries.TransfersAirportsEntry
+	nil,                                    // 126: search_v3.DegradedBoundaries.TransfersCountriesEntry
+	nil,                                    // 127: search_v3.DegradedAirportsBoundaries.ArrivalEntry
+	nil,                                    // 128: search_v3.DegradedAirportsBoundaries.DepartureEntry
+	nil,                                    // 129: search_v3.DegradedTimeBoundaries.ArrivalDateEntry
+	nil,                                    // 130: search_v3.DateTimeRangeBoundaries.BucketsEntry
+	nil,                                    // 131: search_v3.RangeBoundaries.BucketsEntry
+	nil,                                    // 132: search_v3.Boundaries.AgentsEntry
+	nil,                                    // 133: search_v3.Boundaries.AirlinesEntry
+	nil,                                    // 134: search_v3.Boundaries.AlliancesEntry
+	nil,                                    // 135: search_v3.Boundaries.AirportsEntry
+	nil,                                    // 136: search_v3.Boundaries.SameDepartureArrivalAirportEntry
+	nil,                                    // 137: search_v3.Boundaries.EquipmentsEntry
+	nil,                                    // 138: search_v3.Boundaries.PaymentMethodsEntry
+	nil,                                    // 139: search_v3.Boundaries.DepartureArrivalTimeEntry
+	nil,                                    // 140: search_v3.Boundaries.TransfersCountEntry
+	nil,                                    // 141: search_v3.Boundaries.TransfersAirportsEntry
+	nil,                                    // 142: search_v3.Boundaries.TransfersCountriesEntry
+	nil,                                    // 143: search_v3.AirportsBoundaries.ArrivalEntry
+	nil,                                    // 144: search_v3.AirportsBoundaries.DepartureEntry
+	nil,                                    // 145: search_v3.TimeBoundaries.ArrivalDateEntry
+	nil,                                    // 146: search_v3.FilterState.SegmentsEntry
+	(*timestamppb.Timestamp)(nil),          // 147: google.protobuf.Timestamp
 }
 var file_protobuf_search_v3_results_proto_depIdxs = []int32{
 	8,   // 0: search_v3.SearchResults.chunks:type_name -> search_v3.Chunk
@@ -9827,196 +9791,196 @@ var file_protobuf_search_v3_results_proto_depIdxs = []int32{
 	36,  // 2: search_v3.Chunk.tickets:type_name -> search_v3.Ticket
 	45,  // 3: search_v3.Chunk.soft_tickets:type_name -> search_v3.SoftResponse
 	36,  // 4: search_v3.Chunk.brand_ticket:type_name -> search_v3.Ticket
-	89,  // 5: search_v3.Chunk.brand_tickets:type_name -> search_v3.Chunk.BrandTicketsEntry
+	88,  // 5: search_v3.Chunk.brand_tickets:type_name -> search_v3.Chunk.BrandTicketsEntry
 	36,  // 6: search_v3.Chunk.cheapest_ticket:type_name -> search_v3.Ticket
 	36,  // 7: search_v3.Chunk.filtered_cheapest_ticket:type_name -> search_v3.Ticket
 	36,  // 8: search_v3.Chunk.cheapest_ticket_without_airport_precheck:type_name -> search_v3.Ticket
 	46,  // 9: search_v3.Chunk.direct_flights:type_name -> search_v3.DirectFlights
 	49,  // 10: search_v3.Chunk.flight_legs:type_name -> search_v3.FlightLeg
-	90,  // 11: search_v3.Chunk.airlines:type_name -> search_v3.Chunk.AirlinesEntry
+	89,  // 11: search_v3.Chunk.airlines:type_name -> search_v3.Chunk.AirlinesEntry
 	52,  // 12: search_v3.Chunk.places:type_name -> search_v3.Places
-	91,  // 13: search_v3.Chunk.agents:type_name -> search_v3.Chunk.AgentsEntry
-	92,  // 14: search_v3.Chunk.alliances:type_name -> search_v3.Chunk.AlliancesEntry
-	93,  // 15: search_v3.Chunk.equipments:type_name -> search_v3.Chunk.EquipmentsEntry
-	62,  // 16: search_v3.Chunk.search_params:type_name -> search_v3.SearchParams
-	64,  // 17: search_v3.Chunk.degraded_filter_boundaries:type_name -> search_v3.DegradedBoundaries
-	76,  // 18: search_v3.Chunk.filter_boundaries:type_name -> search_v3.Boundaries
-	81,  // 19: search_v3.Chunk.meta:type_name -> search_v3.ResultsMeta
-	82,  // 20: search_v3.Chunk.filter_state:type_name -> search_v3.FilterState
+	90,  // 13: search_v3.Chunk.agents:type_name -> search_v3.Chunk.AgentsEntry
+	91,  // 14: search_v3.Chunk.alliances:type_name -> search_v3.Chunk.AlliancesEntry
+	92,  // 15: search_v3.Chunk.equipments:type_name -> search_v3.Chunk.EquipmentsEntry
+	61,  // 16: search_v3.Chunk.search_params:type_name -> search_v3.SearchParams
+	63,  // 17: search_v3.Chunk.degraded_filter_boundaries:type_name -> search_v3.DegradedBoundaries
+	75,  // 18: search_v3.Chunk.filter_boundaries:type_name -> search_v3.Boundaries
+	80,  // 19: search_v3.Chunk.meta:type_name -> search_v3.ResultsMeta
+	81,  // 20: search_v3.Chunk.filter_state:type_name -> search_v3.FilterState
 	3,   // 21: search_v3.Chunk.order:type_name -> search_v3.Order
 	4,   // 22: search_v3.Chunk.brand:type_name -> search_v3.Brand
-	94,  // 23: search_v3.DebugInfo.gates:type_name -> search_v3.DebugInfo.GatesEntry
-	95,  // 24: search_v3.GateDebugInfo.agents:type_name -> search_v3.GateDebugInfo.AgentsEntry
-	96,  // 25: search_v3.AgentDebugInfo.proposals:type_name -> search_v3.AgentDebugInfo.ProposalsEntry
-	97,  // 26: search_v3.AgentDebugInfo.bad_proposals:type_name -> search_v3.AgentDebugInfo.BadProposalsEntry
-	98,  // 27: search_v3.AgentDebugInfo.filtered_proposals:type_name -> search_v3.AgentDebugInfo.FilteredProposalsEntry
-	99,  // 28: search_v3.AgentDebugInfo.merged_flight_terms_sources:type_name -> search_v3.AgentDebugInfo.MergedFlightTermsSourcesEntry
-	13,  // 29: search_v3.Proposals.proposals:type_name -> search_v3.Proposal
-	31,  // 30: search_v3.Proposal.price:type_name -> search_v3.Amount
-	31,  // 31: search_v3.Proposal.price_per_person:type_name -> search_v3.Amount
-	100, // 32: search_v3.Proposal.flight_terms:type_name -> search_v3.Proposal.FlightTermsEntry
-	21,  // 33: search_v3.Proposal.transfer_terms:type_name -> search_v3.TransferTerms
-	31,  // 34: search_v3.Proposal.unified_price:type_name -> search_v3.Amount
-	19,  // 35: search_v3.Proposal.options:type_name -> search_v3.ProposalOptions
-	16,  // 36: search_v3.Proposal.minimum_fare:type_name -> search_v3.Fare
-	15,  // 37: search_v3.Proposal.cashback:type_name -> search_v3.Cashback
-	15,  // 38: search_v3.Proposal.cashback_per_person:type_name -> search_v3.Cashback
-	14,  // 39: search_v3.Proposal.accepted_cards:type_name -> search_v3.AcceptedCard
-	31,  // 40: search_v3.Cashback.localized_amount:type_name -> search_v3.Amount
-	17,  // 41: search_v3.Fare.baggage:type_name -> search_v3.Baggage
-	17,  // 42: search_v3.Fare.handbags:type_name -> search_v3.Baggage
-	18,  // 43: search_v3.Fare.return_before_flight:type_name -> search_v3.TariffInfo
-	18,  // 44: search_v3.Fare.return_after_flight:type_name -> search_v3.TariffInfo
-	18,  // 45: search_v3.Fare.change_before_flight:type_name -> search_v3.TariffInfo
-	18,  // 46: search_v3.Fare.change_after_flight:type_name -> search_v3.TariffInfo
-	18,  // 47: search_v3.Fare.seat_at_purchase:type_name -> search_v3.TariffInfo
-	18,  // 48: search_v3.Fare.seat_at_registration:type_name -> search_v3.TariffInfo
-	31,  // 49: search_v3.TariffInfo.penalty:type_name -> search_v3.Amount
-	20,  // 50: search_v3.ProposalOptions.hotel:type_name -> search_v3.Hotel
-	22,  // 51: search_v3.TransferTerms.terms:type_name -> search_v3.TransferTerm
-	6,   // 52: search_v3.FlightTerm.trip_class:type_name -> search_v3.TripClass
-	30,  // 53: search_v3.FlightTerm.marketing_carrier_designator:type_name -> search_v3.FlightDesignator
-	17,  // 54: search_v3.FlightTerm.baggage:type_name -> search_v3.Baggage
-	17,  // 55: search_v3.FlightTerm.handbags:type_name -> search_v3.Baggage
-	29,  // 56: search_v3.FlightTerm.additional_tariff_info:type_name -> search_v3.AdditionalTariffInfo
-	24,  // 57: search_v3.FlightTerm.merged_terms_info:type_name -> search_v3.MergedTermsInfo
-	101, // 58: search_v3.FlightTerm.merged_from_other_proposals:type_name -> search_v3.FlightTerm.MergedFromOtherProposalsEntry
-	25,  // 59: search_v3.MergedTermsInfo.seat_at_registration:type_name -> search_v3.TariffMergeInfo
-	25,  // 60: search_v3.MergedTermsInfo.seat_at_purchase:type_name -> search_v3.TariffMergeInfo
-	25,  // 61: search_v3.MergedTermsInfo.return_before_flight:type_name -> search_v3.TariffMergeInfo
-	25,  // 62: search_v3.MergedTermsInfo.return_after_flight:type_name -> search_v3.TariffMergeInfo
-	25,  // 63: search_v3.MergedTermsInfo.change_before_flight:type_name -> search_v3.TariffMergeInfo
-	25,  // 64: search_v3.MergedTermsInfo.change_after_flight:type_name -> search_v3.TariffMergeInfo
-	27,  // 65: search_v3.MergedTermsInfo.baggage:type_name -> search_v3.BaggageMergeInfo
-	27,  // 66: search_v3.MergedTermsInfo.handbags:type_name -> search_v3.BaggageMergeInfo
-	26,  // 67: search_v3.TariffMergeInfo.is_from_config:type_name -> search_v3.TariffMergeParams
-	26,  // 68: search_v3.TariffMergeInfo.mismatch:type_name -> search_v3.TariffMergeParams
-	28,  // 69: search_v3.BaggageMergeInfo.is_from_config:type_name -> search_v3.BaggageMergeParams
-	28,  // 70: search_v3.BaggageMergeInfo.mismatch:type_name -> search_v3.BaggageMergeParams
-	18,  // 71: search_v3.AdditionalTariffInfo.seat_at_purchase_info:type_name -> search_v3.TariffInfo
-	18,  // 72: search_v3.AdditionalTariffInfo.seat_at_registration_info:type_name -> search_v3.TariffInfo
-	18,  // 73: search_v3.AdditionalTariffInfo.return_before_flight:type_name -> search_v3.TariffInfo
-	18,  // 74: search_v3.AdditionalTariffInfo.return_after_flight:type_name -> search_v3.TariffInfo
-	18,  // 75: search_v3.AdditionalTariffInfo.change_before_flight:type_name -> search_v3.TariffInfo
-	18,  // 76: search_v3.AdditionalTariffInfo.change_after_flight:type_name -> search_v3.TariffInfo
-	5,   // 77: search_v3.Amount.currency_code:type_name -> search_v3.Currency
-	31,  // 78: search_v3.ProposalDebugInfo.agency_price:type_name -> search_v3.Amount
-	102, // 79: search_v3.ProposalDebugInfo.flight_terms:type_name -> search_v3.ProposalDebugInfo.FlightTermsEntry
-	35,  // 80: search_v3.ProposalDebugInfo.cashback:type_name -> search_v3.CashbackDebugInfo
-	0,   // 81: search_v3.FlightTermDebugInfo.baggage_source:type_name -> search_v3.TermSource
-	0,   // 82: search_v3.FlightTermDebugInfo.handbags_source:type_name -> search_v3.TermSource
-	34,  // 83: search_v3.FlightTermDebugInfo.gate_technical_stops:type_name -> search_v3.TechnicalStop
-	31,  // 84: search_v3.CashbackDebugInfo.amount:type_name -> search_v3.Amount
-	31,  // 85: search_v3.CashbackDebugInfo.localized_amount:type_name -> search_v3.Amount
-	42,  // 86: search_v3.Ticket.segments:type_name -> search_v3.Segment
-	13,  // 87: search_v3.Ticket.proposals:type_name -> search_v3.Proposal
-	39,  // 88: search_v3.Ticket.badges:type_name -> search_v3.BadgeInfo
-	103, // 89: search_v3.Ticket.extra_fares:type_name -> search_v3.Ticket.ExtraFaresEntry
-	38,  // 90: search_v3.FareProposals.proposals:type_name -> search_v3.FareProposal
-	40,  // 91: search_v3.BadgeInfo.meta:type_name -> search_v3.BadgeInfoMeta
-	104, // 92: search_v3.BadgeInfoMeta.name:type_name -> search_v3.BadgeInfoMeta.NameEntry
-	41,  // 93: search_v3.BadgeInfoMeta.colors:type_name -> search_v3.Colors
-	43,  // 94: search_v3.Segment.transfers:type_name -> search_v3.Transfer
-	44,  // 95: search_v3.Transfer.visa_rules:type_name -> search_v3.VisaRules
-	36,  // 96: search_v3.SoftResponse.tickets:type_name -> search_v3.Ticket
-	36,  // 97: search_v3.DirectFlights.cheapest_ticket:type_name -> search_v3.Ticket
-	47,  // 98: search_v3.DirectFlights.schedule:type_name -> search_v3.ScheduleList
-	48,  // 99: search_v3.ScheduleList.list:type_name -> search_v3.Schedule
-	30,  // 100: search_v3.FlightLeg.operating_carrier_designator:type_name -> search_v3.FlightDesignator
-	61,  // 101: search_v3.FlightLeg.equipment:type_name -> search_v3.Equipment
-	34,  // 102: search_v3.FlightLeg.technical_stops:type_name -> search_v3.TechnicalStop
-	105, // 103: search_v3.AirlineInfo.name:type_name -> search_v3.AirlineInfo.NameEntry
-	106, // 104: search_v3.MapStringString.map:type_name -> search_v3.MapStringString.MapEntry
-	107, // 105: search_v3.Places.airports:type_name -> search_v3.Places.AirportsEntry
-	108, // 106: search_v3.Places.cities:type_name -> search_v3.Places.CitiesEntry
-	109, // 107: search_v3.Places.countries:type_name -> search_v3.Places.CountriesEntry
-	110, // 108: search_v3.Places.metro_areas:type_name -> search_v3.Places.MetroAreasEntry
-	111, // 109: search_v3.Places.airports_to_metro:type_name -> search_v3.Places.AirportsToMetroEntry
-	112, // 110: search_v3.AirportInfo.name:type_name -> search_v3.AirportInfo.NameEntry
-	54,  // 111: search_v3.AirportInfo.coordinates:type_name -> search_v3.GeoPoint
-	55,  // 112: search_v3.AirportInfo.has_transit_zone:type_name -> search_v3.OptBool
-	113, // 113: search_v3.CityInfo.name:type_name -> search_v3.CityInfo.NameEntry
-	114, // 114: search_v3.CountryInfo.name:type_name -> search_v3.CountryInfo.NameEntry
-	115, // 115: search_v3.AgentInfo.label:type_name -> search_v3.AgentInfo.LabelEntry
+	93,  // 23: search_v3.DebugInfo.gates:type_name -> search_v3.DebugInfo.GatesEntry
+	147, // 24: search_v3.DebugInfo.search_start_time:type_name -> google.protobuf.Timestamp
+	94,  // 25: search_v3.GateDebugInfo.agents:type_name -> search_v3.GateDebugInfo.AgentsEntry
+	95,  // 26: search_v3.AgentDebugInfo.proposals:type_name -> search_v3.AgentDebugInfo.ProposalsEntry
+	96,  // 27: search_v3.AgentDebugInfo.bad_proposals:type_name -> search_v3.AgentDebugInfo.BadProposalsEntry
+	97,  // 28: search_v3.AgentDebugInfo.filtered_proposals:type_name -> search_v3.AgentDebugInfo.FilteredProposalsEntry
+	98,  // 29: search_v3.AgentDebugInfo.merged_flight_terms_sources:type_name -> search_v3.AgentDebugInfo.MergedFlightTermsSourcesEntry
+	13,  // 30: search_v3.Proposals.proposals:type_name -> search_v3.Proposal
+	31,  // 31: search_v3.Proposal.price:type_name -> search_v3.Amount
+	31,  // 32: search_v3.Proposal.price_per_person:type_name -> search_v3.Amount
+	99,  // 33: search_v3.Proposal.flight_terms:type_name -> search_v3.Proposal.FlightTermsEntry
+	21,  // 34: search_v3.Proposal.transfer_terms:type_name -> search_v3.TransferTerms
+	31,  // 35: search_v3.Proposal.unified_price:type_name -> search_v3.Amount
+	19,  // 36: search_v3.Proposal.options:type_name -> search_v3.ProposalOptions
+	16,  // 37: search_v3.Proposal.minimum_fare:type_name -> search_v3.Fare
+	15,  // 38: search_v3.Proposal.cashback:type_name -> search_v3.Cashback
+	15,  // 39: search_v3.Proposal.cashback_per_person:type_name -> search_v3.Cashback
+	14,  // 40: search_v3.Proposal.accepted_cards:type_name -> search_v3.AcceptedCard
+	31,  // 41: search_v3.Cashback.localized_amount:type_name -> search_v3.Amount
+	17,  // 42: search_v3.Fare.baggage:type_name -> search_v3.Baggage
+	17,  // 43: search_v3.Fare.handbags:type_name -> search_v3.Baggage
+	18,  // 44: search_v3.Fare.return_before_flight:type_name -> search_v3.TariffInfo
+	18,  // 45: search_v3.Fare.return_after_flight:type_name -> search_v3.TariffInfo
+	18,  // 46: search_v3.Fare.change_before_flight:type_name -> search_v3.TariffInfo
+	18,  // 47: search_v3.Fare.change_after_flight:type_name -> search_v3.TariffInfo
+	18,  // 48: search_v3.Fare.seat_at_purchase:type_name -> search_v3.TariffInfo
+	18,  // 49: search_v3.Fare.seat_at_registration:type_name -> search_v3.TariffInfo
+	31,  // 50: search_v3.TariffInfo.penalty:type_name -> search_v3.Amount
+	20,  // 51: search_v3.ProposalOptions.hotel:type_name -> search_v3.Hotel
+	22,  // 52: search_v3.TransferTerms.terms:type_name -> search_v3.TransferTerm
+	6,   // 53: search_v3.FlightTerm.trip_class:type_name -> search_v3.TripClass
+	30,  // 54: search_v3.FlightTerm.marketing_carrier_designator:type_name -> search_v3.FlightDesignator
+	17,  // 55: search_v3.FlightTerm.baggage:type_name -> search_v3.Baggage
+	17,  // 56: search_v3.FlightTerm.handbags:type_name -> search_v3.Baggage
+	29,  // 57: search_v3.FlightTerm.additional_tariff_info:type_name -> search_v3.AdditionalTariffInfo
+	24,  // 58: search_v3.FlightTerm.merged_terms_info:type_name -> search_v3.MergedTermsInfo
+	100, // 59: search_v3.FlightTerm.merged_from_other_proposals:type_name -> search_v3.FlightTerm.MergedFromOtherProposalsEntry
+	25,  // 60: search_v3.MergedTermsInfo.seat_at_registration:type_name -> search_v3.TariffMergeInfo
+	25,  // 61: search_v3.MergedTermsInfo.seat_at_purchase:type_name -> search_v3.TariffMergeInfo
+	25,  // 62: search_v3.MergedTermsInfo.return_before_flight:type_name -> search_v3.TariffMergeInfo
+	25,  // 63: search_v3.MergedTermsInfo.return_after_flight:type_name -> search_v3.TariffMergeInfo
+	25,  // 64: search_v3.MergedTermsInfo.change_before_flight:type_name -> search_v3.TariffMergeInfo
+	25,  // 65: search_v3.MergedTermsInfo.change_after_flight:type_name -> search_v3.TariffMergeInfo
+	27,  // 66: search_v3.MergedTermsInfo.baggage:type_name -> search_v3.BaggageMergeInfo
+	27,  // 67: search_v3.MergedTermsInfo.handbags:type_name -> search_v3.BaggageMergeInfo
+	26,  // 68: search_v3.TariffMergeInfo.is_from_config:type_name -> search_v3.TariffMergeParams
+	26,  // 69: search_v3.TariffMergeInfo.mismatch:type_name -> search_v3.TariffMergeParams
+	28,  // 70: search_v3.BaggageMergeInfo.is_from_config:type_name -> search_v3.BaggageMergeParams
+	28,  // 71: search_v3.BaggageMergeInfo.mismatch:type_name -> search_v3.BaggageMergeParams
+	18,  // 72: search_v3.AdditionalTariffInfo.seat_at_purchase_info:type_name -> search_v3.TariffInfo
+	18,  // 73: search_v3.AdditionalTariffInfo.seat_at_registration_info:type_name -> search_v3.TariffInfo
+	18,  // 74: search_v3.AdditionalTariffInfo.return_before_flight:type_name -> search_v3.TariffInfo
+	18,  // 75: search_v3.AdditionalTariffInfo.return_after_flight:type_name -> search_v3.TariffInfo
+	18,  // 76: search_v3.AdditionalTariffInfo.change_before_flight:type_name -> search_v3.TariffInfo
+	18,  // 77: search_v3.AdditionalTariffInfo.change_after_flight:type_name -> search_v3.TariffInfo
+	5,   // 78: search_v3.Amount.currency_code:type_name -> search_v3.Currency
+	31,  // 79: search_v3.ProposalDebugInfo.agency_price:type_name -> search_v3.Amount
+	101, // 80: search_v3.ProposalDebugInfo.flight_terms:type_name -> search_v3.ProposalDebugInfo.FlightTermsEntry
+	35,  // 81: search_v3.ProposalDebugInfo.cashback:type_name -> search_v3.CashbackDebugInfo
+	0,   // 82: search_v3.FlightTermDebugInfo.baggage_source:type_name -> search_v3.TermSource
+	0,   // 83: search_v3.FlightTermDebugInfo.handbags_source:type_name -> search_v3.TermSource
+	34,  // 84: search_v3.FlightTermDebugInfo.gate_technical_stops:type_name -> search_v3.TechnicalStop
+	31,  // 85: search_v3.CashbackDebugInfo.amount:type_name -> search_v3.Amount
+	31,  // 86: search_v3.CashbackDebugInfo.localized_amount:type_name -> search_v3.Amount
+	42,  // 87: search_v3.Ticket.segments:type_name -> search_v3.Segment
+	13,  // 88: search_v3.Ticket.proposals:type_name -> search_v3.Proposal
+	39,  // 89: search_v3.Ticket.badges:type_name -> search_v3.BadgeInfo
+	102, // 90: search_v3.Ticket.extra_fares:type_name -> search_v3.Ticket.ExtraFaresEntry
+	38,  // 91: search_v3.FareProposals.proposals:type_name -> search_v3.FareProposal
+	40,  // 92: search_v3.BadgeInfo.meta:type_name -> search_v3.BadgeInfoMeta
+	103, // 93: search_v3.BadgeInfoMeta.name:type_name -> search_v3.BadgeInfoMeta.NameEntry
+	41,  // 94: search_v3.BadgeInfoMeta.colors:type_name -> search_v3.Colors
+	43,  // 95: search_v3.Segment.transfers:type_name -> search_v3.Transfer
+	44,  // 96: search_v3.Transfer.visa_rules:type_name -> search_v3.VisaRules
+	36,  // 97: search_v3.SoftResponse.tickets:type_name -> search_v3.Ticket
+	36,  // 98: search_v3.DirectFlights.cheapest_ticket:type_name -> search_v3.Ticket
+	47,  // 99: search_v3.DirectFlights.schedule:type_name -> search_v3.ScheduleList
+	48,  // 100: search_v3.ScheduleList.list:type_name -> search_v3.Schedule
+	30,  // 101: search_v3.FlightLeg.operating_carrier_designator:type_name -> search_v3.FlightDesignator
+	60,  // 102: search_v3.FlightLeg.equipment:type_name -> search_v3.Equipment
+	34,  // 103: search_v3.FlightLeg.technical_stops:type_name -> search_v3.TechnicalStop
+	104, // 104: search_v3.AirlineInfo.name:type_name -> search_v3.AirlineInfo.NameEntry
+	105, // 105: search_v3.MapStringString.map:type_name -> search_v3.MapStringString.MapEntry
+	106, // 106: search_v3.Places.airports:type_name -> search_v3.Places.AirportsEntry
+	107, // 107: search_v3.Places.cities:type_name -> search_v3.Places.CitiesEntry
+	108, // 108: search_v3.Places.countries:type_name -> search_v3.Places.CountriesEntry
+	109, // 109: search_v3.Places.metro_areas:type_name -> search_v3.Places.MetroAreasEntry
+	110, // 110: search_v3.Places.airports_to_metro:type_name -> search_v3.Places.AirportsToMetroEntry
+	111, // 111: search_v3.AirportInfo.name:type_name -> search_v3.AirportInfo.NameEntry
+	54,  // 112: search_v3.AirportInfo.coordinates:type_name -> search_v3.GeoPoint
+	112, // 113: search_v3.CityInfo.name:type_name -> search_v3.CityInfo.NameEntry
+	113, // 114: search_v3.CountryInfo.name:type_name -> search_v3.CountryInfo.NameEntry
+	114, // 115: search_v3.AgentInfo.label:type_name -> search_v3.AgentInfo.LabelEntry
 	1,   // 116: search_v3.Equipment.type:type_name -> search_v3.EquipmentType
-	63,  // 117: search_v3.SearchParams.passengers:type_name -> search_v3.Passengers
+	62,  // 117: search_v3.SearchParams.passengers:type_name -> search_v3.Passengers
 	6,   // 118: search_v3.SearchParams.trip_class:type_name -> search_v3.TripClass
 	2,   // 119: search_v3.SearchParams.source_kind:type_name -> search_v3.SourceKind
-	116, // 120: search_v3.SearchParams.experiments:type_name -> search_v3.SearchParams.ExperimentsEntry
-	117, // 121: search_v3.DegradedBoundaries.agents:type_name -> search_v3.DegradedBoundaries.AgentsEntry
-	118, // 122: search_v3.DegradedBoundaries.airlines:type_name -> search_v3.DegradedBoundaries.AirlinesEntry
-	119, // 123: search_v3.DegradedBoundaries.alliances:type_name -> search_v3.DegradedBoundaries.AlliancesEntry
-	66,  // 124: search_v3.DegradedBoundaries.has_interlines:type_name -> search_v3.FilterBool
-	66,  // 125: search_v3.DegradedBoundaries.has_lowcosts:type_name -> search_v3.FilterBool
-	120, // 126: search_v3.DegradedBoundaries.airports:type_name -> search_v3.DegradedBoundaries.AirportsEntry
-	121, // 127: search_v3.DegradedBoundaries.same_departure_arrival_airport:type_name -> search_v3.DegradedBoundaries.SameDepartureArrivalAirportEntry
-	68,  // 128: search_v3.DegradedBoundaries.baggage:type_name -> search_v3.FilterBaggageBoundaries
-	122, // 129: search_v3.DegradedBoundaries.equipments:type_name -> search_v3.DegradedBoundaries.EquipmentsEntry
-	123, // 130: search_v3.DegradedBoundaries.payment_methods:type_name -> search_v3.DegradedBoundaries.PaymentMethodsEntry
-	70,  // 131: search_v3.DegradedBoundaries.price:type_name -> search_v3.PriceBoundaries
-	124, // 132: search_v3.DegradedBoundaries.departure_arrival_time:type_name -> search_v3.DegradedBoundaries.DepartureArrivalTimeEntry
-	74,  // 133: search_v3.DegradedBoundaries.return_ticket:type_name -> search_v3.DegradedReturnTicketBoundaries
-	74,  // 134: search_v3.DegradedBoundaries.change_ticket:type_name -> search_v3.DegradedReturnTicketBoundaries
-	125, // 135: search_v3.DegradedBoundaries.transfers_count:type_name -> search_v3.DegradedBoundaries.TransfersCountEntry
-	75,  // 136: search_v3.DegradedBoundaries.transfers_duration:type_name -> search_v3.TransferDurationBoundaries
-	126, // 137: search_v3.DegradedBoundaries.transfers_airports:type_name -> search_v3.DegradedBoundaries.TransfersAirportsEntry
-	127, // 138: search_v3.DegradedBoundaries.transfers_countries:type_name -> search_v3.DegradedBoundaries.TransfersCountriesEntry
-	66,  // 139: search_v3.DegradedBoundaries.has_transfers_with_airport_change:type_name -> search_v3.FilterBool
-	66,  // 140: search_v3.DegradedBoundaries.has_transfers_with_baggage_recheck:type_name -> search_v3.FilterBool
-	66,  // 141: search_v3.DegradedBoundaries.has_transfers_with_visa:type_name -> search_v3.FilterBool
-	66,  // 142: search_v3.DegradedBoundaries.has_transfers_with_virtual_interline:type_name -> search_v3.FilterBool
-	66,  // 143: search_v3.DegradedBoundaries.has_covid_restrictions:type_name -> search_v3.FilterBool
-	66,  // 144: search_v3.DegradedBoundaries.has_night_transfers:type_name -> search_v3.FilterBool
-	66,  // 145: search_v3.DegradedBoundaries.has_convenient_transfers:type_name -> search_v3.FilterBool
-	66,  // 146: search_v3.DegradedBoundaries.has_short_layover_transfers:type_name -> search_v3.FilterBool
-	66,  // 147: search_v3.DegradedBoundaries.has_long_layover_transfers:type_name -> search_v3.FilterBool
-	128, // 148: search_v3.DegradedAirportsBoundaries.arrival:type_name -> search_v3.DegradedAirportsBoundaries.ArrivalEntry
-	129, // 149: search_v3.DegradedAirportsBoundaries.departure:type_name -> search_v3.DegradedAirportsBoundaries.DepartureEntry
-	65,  // 150: search_v3.FilterBaggageBoundaries.full_baggage:type_name -> search_v3.FilterPrice
-	65,  // 151: search_v3.FilterBaggageBoundaries.no_baggage:type_name -> search_v3.FilterPrice
-	65,  // 152: search_v3.FilterBaggageBoundaries.large_handbag:type_name -> search_v3.FilterPrice
-	130, // 153: search_v3.DegradedTimeBoundaries.arrival_date:type_name -> search_v3.DegradedTimeBoundaries.ArrivalDateEntry
-	72,  // 154: search_v3.DegradedTimeBoundaries.arrival_time:type_name -> search_v3.DateTimeRangeBoundaries
-	72,  // 155: search_v3.DegradedTimeBoundaries.departure_time:type_name -> search_v3.DateTimeRangeBoundaries
-	73,  // 156: search_v3.DegradedTimeBoundaries.trip_duration:type_name -> search_v3.RangeBoundaries
-	131, // 157: search_v3.DateTimeRangeBoundaries.buckets:type_name -> search_v3.DateTimeRangeBoundaries.BucketsEntry
-	132, // 158: search_v3.RangeBoundaries.buckets:type_name -> search_v3.RangeBoundaries.BucketsEntry
-	65,  // 159: search_v3.DegradedReturnTicketBoundaries.available:type_name -> search_v3.FilterPrice
-	65,  // 160: search_v3.DegradedReturnTicketBoundaries.free:type_name -> search_v3.FilterPrice
-	133, // 161: search_v3.Boundaries.agents:type_name -> search_v3.Boundaries.AgentsEntry
-	134, // 162: search_v3.Boundaries.airlines:type_name -> search_v3.Boundaries.AirlinesEntry
-	135, // 163: search_v3.Boundaries.alliances:type_name -> search_v3.Boundaries.AlliancesEntry
-	136, // 164: search_v3.Boundaries.airports:type_name -> search_v3.Boundaries.AirportsEntry
-	137, // 165: search_v3.Boundaries.same_departure_arrival_airport:type_name -> search_v3.Boundaries.SameDepartureArrivalAirportEntry
-	69,  // 166: search_v3.Boundaries.baggage:type_name -> search_v3.BaggageBoundaries
-	138, // 167: search_v3.Boundaries.equipments:type_name -> search_v3.Boundaries.EquipmentsEntry
-	139, // 168: search_v3.Boundaries.payment_methods:type_name -> search_v3.Boundaries.PaymentMethodsEntry
-	70,  // 169: search_v3.Boundaries.price:type_name -> search_v3.PriceBoundaries
-	140, // 170: search_v3.Boundaries.departure_arrival_time:type_name -> search_v3.Boundaries.DepartureArrivalTimeEntry
-	77,  // 171: search_v3.Boundaries.return_ticket:type_name -> search_v3.ReturnBoundaries
-	78,  // 172: search_v3.Boundaries.change_ticket:type_name -> search_v3.ChangeBoundaries
-	141, // 173: search_v3.Boundaries.transfers_count:type_name -> search_v3.Boundaries.TransfersCountEntry
-	75,  // 174: search_v3.Boundaries.transfers_duration:type_name -> search_v3.TransferDurationBoundaries
-	142, // 175: search_v3.Boundaries.transfers_airports:type_name -> search_v3.Boundaries.TransfersAirportsEntry
-	143, // 176: search_v3.Boundaries.transfers_countries:type_name -> search_v3.Boundaries.TransfersCountriesEntry
-	144, // 177: search_v3.AirportsBoundaries.arrival:type_name -> search_v3.AirportsBoundaries.ArrivalEntry
-	145, // 178: search_v3.AirportsBoundaries.departure:type_name -> search_v3.AirportsBoundaries.DepartureEntry
-	146, // 179: search_v3.TimeBoundaries.arrival_date:type_name -> search_v3.TimeBoundaries.ArrivalDateEntry
-	72,  // 180: search_v3.TimeBoundaries.arrival_time:type_name -> search_v3.DateTimeRangeBoundaries
-	72,  // 181: search_v3.TimeBoundaries.departure_time:type_name -> search_v3.DateTimeRangeBoundaries
-	73,  // 182: search_v3.TimeBoundaries.trip_duration:type_name -> search_v3.RangeBoundaries
-	147, // 183: search_v3.FilterState.segments:type_name -> search_v3.FilterState.SegmentsEntry
-	88,  // 184: search_v3.FilterState.price:type_name -> search_v3.FloatRange
-	87,  // 185: search_v3.FilterState.transfers_duration:type_name -> search_v3.Range
-	83,  // 186: search_v3.FilterState.time_buckets:type_name -> search_v3.TimeBuckets
-	85,  // 187: search_v3.SegmentFilter.arrival_time:type_name -> search_v3.DateTimeOrTimeRange
-	86,  // 188: search_v3.SegmentFilter.departure_time:type_name -> search_v3.DateTimeRange
-	87,  // 189: search_v3.SegmentFilter.trip_duration:type_name -> search_v3.Range
+	115, // 120: search_v3.SearchParams.experiments:type_name -> search_v3.SearchParams.ExperimentsEntry
+	116, // 121: search_v3.DegradedBoundaries.agents:type_name -> search_v3.DegradedBoundaries.AgentsEntry
+	117, // 122: search_v3.DegradedBoundaries.airlines:type_name -> search_v3.DegradedBoundaries.AirlinesEntry
+	118, // 123: search_v3.DegradedBoundaries.alliances:type_name -> search_v3.DegradedBoundaries.AlliancesEntry
+	65,  // 124: search_v3.DegradedBoundaries.has_interlines:type_name -> search_v3.FilterBool
+	65,  // 125: search_v3.DegradedBoundaries.has_lowcosts:type_name -> search_v3.FilterBool
+	119, // 126: search_v3.DegradedBoundaries.airports:type_name -> search_v3.DegradedBoundaries.AirportsEntry
+	120, // 127: search_v3.DegradedBoundaries.same_departure_arrival_airport:type_name -> search_v3.DegradedBoundaries.SameDepartureArrivalAirportEntry
+	67,  // 128: search_v3.DegradedBoundaries.baggage:type_name -> search_v3.FilterBaggageBoundaries
+	121, // 129: search_v3.DegradedBoundaries.equipments:type_name -> search_v3.DegradedBoundaries.EquipmentsEntry
+	122, // 130: search_v3.DegradedBoundaries.payment_methods:type_name -> search_v3.DegradedBoundaries.PaymentMethodsEntry
+	69,  // 131: search_v3.DegradedBoundaries.price:type_name -> search_v3.PriceBoundaries
+	123, // 132: search_v3.DegradedBoundaries.departure_arrival_time:type_name -> search_v3.DegradedBoundaries.DepartureArrivalTimeEntry
+	73,  // 133: search_v3.DegradedBoundaries.return_ticket:type_name -> search_v3.DegradedReturnTicketBoundaries
+	73,  // 134: search_v3.DegradedBoundaries.change_ticket:type_name -> search_v3.DegradedReturnTicketBoundaries
+	124, // 135: search_v3.DegradedBoundaries.transfers_count:type_name -> search_v3.DegradedBoundaries.TransfersCountEntry
+	74,  // 136: search_v3.DegradedBoundaries.transfers_duration:type_name -> search_v3.TransferDurationBoundaries
+	125, // 137: search_v3.DegradedBoundaries.transfers_airports:type_name -> search_v3.DegradedBoundaries.TransfersAirportsEntry
+	126, // 138: search_v3.DegradedBoundaries.transfers_countries:type_name -> search_v3.DegradedBoundaries.TransfersCountriesEntry
+	65,  // 139: search_v3.DegradedBoundaries.has_transfers_with_airport_change:type_name -> search_v3.FilterBool
+	65,  // 140: search_v3.DegradedBoundaries.has_transfers_with_baggage_recheck:type_name -> search_v3.FilterBool
+	65,  // 141: search_v3.DegradedBoundaries.has_transfers_with_visa:type_name -> search_v3.FilterBool
+	65,  // 142: search_v3.DegradedBoundaries.has_transfers_with_virtual_interline:type_name -> search_v3.FilterBool
+	65,  // 143: search_v3.DegradedBoundaries.has_covid_restrictions:type_name -> search_v3.FilterBool
+	65,  // 144: search_v3.DegradedBoundaries.has_night_transfers:type_name -> search_v3.FilterBool
+	65,  // 145: search_v3.DegradedBoundaries.has_convenient_transfers:type_name -> search_v3.FilterBool
+	65,  // 146: search_v3.DegradedBoundaries.has_short_layover_transfers:type_name -> search_v3.FilterBool
+	65,  // 147: search_v3.DegradedBoundaries.has_long_layover_transfers:type_name -> search_v3.FilterBool
+	127, // 148: search_v3.DegradedAirportsBoundaries.arrival:type_name -> search_v3.DegradedAirportsBoundaries.ArrivalEntry
+	128, // 149: search_v3.DegradedAirportsBoundaries.departure:type_name -> search_v3.DegradedAirportsBoundaries.DepartureEntry
+	64,  // 150: search_v3.FilterBaggageBoundaries.full_baggage:type_name -> search_v3.FilterPrice
+	64,  // 151: search_v3.FilterBaggageBoundaries.no_baggage:type_name -> search_v3.FilterPrice
+	64,  // 152: search_v3.FilterBaggageBoundaries.large_handbag:type_name -> search_v3.FilterPrice
+	129, // 153: search_v3.DegradedTimeBoundaries.arrival_date:type_name -> search_v3.DegradedTimeBoundaries.ArrivalDateEntry
+	71,  // 154: search_v3.DegradedTimeBoundaries.arrival_time:type_name -> search_v3.DateTimeRangeBoundaries
+	71,  // 155: search_v3.DegradedTimeBoundaries.departure_time:type_name -> search_v3.DateTimeRangeBoundaries
+	72,  // 156: search_v3.DegradedTimeBoundaries.trip_duration:type_name -> search_v3.RangeBoundaries
+	130, // 157: search_v3.DateTimeRangeBoundaries.buckets:type_name -> search_v3.DateTimeRangeBoundaries.BucketsEntry
+	131, // 158: search_v3.RangeBoundaries.buckets:type_name -> search_v3.RangeBoundaries.BucketsEntry
+	64,  // 159: search_v3.DegradedReturnTicketBoundaries.available:type_name -> search_v3.FilterPrice
+	64,  // 160: search_v3.DegradedReturnTicketBoundaries.free:type_name -> search_v3.FilterPrice
+	132, // 161: search_v3.Boundaries.agents:type_name -> search_v3.Boundaries.AgentsEntry
+	133, // 162: search_v3.Boundaries.airlines:type_name -> search_v3.Boundaries.AirlinesEntry
+	134, // 163: search_v3.Boundaries.alliances:type_name -> search_v3.Boundaries.AlliancesEntry
+	135, // 164: search_v3.Boundaries.airports:type_name -> search_v3.Boundaries.AirportsEntry
+	136, // 165: search_v3.Boundaries.same_departure_arrival_airport:type_name -> search_v3.Boundaries.SameDepartureArrivalAirportEntry
+	68,  // 166: search_v3.Boundaries.baggage:type_name -> search_v3.BaggageBoundaries
+	137, // 167: search_v3.Boundaries.equipments:type_name -> search_v3.Boundaries.EquipmentsEntry
+	138, // 168: search_v3.Boundaries.payment_methods:type_name -> search_v3.Boundaries.PaymentMethodsEntry
+	69,  // 169: search_v3.Boundaries.price:type_name -> search_v3.PriceBoundaries
+	139, // 170: search_v3.Boundaries.departure_arrival_time:type_name -> search_v3.Boundaries.DepartureArrivalTimeEntry
+	76,  // 171: search_v3.Boundaries.return_ticket:type_name -> search_v3.ReturnBoundaries
+	77,  // 172: search_v3.Boundaries.change_ticket:type_name -> search_v3.ChangeBoundaries
+	140, // 173: search_v3.Boundaries.transfers_count:type_name -> search_v3.Boundaries.TransfersCountEntry
+	74,  // 174: search_v3.Boundaries.transfers_duration:type_name -> search_v3.TransferDurationBoundaries
+	141, // 175: search_v3.Boundaries.transfers_airports:type_name -> search_v3.Boundaries.TransfersAirportsEntry
+	142, // 176: search_v3.Boundaries.transfers_countries:type_name -> search_v3.Boundaries.TransfersCountriesEntry
+	143, // 177: search_v3.AirportsBoundaries.arrival:type_name -> search_v3.AirportsBoundaries.ArrivalEntry
+	144, // 178: search_v3.AirportsBoundaries.departure:type_name -> search_v3.AirportsBoundaries.DepartureEntry
+	145, // 179: search_v3.TimeBoundaries.arrival_date:type_name -> search_v3.TimeBoundaries.ArrivalDateEntry
+	71,  // 180: search_v3.TimeBoundaries.arrival_time:type_name -> search_v3.DateTimeRangeBoundaries
+	71,  // 181: search_v3.TimeBoundaries.departure_time:type_name -> search_v3.DateTimeRangeBoundaries
+	72,  // 182: search_v3.TimeBoundaries.trip_duration:type_name -> search_v3.RangeBoundaries
+	146, // 183: search_v3.FilterState.segments:type_name -> search_v3.FilterState.SegmentsEntry
+	87,  // 184: search_v3.FilterState.price:type_name -> search_v3.FloatRange
+	86,  // 185: search_v3.FilterState.transfers_duration:type_name -> search_v3.Range
+	82,  // 186: search_v3.FilterState.time_buckets:type_name -> search_v3.TimeBuckets
+	84,  // 187: search_v3.SegmentFilter.arrival_time:type_name -> search_v3.DateTimeOrTimeRange
+	85,  // 188: search_v3.SegmentFilter.departure_time:type_name -> search_v3.DateTimeRange
+	86,  // 189: search_v3.SegmentFilter.trip_duration:type_name -> search_v3.Range
 	36,  // 190: search_v3.Chunk.BrandTicketsEntry.value:type_name -> search_v3.Ticket
 	50,  // 191: search_v3.Chunk.AirlinesEntry.value:type_name -> search_v3.AirlineInfo
-	59,  // 192: search_v3.Chunk.AgentsEntry.value:type_name -> search_v3.AgentInfo
-	60,  // 193: search_v3.Chunk.AlliancesEntry.value:type_name -> search_v3.Alliance
-	61,  // 194: search_v3.Chunk.EquipmentsEntry.value:type_name -> search_v3.Equipment
+	58,  // 192: search_v3.Chunk.AgentsEntry.value:type_name -> search_v3.AgentInfo
+	59,  // 193: search_v3.Chunk.AlliancesEntry.value:type_name -> search_v3.Alliance
+	60,  // 194: search_v3.Chunk.EquipmentsEntry.value:type_name -> search_v3.Equipment
 	10,  // 195: search_v3.DebugInfo.GatesEntry.value:type_name -> search_v3.GateDebugInfo
 	11,  // 196: search_v3.GateDebugInfo.AgentsEntry.value:type_name -> search_v3.AgentDebugInfo
 	32,  // 197: search_v3.AgentDebugInfo.ProposalsEntry.value:type_name -> search_v3.ProposalDebugInfo
@@ -10026,30 +9990,30 @@ var file_protobuf_search_v3_results_proto_depIdxs = []int32{
 	37,  // 201: search_v3.Ticket.ExtraFaresEntry.value:type_name -> search_v3.FareProposals
 	51,  // 202: search_v3.AirlineInfo.NameEntry.value:type_name -> search_v3.MapStringString
 	53,  // 203: search_v3.Places.AirportsEntry.value:type_name -> search_v3.AirportInfo
-	56,  // 204: search_v3.Places.CitiesEntry.value:type_name -> search_v3.CityInfo
-	57,  // 205: search_v3.Places.CountriesEntry.value:type_name -> search_v3.CountryInfo
-	58,  // 206: search_v3.Places.MetroAreasEntry.value:type_name -> search_v3.MetroAreaInfo
+	55,  // 204: search_v3.Places.CitiesEntry.value:type_name -> search_v3.CityInfo
+	56,  // 205: search_v3.Places.CountriesEntry.value:type_name -> search_v3.CountryInfo
+	57,  // 206: search_v3.Places.MetroAreasEntry.value:type_name -> search_v3.MetroAreaInfo
 	51,  // 207: search_v3.AirportInfo.NameEntry.value:type_name -> search_v3.MapStringString
 	51,  // 208: search_v3.CityInfo.NameEntry.value:type_name -> search_v3.MapStringString
 	51,  // 209: search_v3.CountryInfo.NameEntry.value:type_name -> search_v3.MapStringString
 	51,  // 210: search_v3.AgentInfo.LabelEntry.value:type_name -> search_v3.MapStringString
-	65,  // 211: search_v3.DegradedBoundaries.AgentsEntry.value:type_name -> search_v3.FilterPrice
-	65,  // 212: search_v3.DegradedBoundaries.AirlinesEntry.value:type_name -> search_v3.FilterPrice
-	65,  // 213: search_v3.DegradedBoundaries.AlliancesEntry.value:type_name -> search_v3.FilterPrice
-	67,  // 214: search_v3.DegradedBoundaries.AirportsEntry.value:type_name -> search_v3.DegradedAirportsBoundaries
-	65,  // 215: search_v3.DegradedBoundaries.SameDepartureArrivalAirportEntry.value:type_name -> search_v3.FilterPrice
-	65,  // 216: search_v3.DegradedBoundaries.EquipmentsEntry.value:type_name -> search_v3.FilterPrice
-	65,  // 217: search_v3.DegradedBoundaries.PaymentMethodsEntry.value:type_name -> search_v3.FilterPrice
-	71,  // 218: search_v3.DegradedBoundaries.DepartureArrivalTimeEntry.value:type_name -> search_v3.DegradedTimeBoundaries
-	65,  // 219: search_v3.DegradedBoundaries.TransfersCountEntry.value:type_name -> search_v3.FilterPrice
-	65,  // 220: search_v3.DegradedBoundaries.TransfersAirportsEntry.value:type_name -> search_v3.FilterPrice
-	65,  // 221: search_v3.DegradedBoundaries.TransfersCountriesEntry.value:type_name -> search_v3.FilterPrice
-	65,  // 222: search_v3.DegradedAirportsBoundaries.ArrivalEntry.value:type_name -> search_v3.FilterPrice
-	65,  // 223: search_v3.DegradedAirportsBoundaries.DepartureEntry.value:type_name -> search_v3.FilterPrice
-	65,  // 224: search_v3.DegradedTimeBoundaries.ArrivalDateEntry.value:type_name -> search_v3.FilterPrice
-	79,  // 225: search_v3.Boundaries.AirportsEntry.value:type_name -> search_v3.AirportsBoundaries
-	80,  // 226: search_v3.Boundaries.DepartureArrivalTimeEntry.value:type_name -> search_v3.TimeBoundaries
-	84,  // 227: search_v3.FilterState.SegmentsEntry.value:type_name -> search_v3.SegmentFilter
+	64,  // 211: search_v3.DegradedBoundaries.AgentsEntry.value:type_name -> search_v3.FilterPrice
+	64,  // 212: search_v3.DegradedBoundaries.AirlinesEntry.value:type_name -> search_v3.FilterPrice
+	64,  // 213: search_v3.DegradedBoundaries.AlliancesEntry.value:type_name -> search_v3.FilterPrice
+	66,  // 214: search_v3.DegradedBoundaries.AirportsEntry.value:type_name -> search_v3.DegradedAirportsBoundaries
+	64,  // 215: search_v3.DegradedBoundaries.SameDepartureArrivalAirportEntry.value:type_name -> search_v3.FilterPrice
+	64,  // 216: search_v3.DegradedBoundaries.EquipmentsEntry.value:type_name -> search_v3.FilterPrice
+	64,  // 217: search_v3.DegradedBoundaries.PaymentMethodsEntry.value:type_name -> search_v3.FilterPrice
+	70,  // 218: search_v3.DegradedBoundaries.DepartureArrivalTimeEntry.value:type_name -> search_v3.DegradedTimeBoundaries
+	64,  // 219: search_v3.DegradedBoundaries.TransfersCountEntry.value:type_name -> search_v3.FilterPrice
+	64,  // 220: search_v3.DegradedBoundaries.TransfersAirportsEntry.value:type_name -> search_v3.FilterPrice
+	64,  // 221: search_v3.DegradedBoundaries.TransfersCountriesEntry.value:type_name -> search_v3.FilterPrice
+	64,  // 222: search_v3.DegradedAirportsBoundaries.ArrivalEntry.value:type_name -> search_v3.FilterPrice
+	64,  // 223: search_v3.DegradedAirportsBoundaries.DepartureEntry.value:type_name -> search_v3.FilterPrice
+	64,  // 224: search_v3.DegradedTimeBoundaries.ArrivalDateEntry.value:type_name -> search_v3.FilterPrice
+	78,  // 225: search_v3.Boundaries.AirportsEntry.value:type_name -> search_v3.AirportsBoundaries
+	79,  // 226: search_v3.Boundaries.DepartureArrivalTimeEntry.value:type_name -> search_v3.TimeBoundaries
+	83,  // 227: search_v3.FilterState.SegmentsEntry.value:type_name -> search_v3.SegmentFilter
 	228, // [228:228] is the sub-list for method output_type
 	228, // [228:228] is the sub-list for method input_type
 	228, // [228:228] is the sub-list for extension type_name
@@ -10640,18 +10604,6 @@ func file_protobuf_search_v3_results_proto_init() {
 			}
 		}
 		file_protobuf_search_v3_results_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OptBool); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_protobuf_search_v3_results_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CityInfo); i {
 			case 0:
 				return &v.state
@@ -10663,7 +10615,7 @@ func file_protobuf_search_v3_results_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_search_v3_results_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_search_v3_results_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CountryInfo); i {
 			case 0:
 				return &v.state
@@ -10675,7 +10627,7 @@ func file_protobuf_search_v3_results_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_search_v3_results_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_search_v3_results_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MetroAreaInfo); i {
 			case 0:
 				return &v.state
@@ -10687,7 +10639,7 @@ func file_protobuf_search_v3_results_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_search_v3_results_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_search_v3_results_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AgentInfo); i {
 			case 0:
 				return &v.state
@@ -10699,7 +10651,7 @@ func file_protobuf_search_v3_results_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_search_v3_results_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_search_v3_results_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Alliance); i {
 			case 0:
 				return &v.state
@@ -10711,7 +10663,7 @@ func file_protobuf_search_v3_results_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_search_v3_results_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_search_v3_results_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Equipment); i {
 			case 0:
 				return &v.state
@@ -10723,7 +10675,7 @@ func file_protobuf_search_v3_results_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_search_v3_results_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_search_v3_results_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SearchParams); i {
 			case 0:
 				return &v.state
@@ -10735,7 +10687,7 @@ func file_protobuf_search_v3_results_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_search_v3_results_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_search_v3_results_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Passengers); i {
 			case 0:
 				return &v.state
@@ -10747,7 +10699,7 @@ func file_protobuf_search_v3_results_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_search_v3_results_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_search_v3_results_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DegradedBoundaries); i {
 			case 0:
 				return &v.state
@@ -10759,7 +10711,7 @@ func file_protobuf_search_v3_results_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_search_v3_results_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_search_v3_results_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FilterPrice); i {
 			case 0:
 				return &v.state
@@ -10771,7 +10723,7 @@ func file_protobuf_search_v3_results_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_search_v3_results_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_search_v3_results_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FilterBool); i {
 			case 0:
 				return &v.state
@@ -10783,7 +10735,7 @@ func file_protobuf_search_v3_results_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_search_v3_results_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_search_v3_results_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DegradedAirportsBoundaries); i {
 			case 0:
 				return &v.state
@@ -10795,7 +10747,7 @@ func file_protobuf_search_v3_results_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_search_v3_results_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_search_v3_results_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FilterBaggageBoundaries); i {
 			case 0:
 				return &v.state
@@ -10807,7 +10759,7 @@ func file_protobuf_search_v3_results_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_search_v3_results_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_search_v3_results_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BaggageBoundaries); i {
 			case 0:
 				return &v.state
@@ -10819,7 +10771,7 @@ func file_protobuf_search_v3_results_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_search_v3_results_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_search_v3_results_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PriceBoundaries); i {
 			case 0:
 				return &v.state
@@ -10831,7 +10783,7 @@ func file_protobuf_search_v3_results_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_search_v3_results_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_search_v3_results_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DegradedTimeBoundaries); i {
 			case 0:
 				return &v.state
@@ -10843,7 +10795,7 @@ func file_protobuf_search_v3_results_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_search_v3_results_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_search_v3_results_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DateTimeRangeBoundaries); i {
 			case 0:
 				return &v.state
@@ -10855,7 +10807,7 @@ func file_protobuf_search_v3_results_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_search_v3_results_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_search_v3_results_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RangeBoundaries); i {
 			case 0:
 				return &v.state
@@ -10867,7 +10819,7 @@ func file_protobuf_search_v3_results_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_search_v3_results_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_search_v3_results_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DegradedReturnTicketBoundaries); i {
 			case 0:
 				return &v.state
@@ -10879,7 +10831,7 @@ func file_protobuf_search_v3_results_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_search_v3_results_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_search_v3_results_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TransferDurationBoundaries); i {
 			case 0:
 				return &v.state
@@ -10891,7 +10843,7 @@ func file_protobuf_search_v3_results_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_search_v3_results_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_search_v3_results_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Boundaries); i {
 			case 0:
 				return &v.state
@@ -10903,7 +10855,7 @@ func file_protobuf_search_v3_results_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_search_v3_results_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_search_v3_results_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReturnBoundaries); i {
 			case 0:
 				return &v.state
@@ -10915,7 +10867,7 @@ func file_protobuf_search_v3_results_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_search_v3_results_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_search_v3_results_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ChangeBoundaries); i {
 			case 0:
 				return &v.state
@@ -10927,7 +10879,7 @@ func file_protobuf_search_v3_results_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_search_v3_results_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_search_v3_results_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AirportsBoundaries); i {
 			case 0:
 				return &v.state
@@ -10939,7 +10891,7 @@ func file_protobuf_search_v3_results_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_search_v3_results_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_search_v3_results_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TimeBoundaries); i {
 			case 0:
 				return &v.state
@@ -10951,7 +10903,7 @@ func file_protobuf_search_v3_results_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_search_v3_results_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_search_v3_results_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResultsMeta); i {
 			case 0:
 				return &v.state
@@ -10963,7 +10915,7 @@ func file_protobuf_search_v3_results_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_search_v3_results_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_search_v3_results_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FilterState); i {
 			case 0:
 				return &v.state
@@ -10975,7 +10927,7 @@ func file_protobuf_search_v3_results_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_search_v3_results_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_search_v3_results_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TimeBuckets); i {
 			case 0:
 				return &v.state
@@ -10987,7 +10939,7 @@ func file_protobuf_search_v3_results_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_search_v3_results_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_search_v3_results_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SegmentFilter); i {
 			case 0:
 				return &v.state
@@ -10999,7 +10951,7 @@ func file_protobuf_search_v3_results_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_search_v3_results_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_search_v3_results_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DateTimeOrTimeRange); i {
 			case 0:
 				return &v.state
@@ -11011,7 +10963,7 @@ func file_protobuf_search_v3_results_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_search_v3_results_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_search_v3_results_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DateTimeRange); i {
 			case 0:
 				return &v.state
@@ -11023,7 +10975,7 @@ func file_protobuf_search_v3_results_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_search_v3_results_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_search_v3_results_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Range); i {
 			case 0:
 				return &v.state
@@ -11035,7 +10987,7 @@ func file_protobuf_search_v3_results_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_search_v3_results_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+		file_protobuf_search_v3_results_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FloatRange); i {
 			case 0:
 				return &v.state
@@ -11057,19 +11009,21 @@ func file_protobuf_search_v3_results_proto_init() {
 	file_protobuf_search_v3_results_proto_msgTypes[22].OneofWrappers = []interface{}{}
 	file_protobuf_search_v3_results_proto_msgTypes[25].OneofWrappers = []interface{}{}
 	file_protobuf_search_v3_results_proto_msgTypes[28].OneofWrappers = []interface{}{}
-	file_protobuf_search_v3_results_proto_msgTypes[57].OneofWrappers = []interface{}{}
-	file_protobuf_search_v3_results_proto_msgTypes[61].OneofWrappers = []interface{}{}
-	file_protobuf_search_v3_results_proto_msgTypes[64].OneofWrappers = []interface{}{}
-	file_protobuf_search_v3_results_proto_msgTypes[67].OneofWrappers = []interface{}{}
-	file_protobuf_search_v3_results_proto_msgTypes[69].OneofWrappers = []interface{}{}
-	file_protobuf_search_v3_results_proto_msgTypes[75].OneofWrappers = []interface{}{}
+	file_protobuf_search_v3_results_proto_msgTypes[46].OneofWrappers = []interface{}{}
+	file_protobuf_search_v3_results_proto_msgTypes[56].OneofWrappers = []interface{}{}
+	file_protobuf_search_v3_results_proto_msgTypes[60].OneofWrappers = []interface{}{}
+	file_protobuf_search_v3_results_proto_msgTypes[63].OneofWrappers = []interface{}{}
+	file_protobuf_search_v3_results_proto_msgTypes[66].OneofWrappers = []interface{}{}
+	file_protobuf_search_v3_results_proto_msgTypes[68].OneofWrappers = []interface{}{}
+	file_protobuf_search_v3_results_proto_msgTypes[74].OneofWrappers = []interface{}{}
+	file_protobuf_search_v3_results_proto_msgTypes[78].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_protobuf_search_v3_results_proto_rawDesc,
 			NumEnums:      7,
-			NumMessages:   141,
+			NumMessages:   140,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -2,55 +2,31 @@ package search_v3
 
 import (
 	"compress/gzip"
-	"embed"
-	"encoding/json"
-	"fmt"
+	"github.com/KosyanMedia/delta/pkg/types/datetime"
 	v3 "github.com/KosyanMedia/delta/search/cmd/results-api/api/v3"
 	"github.com/golang/protobuf/proto"
-	jsoniter "github.com/json-iterator/go"
 	"github.com/stretchr/testify/require"
+	"go-playground/protobuf/codec"
+	"go-playground/protobuf/codec/codectest"
 	"go-playground/protobuf/utils"
 	"reflect"
 	"testing"
 )
 
-var (
-	//go:embed results.json
-	resultsJson embed.FS
-
-	jsonIter = jsoniter.ConfigFastest
-)
-
 func TestBinarySize(t *testing.T) {
-	dump := readDump()
-
-	var data v3.SearchResults
-	require.NoError(t, jsonIter.Unmarshal(dump, &data))
-
-	marshalledJson := utils.Must2(jsonIter.Marshal(data))
-	fmt.Printf("Json body length: %d\n", len(marshalledJson))
-	utils.GzipAndPrint(marshalledJson)
-
-	protoData := resultsToProto(data)
-	bodyBytes := utils.Must2(proto.Marshal(protoData))
-	fmt.Printf("Proto body length: %d\n", len(bodyBytes))
-	utils.GzipAndPrint(bodyBytes)
+	codectest.RoundTrip(t, readDumpPayload())
 }
 
 // Checking that original struct is equal to proto struct, converted by `resultsToProto`
 func TestProtoSameAsOriginal(t *testing.T) {
-	dump := readDump()
-	var data v3.SearchResults
-	require.NoError(t, jsonIter.Unmarshal(dump, &data))
+	data := readDumpStruct()
 
 	protoData := resultsToProto(data)
 	requireDeepEqual(t, reflect.ValueOf(data), reflect.ValueOf(protoData.Chunks))
 }
 
 func TestVTProtoTheSame(t *testing.T) {
-	dump := readDump()
-	var data v3.SearchResults
-	require.NoError(t, jsonIter.Unmarshal(dump, &data))
+	data := readDumpStruct()
 
 	protoData := resultsToProto(data)
 	protoBytes := utils.Must2(proto.Marshal(protoData))
@@ -59,134 +35,100 @@ func TestVTProtoTheSame(t *testing.T) {
 	require.NoError(t, target.UnmarshalVT(protoBytes))
 }
 
-func BenchmarkObject_MarshalJSON(b *testing.B) {
+// Checking that JSON -> v3 -> proto -> v3 -> JSON reproduces the original dump
+func TestProtoToResultsRoundTrip(t *testing.T) {
 	data := readDumpStruct()
-	b.ReportAllocs()
-	b.ResetTimer()
+	expected := utils.Must2(jsonIter.Marshal(data))
 
-	for i := 0; i < b.N; i++ {
-		json.Marshal(data)
-	}
+	restored := utils.Must2(FromProtoE(resultsToProto(data)))
+	actual := utils.Must2(jsonIter.Marshal(restored))
+	require.JSONEq(t, string(expected), string(actual))
 }
 
-func BenchmarkObject_UnmarshalJSON(b *testing.B) {
-	bytes := readDump()
-	b.ReportAllocs()
-	b.ResetTimer()
-
-	for i := 0; i < b.N; i++ {
-		var target v3.SearchResults
-		json.Unmarshal(bytes, &target)
-	}
-}
+func TestFromProtoEMalformed(t *testing.T) {
+	results := resultsToProto(GenerateResults(generatorConfig))
+	results.Chunks[0].FilterState = &FilterState{Segments: map[int64]*SegmentFilter{0: {ArrivalDate: []string{"2023-02-30"}}}}
 
-//func BenchmarkObject_MarshalEasyJSON(b *testing.B) {
-//	data := readDumpStruct()
-//	b.ReportAllocs()
-//	b.ResetTimer()
-//
-//	for i := 0; i < b.N; i++ {
-//		easyjson.Marshal(data)
-//	}
-//}
-//
-//func BenchmarkObject_UnmarshalEasyJSON(b *testing.B) {
-//	bytes := readDump()
-//	b.ReportAllocs()
-//	b.ResetTimer()
-//
-//	for i := 0; i < b.N; i++ {
-//		var target v3.SearchResults
-//		easyjson.Unmarshal(bytes, &target)
-//	}
-//}
-
-func BenchmarkObject_MarshalIterJSON(b *testing.B) {
-	data := readDumpStruct()
-	b.ReportAllocs()
-	b.ResetTimer()
+	converted, err := FromProtoE(results)
+	require.Nil(t, converted)
+	require.ErrorContains(t, err, "2023-02-30")
 
-	for i := 0; i < b.N; i++ {
-		jsonIter.Marshal(data)
-	}
+	// FromProto leaves the date zero instead of panicking
+	restored := FromProto(results)
+	require.Equal(t, datetime.Date{}, restored[0].FilterState.Segments[0].ArrivalDate[0])
+	results.Chunks[0].FilterState = nil
+	require.Equal(t, FromProto(results), utils.Must2(FromProtoE(results)))
 }
 
-func BenchmarkObject_UnmarshalIterJSON(b *testing.B) {
-	bytes := readDump()
-	b.ReportAllocs()
-	b.ResetTimer()
-
-	for i := 0; i < b.N; i++ {
-		var target v3.SearchResults
-		jsonIter.Unmarshal(bytes, &target)
-	}
+func BenchmarkObject_Marshal(b *testing.B) {
+	codectest.Marshal(b, readDumpPayload())
 }
 
-func BenchmarkObject_MarshalProto(b *testing.B) {
-	data := resultsToProto(readDumpStruct())
-	b.ReportAllocs()
-	b.ResetTimer()
-
-	for i := 0; i < b.N; i++ {
-		proto.Marshal(data)
-	}
+func BenchmarkObject_Unmarshal(b *testing.B) {
+	codectest.Unmarshal(b, readDumpPayload())
 }
 
-func BenchmarkObject_UnmarshalProto(b *testing.B) {
-	data := resultsToProto(readDumpStruct())
-	bytes := utils.Must2(proto.Marshal(data))
-	b.ReportAllocs()
-	b.ResetTimer()
+func BenchmarkObject_Compress(b *testing.B) {
+	codectest.Compress(b, readDumpPayload())
+}
 
-	for i := 0; i < b.N; i++ {
-		var target SearchResults
-		proto.Unmarshal(bytes, &target)
-	}
+func BenchmarkObject_Decompress(b *testing.B) {
+	codectest.Decompress(b, readDumpPayload())
 }
 
-func BenchmarkObject_MarshalVTProto(b *testing.B) {
-	data := resultsToProto(readDumpStruct())
+// Custom checks
+func BenchmarkObject_Convert_MarshalVTProto_GZipDefault(b *testing.B) {
+	originalStruct := readDumpStruct()
 	b.ReportAllocs()
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
-		data.MarshalVT()
+		data := resultsToProto(originalStruct)
+		bytes, _ := data.MarshalVT()
+		utils.CompressGZIP(bytes, gzip.DefaultCompression)
 	}
 }
 
-func BenchmarkObject_UnmarshalVTProto(b *testing.B) {
+func BenchmarkObject_MarshalVTProto_GZipDefault(b *testing.B) {
 	data := resultsToProto(readDumpStruct())
-	bytes := utils.Must2(data.MarshalVT())
 	b.ReportAllocs()
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
-		var target SearchResults
-		target.UnmarshalVT(bytes)
+		bytes, _ := data.MarshalVT()
+		utils.CompressGZIP(bytes, gzip.DefaultCompression)
 	}
 }
 
-// Custom checks
-func BenchmarkObject_Convert_MarshalVTProto_GZipDefault(b *testing.B) {
-	originalStruct := readDumpStruct()
+// Same as above with columnar tickets and flight legs
+func BenchmarkObject_MarshalColumnar_GZipDefault(b *testing.B) {
+	data := resultsToProto(readDumpStruct())
 	b.ReportAllocs()
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
-		data := resultsToProto(originalStruct)
-		bytes, _ := data.MarshalVT()
-		utils.CompressGZIP(bytes, gzip.DefaultCompression)
+		for _, chunk := range data.Chunks {
+			bytes, _ := MarshalColumnar(chunk)
+			utils.CompressGZIP(bytes, gzip.DefaultCompression)
+		}
 	}
 }
 
-func BenchmarkObject_MarshalVTProto_GZipDefault(b *testing.B) {
+// Same as above, reusing the marshal buffer, the compressed buffer and a pooled gzip writer
+func BenchmarkObject_MarshalVTProto_GZipDefaultPooled(b *testing.B) {
 	data := resultsToProto(readDumpStruct())
+	var encoded, compressed []byte
 	b.ReportAllocs()
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
-		bytes, _ := data.MarshalVT()
-		utils.CompressGZIP(bytes, gzip.DefaultCompression)
+		size := data.SizeVT()
+		if cap(encoded) < size {
+			encoded = make([]byte, size)
+		}
+		encoded = encoded[:size]
+		data.MarshalToSizedBufferVT(encoded)
+		compressed, _ = utils.AppendCompressedGZIP(compressed[:0], encoded, gzip.DefaultCompression)
 	}
 }
 
@@ -202,13 +144,13 @@ func BenchmarkObject_MarshalIterJSON_GZipDefault(b *testing.B) {
 }
 
 func readDump() []byte {
-	return utils.Must2(resultsJson.ReadFile("results.json"))
+	return Dump()
 }
 
-func readDumpStruct() v3.SearchResults {
-	dump := readDump()
+func readDumpPayload() codec.Payload {
+	return NewResultsPayload(readDumpStruct())
+}
 
-	var data v3.SearchResults
-	utils.Must(jsonIter.Unmarshal(dump, &data))
-	return data
+func readDumpStruct() v3.SearchResults {
+	return utils.Must2(Results(readDump()))
 }
@@ -1,7 +1,6 @@
 package search_v3
 
 import (
-	"fmt"
 	"github.com/KosyanMedia/delta/pkg/currency"
 	"github.com/KosyanMedia/delta/pkg/iata"
 	"github.com/KosyanMedia/delta/pkg/types/datetime"
@@ -12,65 +11,96 @@ import (
 	"github.com/KosyanMedia/delta/search/cmd/results-api/filter/boundaries"
 	"github.com/KosyanMedia/delta/search/cmd/results-api/filter/times"
 	"github.com/KosyanMedia/delta/search/cmd/results-api/filter/transfers"
-	"golang.org/x/exp/constraints"
+	"go-playground/protobuf/conv"
+	"go-playground/protobuf/utils"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
 )
 
-func resultsToProto(results v3.SearchResults) *SearchResults {
+// ToProto converts search results into their proto representation
+func ToProto(results v3.SearchResults) *SearchResults {
+	return resultsToProto(results)
+}
+
+// ConvertOptions trade completeness of the proto representation for its size, zero value keeps everything
+type ConvertOptions struct {
+	// Locales keep only the requested locales of localized names, see LocaleFilter
+	Locales LocaleFilter
+	// Strict makes ToProtoE fail when a value is lost by the conversion instead of returning the results along with errors
+	Strict bool
+	// Workers convert chunks concurrently when more than 1, the order of chunks is kept
+	Workers int
+}
+
+// ToProtoWith converts search results into their proto representation according to opts
+func ToProtoWith(results v3.SearchResults, opts ConvertOptions) *SearchResults {
 	return &SearchResults{
-		Chunks: chunksToProto(results),
+		Chunks: chunksToProto(results, opts),
 	}
 }
 
-func chunksToProto(chunks []*v3.Chunk) []*Chunk {
+func resultsToProto(results v3.SearchResults) *SearchResults {
+	return ToProtoWith(results, ConvertOptions{})
+}
+
+func chunksToProto(chunks []*v3.Chunk, opts ConvertOptions) []*Chunk {
 	result := make([]*Chunk, len(chunks))
-	for i, chunk := range chunks {
-		paymentOptions := make([]string, len(chunk.SearchParams.PaymentOptions))
-		for i2, option := range chunk.SearchParams.PaymentOptions {
-			paymentOptions[i2] = string(option)
+	_ = parallel(len(chunks), opts.Workers, func(i int) error {
+		result[i] = chunkToProto(chunks[i], opts)
+		return nil
+	})
+	return result
+}
+
+func chunkToProto(chunk *v3.Chunk, opts ConvertOptions) *Chunk {
+	var paymentOptions []string
+	if chunk.SearchParams.PaymentOptions != nil {
+		paymentOptions = make([]string, len(chunk.SearchParams.PaymentOptions))
+		for i, option := range chunk.SearchParams.PaymentOptions {
+			paymentOptions[i] = string(option)
 		}
+	}
 
-		result[i] = &Chunk{
-			ChunkId:                              chunk.ChunkID,
-			LastUpdateTimestamp:                  chunk.LastUpdateTimestamp,
-			DebugInfo:                            debugInfoToProto(chunk.DebugInfo),
-			Tickets:                              convArray(chunk.Tickets, ticketToProto),
-			SoftTickets:                          softResponseToProto(chunk.SoftTickets),
-			BrandTicket:                          ticketToProtoOpt(chunk.BrandTicket),
-			BrandTickets:                         convMap(chunk.BrandTickets, convNum[int, int64], ticketToProto),
-			CheapestTicket:                       ticketToProtoOpt(chunk.CheapestTicket),
-			FilteredCheapestTicket:               ticketToProtoOpt(chunk.FilteredCheapestTicket),
-			CheapestTicketWithoutAirportPrecheck: ticketToProtoOpt(chunk.CheapestTicketWithoutAirportPreCheck),
-			DirectFlights:                        convArray(chunk.DirectFlights, directFlightsToProto),
-			FlightLegs:                           convArray(chunk.FlightLegs, flightLegToProto),
-			Airlines:                             convMap(chunk.Airlines, convString[iata.AirlineID], airlineInfoToProto),
-			Places:                               placesToProto(chunk.Places),
-			Agents:                               convMap(chunk.Agents, convNum[int, int64], agentInfoToProto),
-			Alliances:                            convMap(chunk.Alliances, convNum[int, int64], allianceToProto),
-			Equipments:                           convMap(chunk.Equipments, same[string], equipmentToProto),
-			SearchParams: &SearchParams{
-				Passengers: &Passengers{
-					Adults:   chunk.SearchParams.Passengers.Adults,
-					Children: chunk.SearchParams.Passengers.Children,
-					Infants:  chunk.SearchParams.Passengers.Infants,
-				},
-				TripClass:      TripClass(chunk.SearchParams.TripClass),
-				SourceKind:     SourceKind(chunk.SearchParams.SourceKind),
-				Experiments:    chunk.SearchParams.Experiments,
-				PaymentOptions: paymentOptions,
+	return &Chunk{
+		ChunkId:                              chunk.ChunkID,
+		LastUpdateTimestamp:                  chunk.LastUpdateTimestamp,
+		DebugInfo:                            debugInfoToProto(chunk.DebugInfo),
+		Tickets:                              conv.Array(chunk.Tickets, ticketToProto),
+		SoftTickets:                          softResponseToProto(chunk.SoftTickets),
+		BrandTicket:                          ticketToProtoOpt(chunk.BrandTicket),
+		BrandTickets:                         conv.Map(chunk.BrandTickets, conv.Cast[int, int64], ticketToProto),
+		CheapestTicket:                       ticketToProtoOpt(chunk.CheapestTicket),
+		FilteredCheapestTicket:               ticketToProtoOpt(chunk.FilteredCheapestTicket),
+		CheapestTicketWithoutAirportPrecheck: ticketToProtoOpt(chunk.CheapestTicketWithoutAirportPreCheck),
+		DirectFlights:                        conv.Array(chunk.DirectFlights, directFlightsToProto),
+		FlightLegs:                           conv.Array(chunk.FlightLegs, flightLegToProto),
+		Airlines:                             conv.Map(chunk.Airlines, conv.String[iata.AirlineID], opts.Locales.airlineInfoToProto),
+		Places:                               opts.Locales.placesToProto(chunk.Places),
+		Agents:                               conv.Map(chunk.Agents, conv.Cast[int, int64], opts.Locales.agentInfoToProto),
+		Alliances:                            conv.Map(chunk.Alliances, conv.Cast[int, int64], allianceToProto),
+		Equipments:                           conv.Map(chunk.Equipments, conv.Same[string], equipmentToProto),
+		SearchParams: &SearchParams{
+			Passengers: &Passengers{
+				Adults:   chunk.SearchParams.Passengers.Adults,
+				Children: chunk.SearchParams.Passengers.Children,
+				Infants:  chunk.SearchParams.Passengers.Infants,
 			},
-			DegradedFilterBoundaries: degradedBoundariesToProto(chunk.DegradedFilterBoundaries),
-			FilterBoundaries:         boundariesToProto(chunk.FilterBoundaries),
-			Meta: &ResultsMeta{
-				FilteredTicketsCount: int64(chunk.Meta.FilteredTicketsCount),
-				TotalTicketsCount:    int64(chunk.Meta.TotalTicketsCount),
-				DirectTicketsCount:   int64(chunk.Meta.DirectTicketsCount),
-			},
-			FilterState: filterState(chunk.FilterState),
-			Order:       Order(chunk.Order),
-			Brand:       Brand(chunk.Brand),
-		}
+			TripClass:      TripClass(chunk.SearchParams.TripClass),
+			SourceKind:     SourceKind(chunk.SearchParams.SourceKind),
+			Experiments:    chunk.SearchParams.Experiments,
+			PaymentOptions: paymentOptions,
+		},
+		DegradedFilterBoundaries: degradedBoundariesToProto(chunk.DegradedFilterBoundaries),
+		FilterBoundaries:         boundariesToProto(chunk.FilterBoundaries),
+		Meta: &ResultsMeta{
+			FilteredTicketsCount: int64(chunk.Meta.FilteredTicketsCount),
+			TotalTicketsCount:    int64(chunk.Meta.TotalTicketsCount),
+			DirectTicketsCount:   int64(chunk.Meta.DirectTicketsCount),
+		},
+		FilterState: filterState(chunk.FilterState),
+		Order:       Order(chunk.Order),
+		Brand:       Brand(chunk.Brand),
 	}
-	return result
 }
 
 func debugInfoToProto(info *v3.DebugInfo) *DebugInfo {
@@ -82,7 +112,7 @@ func debugInfoToProto(info *v3.DebugInfo) *DebugInfo {
 		DataCenter:      info.DataCenter,
 		Gates:           gatesToProto(info.Gates),
 		FromCache:       info.FromCache,
-		SearchStartTime: info.SearchStartTime.UnixMilli(),
+		SearchStartTime: timeToProto(info.SearchStartTime),
 	}
 }
 
@@ -114,9 +144,9 @@ func agentsToProto(agents map[int]v3.AgentDebugInfo) map[int64]*AgentDebugInfo {
 		result[int64(id)] = &AgentDebugInfo{
 			Proposals:                proposalsMapToProto(info.Proposals),
 			ProposalsCount:           int64(info.ProposalsCount),
-			BadProposals:             convMap(info.BadProposals, same[string], convNum[int, int64]),
-			FilteredProposals:        convMap(info.FilteredProposals, same[string], proposalsToProto),
-			MergedFlightTermsSources: convMap(info.MergedFlightTermsSources, same[string], convNum[int, int64]),
+			BadProposals:             conv.Map(info.BadProposals, conv.Same[string], conv.Cast[int, int64]),
+			FilteredProposals:        conv.Map(info.FilteredProposals, conv.Same[string], proposalsToProto),
+			MergedFlightTermsSources: conv.Map(info.MergedFlightTermsSources, conv.Same[string], conv.Cast[int, int64]),
 		}
 	}
 	return result
@@ -140,17 +170,19 @@ func proposalsMapToProto(proposals map[v3.ProposalID]v3.ProposalDebugInfo) map[s
 }
 
 func amountToProtoOpt(amount *currency.Amount) *Amount {
-	if amount == nil {
-		return nil
-	}
-	return amountToProto(*amount)
+	return conv.Opt(amount, amountToProto)
 }
 
+// amountToProto keeps codes missing from Currency in CurrencyIsoCode, zero code stays CURRENCY_UNSPECIFIED
 func amountToProto(amount currency.Amount) *Amount {
-	return &Amount{
-		CurrencyCode: Currency(Currency_value[amount.CurrencyCode.String()]),
-		Value:        amount.Value,
+	result := &Amount{Value: amount.Value}
+	code := amount.CurrencyCode.String()
+	if value := Currency_value[code]; value != int32(Currency_CURRENCY_UNSPECIFIED) {
+		result.CurrencyCode = Currency(value)
+	} else {
+		result.CurrencyIsoCode = code
 	}
+	return result
 }
 
 func flightTermsDebugInfoToProto(terms map[v3.FlightLegIndex]v3.FlightTermDebugInfo) map[int64]*FlightTermDebugInfo {
@@ -162,17 +194,14 @@ func flightTermsDebugInfoToProto(terms map[v3.FlightLegIndex]v3.FlightTermDebugI
 		result[int64(index)] = &FlightTermDebugInfo{
 			BaggageSource:      TermSource(info.BaggageSource),
 			HandbagsSource:     TermSource(info.HandbagsSource),
-			GateTechnicalStops: convArray(info.GateTechnicalStops, technicalStopToProto),
+			GateTechnicalStops: conv.Array(info.GateTechnicalStops, technicalStopToProto),
 		}
 	}
 	return result
 }
 
 func technicalStopToProtoOpt(stop *delta.TechnicalStop) *TechnicalStop {
-	if stop == nil {
-		return nil
-	}
-	return technicalStopToProto(*stop)
+	return conv.Opt(stop, technicalStopToProto)
 }
 
 func technicalStopToProto(stop delta.TechnicalStop) *TechnicalStop {
@@ -203,7 +232,7 @@ func proposalsToProto(proposals []v3.Proposal) *Proposals {
 			Price:             amountToProto(proposal.Price),
 			PricePerPerson:    amountToProto(proposal.PricePerPerson),
 			AgentId:           int64(proposal.AgentID),
-			FlightTerms:       convMap(proposal.FlightTerms, convNum[int, int64], flightTermToProto),
+			FlightTerms:       conv.Map(proposal.FlightTerms, conv.Cast[int, int64], flightTermToProto),
 			TransferTerms:     transferTermsToProto(proposal.TransferTerms),
 			UnifiedPrice:      amountToProto(proposal.UnifiedPrice),
 			Options:           proposalOptionsToProto(proposal.Options),
@@ -214,7 +243,7 @@ func proposalsToProto(proposals []v3.Proposal) *Proposals {
 			IsWarmcache:       proposal.IsWarmcache,
 			Cashback:          cashbackToProto(proposal.Cashback),
 			CashbackPerPerson: cashbackToProto(proposal.CashbackPerPerson),
-			AcceptedCards:     convArray(proposal.AcceptedCards, acceptedCardToProto),
+			AcceptedCards:     conv.Array(proposal.AcceptedCards, acceptedCardToProto),
 		}
 	}
 	return &Proposals{
@@ -226,7 +255,7 @@ func flightTermToProto(term v3.FlightTerm) *FlightTerm {
 	return &FlightTerm{
 		FareCode:                   string(term.FareCode),
 		TripClass:                  TripClass(term.TripClass),
-		SeatsAvailable:             int32(term.SeatsAvailable),
+		SeatsAvailable:             seatsToProto(term.SeatsAvailable),
 		MarketingCarrierDesignator: flightDesignatorToProto(term.MarketingCarrierDesignator),
 		Baggage:                    baggageToProto(term.Baggage),
 		Handbags:                   baggageToProto(term.Handbags),
@@ -234,8 +263,17 @@ func flightTermToProto(term v3.FlightTerm) *FlightTerm {
 		IsCharter:                  term.IsCharter,
 		Tags:                       term.Tags,
 		MergedTermsInfo:            mergedTermsInfoToProto(term.MergedTermsInfo),
-		MergedFromOtherProposals:   convMap(term.MergedFromOtherProposals, same[string], convNum[int, int64]),
+		MergedFromOtherProposals:   conv.Map(term.MergedFromOtherProposals, conv.Same[string], conv.Cast[int, int64]),
+	}
+}
+
+// seatsToProto leaves seats which don't fit int32 zero, ToProtoE reports them
+func seatsToProto(seats int) int32 {
+	converted, err := conv.Int[int, int32](seats)
+	if err != nil {
+		return 0
 	}
+	return converted
 }
 
 func flightDesignatorToProto(fd *base.FlightDesignator) *FlightDesignator {
@@ -313,12 +351,12 @@ func baggageMergeParamsToProto(bmp v3.BaggageMergeParams) *BaggageMergeParams {
 }
 
 func transferTermsToProto(terms [][]v3.TransferTerm) []*TransferTerms {
-	return convArray(terms, func(v1 []v3.TransferTerm) *TransferTerms {
+	return conv.Array(terms, func(v1 []v3.TransferTerm) *TransferTerms {
 		if v1 == nil {
 			return nil
 		}
 		return &TransferTerms{
-			Terms: convArray(v1, func(v1 v3.TransferTerm) *TransferTerm {
+			Terms: conv.Array(v1, func(v1 v3.TransferTerm) *TransferTerm {
 				return &TransferTerm{
 					IsVirtualInterline: v1.IsVirtualInterline,
 					Tags:               v1.Tags,
@@ -411,30 +449,27 @@ func ticketToProto(ticket v3.Ticket) *Ticket {
 		proposals = proposalsObj.Proposals
 	}
 	return &Ticket{
-		Segments:   convArray(ticket.Segments, segmentToProto),
+		Segments:   conv.Array(ticket.Segments, segmentToProto),
 		Proposals:  proposals,
 		Signature:  ticket.Signature,
 		Popularity: ticket.Popularity,
 		Score:      ticket.Score,
 		Hashsum:    ticket.HashSum,
 		Tags:       ticket.Tags,
-		Badges:     convArray(ticket.Badges, badgeInfoToProto),
-		ExtraFares: convMap(ticket.ExtraFares, same[string], fareProposalsToProto),
+		Badges:     conv.Array(ticket.Badges, badgeInfoToProto),
+		ExtraFares: conv.Map(ticket.ExtraFares, conv.Same[string], fareProposalsToProto),
 		FilteredBy: ticket.FilteredBy,
 	}
 }
 
 func ticketToProtoOpt(ticket *v3.Ticket) *Ticket {
-	if ticket == nil {
-		return nil
-	}
-	return ticketToProto(*ticket)
+	return conv.Opt(ticket, ticketToProto)
 }
 
 func segmentToProto(segment v3.Segment) *Segment {
 	return &Segment{
-		Flights:   convArray(segment.FlightLegs, convNum[int, int64]),
-		Transfers: convArray(segment.Transfers, transferToProto),
+		Flights:   conv.Array(segment.FlightLegs, conv.Cast[int, int64]),
+		Transfers: conv.Array(segment.Transfers, transferToProto),
 		Tags:      segment.Tags,
 	}
 }
@@ -455,7 +490,7 @@ func badgeInfoToProto(info v3.BadgeInfo) *BadgeInfo {
 		Type:   info.Type,
 		Scores: info.Scores,
 		Meta: &BadgeInfoMeta{
-			Name:     convMap(info.Meta.Name, convString[base.LanguageCode], same[string]),
+			Name:     conv.Map(info.Meta.Name, conv.String[base.LanguageCode], conv.Same[string]),
 			Priority: int64(info.Meta.Priority),
 			Position: int64(info.Meta.Position),
 			Limit:    int64(info.Meta.Limit),
@@ -472,7 +507,7 @@ func fareProposalsToProto(proposals []v3.FareProposal) *FareProposals {
 		return nil
 	}
 	return &FareProposals{
-		Proposals: convArray(proposals, func(v1 v3.FareProposal) *FareProposal {
+		Proposals: conv.Array(proposals, func(v1 v3.FareProposal) *FareProposal {
 			return &FareProposal{
 				ProposalId: v1.ID,
 				Index:      int64(v1.Index),
@@ -487,7 +522,7 @@ func softResponseToProto(response *v3.SoftResponse) *SoftResponse {
 	}
 	return &SoftResponse{
 		FiltersApplied: response.FiltersApplied,
-		Tickets:        convArray(response.Tickets, ticketToProto),
+		Tickets:        conv.Array(response.Tickets, ticketToProto),
 	}
 }
 
@@ -496,12 +531,12 @@ func directFlightsToProto(df v3.DirectFlights) *DirectFlights {
 		Carrier:        df.Carrier,
 		Carriers:       df.Carriers,
 		CheapestTicket: ticketToProto(df.CheapestTicket),
-		Schedule: convArray(df.Schedule, func(v1 []v3.Schedule) *ScheduleList {
+		Schedule: conv.Array(df.Schedule, func(v1 []v3.Schedule) *ScheduleList {
 			if v1 == nil {
 				return nil
 			}
 			return &ScheduleList{
-				List: convArray(v1, func(v1 v3.Schedule) *Schedule {
+				List: conv.Array(v1, func(v1 v3.Schedule) *Schedule {
 					return &Schedule{
 						Time:              v1.Time,
 						Datetime:          v1.DateTime,
@@ -523,7 +558,7 @@ func flightLegToProto(leg v3.FlightLeg) *FlightLeg {
 		ArrivalUnixTimestamp:       leg.ArrivalUnixTimestamp,
 		OperatingCarrierDesignator: flightDesignatorToProto(&leg.OperatingCarrierDesignator),
 		Equipment:                  baseEquipmentToProto(leg.Equipment),
-		TechnicalStops:             convArray(leg.TechnicalStops, technicalStopToProtoOpt),
+		TechnicalStops:             conv.Array(leg.TechnicalStops, technicalStopToProtoOpt),
 		Signature:                  leg.Signature,
 		Tags:                       leg.Tags,
 	}
@@ -545,21 +580,32 @@ func baseEquipmentToProto(equipment base.Equipment) *Equipment {
 	}
 }
 
-func airlineInfoToProto(info v3.AirlineInfo) *AirlineInfo {
+func (locales LocaleFilter) airlineInfoToProto(info v3.AirlineInfo) *AirlineInfo {
 	return &AirlineInfo{
 		Iata:       string(info.IATA),
 		IsLowcost:  info.IsLowcost,
-		Name:       localizableContextStringToProto(info.Name),
+		Name:       locales.localizableContextStringToProto(info.Name),
 		AllianceId: int64(info.AllianceID),
 		SiteName:   info.SiteName,
 		BrandColor: info.BrandColor,
 	}
 }
 
-func localizableContextStringToProto(name base.LocalizableContextString) map[string]*MapStringString {
+func (locales LocaleFilter) localizableContextStringToProto(name base.LocalizableContextString) map[string]*MapStringString {
 	if name == nil {
 		return nil
 	}
+	if len(locales.Locales) > 0 {
+		result := make(map[string]*MapStringString, len(locales.Locales))
+		for _, locale := range locales.Locales {
+			if code, ok := locales.resolve(name, locale); ok {
+				result[code.String()] = &MapStringString{
+					Map: name[code],
+				}
+			}
+		}
+		return result
+	}
 
 	result := make(map[string]*MapStringString, len(name))
 	for k1, v1 := range name {
@@ -572,12 +618,12 @@ func localizableContextStringToProto(name base.LocalizableContextString) map[str
 	return result
 }
 
-func placesToProto(places base.Places) *Places {
+func (locales LocaleFilter) placesToProto(places base.Places) *Places {
 	return &Places{
-		Airports: convMap(places.Airports, convString[iata.LocationIATACode],
+		Airports: conv.Map(places.Airports, conv.String[iata.LocationIATACode],
 			func(v1 base.AirportInfo) *AirportInfo {
 				return &AirportInfo{
-					Name:          localizableContextStringToProto(v1.Name),
+					Name:          locales.localizableContextStringToProto(v1.Name),
 					Code:          string(v1.Code),
 					CityCode:      string(v1.CityCode),
 					MetroAreaCode: string(v1.MetroAreaCode),
@@ -590,48 +636,49 @@ func placesToProto(places base.Places) *Places {
 					TransitWorkHoursMax: int64(v1.TransitWorkHoursMax),
 				}
 			}),
-		Cities: convMap(places.Cities, convString[iata.LocationIATACode],
+		Cities: conv.Map(places.Cities, conv.String[iata.LocationIATACode],
 			func(v1 base.CityInfo) *CityInfo {
 				return &CityInfo{
 					Code:     string(v1.Code),
-					Name:     localizableContextStringToProto(v1.Name),
+					Name:     locales.localizableContextStringToProto(v1.Name),
 					Country:  string(v1.Country),
 					Timezone: v1.Timezone,
-					Airports: convArray(v1.Airports, convString[iata.LocationIATACode]),
+					Airports: conv.Array(v1.Airports, conv.String[iata.LocationIATACode]),
 				}
 			}),
-		Countries: convMap(places.Countries, convString[iata.CountryCode],
+		Countries: conv.Map(places.Countries, conv.String[iata.CountryCode],
 			func(v1 base.CountryInfo) *CountryInfo {
 				return &CountryInfo{
 					Code:        string(v1.Code),
-					Name:        localizableContextStringToProto(v1.Name),
+					Name:        locales.localizableContextStringToProto(v1.Name),
 					UnifiedVisa: v1.UnifiedVisa,
 				}
 			}),
-		MetroAreas: convMap(places.MetroAreas, convString[iata.LocationIATACode],
+		MetroAreas: conv.Map(places.MetroAreas, conv.String[iata.LocationIATACode],
 			func(v1 base.MetroAreaInfo) *MetroAreaInfo {
 				return &MetroAreaInfo{
 					Code:     string(v1.Code),
-					Airports: convArray(v1.Airports, convString[iata.LocationIATACode]),
+					Airports: conv.Array(v1.Airports, conv.String[iata.LocationIATACode]),
 					Timezone: v1.Timezone,
 				}
 			}),
-		AirportsToMetro: convMap(places.AirportsToMetro, convString[iata.LocationIATACode], convString[iata.LocationIATACode]),
+		AirportsToMetro: conv.Map(places.AirportsToMetro, conv.String[iata.LocationIATACode], conv.String[iata.LocationIATACode]),
 	}
 }
 
-func pointerBoolToProto(bool base.PointerBool) *OptBool {
-	return &OptBool{
-		Value:     bool.IsTrue(),
-		IsUnknown: bool.IsUnknown(),
+// pointerBoolToProto keeps an unknown value unset
+func pointerBoolToProto(bool base.PointerBool) *bool {
+	if bool.IsUnknown() {
+		return nil
 	}
+	return utils.Ptr(bool.IsTrue())
 }
 
-func agentInfoToProto(info v3.AgentInfo) *AgentInfo {
+func (locales LocaleFilter) agentInfoToProto(info v3.AgentInfo) *AgentInfo {
 	return &AgentInfo{
 		Id:             int64(info.ID),
 		GateName:       info.GateName,
-		Label:          localizableContextStringToProto(info.Label),
+		Label:          locales.localizableContextStringToProto(info.Label),
 		PaymentMethods: info.PaymentMethods,
 		MobileVersion:  info.MobileVersion,
 		HideProposals:  info.HideProposals,
@@ -658,8 +705,8 @@ func degradedBoundariesToProto(bound *boundaries.DegradedBoundaries) *DegradedBo
 		airports = make(map[int64]*DegradedAirportsBoundaries, len(bound.Airports))
 		for i, airportsBoundaries := range bound.Airports {
 			airports[int64(i)] = &DegradedAirportsBoundaries{
-				Arrival:   convMap(airportsBoundaries.Arrival, convString[iata.LocationIATACode], filterPriceToProto),
-				Departure: convMap(airportsBoundaries.Departure, convString[iata.LocationIATACode], filterPriceToProto),
+				Arrival:   conv.Map(airportsBoundaries.Arrival, conv.String[iata.LocationIATACode], filterPriceToProto),
+				Departure: conv.Map(airportsBoundaries.Departure, conv.String[iata.LocationIATACode], filterPriceToProto),
 			}
 		}
 	}
@@ -678,7 +725,7 @@ func degradedBoundariesToProto(bound *boundaries.DegradedBoundaries) *DegradedBo
 		departureArrivalTime = make(map[int64]*DegradedTimeBoundaries, len(bound.DepartureArrivalTime))
 		for i, timeBoundaries := range bound.DepartureArrivalTime {
 			departureArrivalTime[int64(i)] = &DegradedTimeBoundaries{
-				ArrivalDate:   convMap(timeBoundaries.ArrivalDate, convStringer[datetime.Date], filterPriceToProto),
+				ArrivalDate:   conv.Map(timeBoundaries.ArrivalDate, conv.Stringer[datetime.Date], filterPriceToProto),
 				ArrivalTime:   dateTimeRangeBoundariesToProtoOpt(timeBoundaries.ArrivalTime),
 				DepartureTime: dateTimeRangeBoundariesToProtoOpt(timeBoundaries.DepartureTime),
 				TripDuration:  rangeBoundariesToProtoOpt((*times.RangeBoundaries)(timeBoundaries.TripDuration)),
@@ -703,24 +750,24 @@ func degradedBoundariesToProto(bound *boundaries.DegradedBoundaries) *DegradedBo
 	}
 
 	return &DegradedBoundaries{
-		Agents:                           convMap(bound.Agents, convNum[int, int64], filterPriceToProto),
-		Airlines:                         convMap(bound.Airlines, convStringer[iata.AirlineID], filterPriceToProto),
-		Alliances:                        convMap(bound.Alliances, convNum[int, int64], filterPriceToProto),
+		Agents:                           conv.Map(bound.Agents, conv.Cast[int, int64], filterPriceToProto),
+		Airlines:                         conv.Map(bound.Airlines, conv.Stringer[iata.AirlineID], filterPriceToProto),
+		Alliances:                        conv.Map(bound.Alliances, conv.Cast[int, int64], filterPriceToProto),
 		HasInterlines:                    filterBoolToProto(bound.HasInterlines),
 		HasLowcosts:                      filterBoolToProto(bound.HasLowcosts),
 		Airports:                         airports,
-		SameDepartureArrivalAirport:      convMap(bound.SameDepartureArrivalAirport, convString[iata.LocationIATACode], filterPriceToProto),
+		SameDepartureArrivalAirport:      conv.Map(bound.SameDepartureArrivalAirport, conv.String[iata.LocationIATACode], filterPriceToProto),
 		Baggage:                          baggage,
-		Equipments:                       convMap(bound.Equipments, same[string], filterPriceToProto),
-		PaymentMethods:                   convMap(bound.PaymentMethods, same[string], filterPriceToProto),
+		Equipments:                       conv.Map(bound.Equipments, conv.Same[string], filterPriceToProto),
+		PaymentMethods:                   conv.Map(bound.PaymentMethods, conv.Same[string], filterPriceToProto),
 		Price:                            floatRangeToProtoOpt((*filter.FloatRange)(bound.Price)),
 		DepartureArrivalTime:             departureArrivalTime,
 		ReturnTicket:                     returnTicket,
 		ChangeTicket:                     changeTicket,
-		TransfersCount:                   convMap(bound.TransfersCount, same[int64], filterPriceToProto),
+		TransfersCount:                   conv.Map(bound.TransfersCount, conv.Same[int64], filterPriceToProto),
 		TransfersDuration:                transferDurationBoundariesToProto(bound.TransfersDuration),
-		TransfersAirports:                convMap(bound.TransfersAirports, convStringer[iata.LocationIATACode], filterPriceToProto),
-		TransfersCountries:               convMap(bound.TransfersCountries, same[string], filterPriceToProto),
+		TransfersAirports:                conv.Map(bound.TransfersAirports, conv.Stringer[iata.LocationIATACode], filterPriceToProto),
+		TransfersCountries:               conv.Map(bound.TransfersCountries, conv.Same[string], filterPriceToProto),
 		HasTransfersWithAirportChange:    filterBoolToProto(bound.HasTransfersWithAirportChange),
 		HasTransfersWithBaggageRecheck:   filterBoolToProto(bound.HasTransfersWithBaggageRecheck),
 		HasTransfersWithVisa:             filterBoolToProto(bound.HasTransfersWithVisa),
@@ -754,10 +801,7 @@ func filterBoolToProto(bool *filter.Bool) *FilterBool {
 }
 
 func floatRangeToProtoOpt(fr *filter.FloatRange) *PriceBoundaries {
-	if fr == nil {
-		return nil
-	}
-	return floatRangeToProto(*fr)
+	return conv.Opt(fr, floatRangeToProto)
 }
 
 func floatRangeToProto(fr filter.FloatRange) *PriceBoundaries {
@@ -771,23 +815,17 @@ func dateTimeRangeBoundariesToProto(dtrb times.DateTimeRangeBoundaries) *DateTim
 	return &DateTimeRangeBoundaries{
 		Min:         dtrb.Min.String(),
 		Max:         dtrb.Max.String(),
-		Buckets:     convMap(dtrb.Buckets, convStringer[datetime.DateTime], same[float64]),
+		Buckets:     conv.Map(dtrb.Buckets, conv.Stringer[datetime.DateTime], conv.Same[float64]),
 		BucketWidth: dtrb.BucketWidth,
 	}
 }
 
 func dateTimeRangeBoundariesToProtoOpt(dtrb *times.DateTimeRangeBoundaries) *DateTimeRangeBoundaries {
-	if dtrb == nil {
-		return nil
-	}
-	return dateTimeRangeBoundariesToProto(*dtrb)
+	return conv.Opt(dtrb, dateTimeRangeBoundariesToProto)
 }
 
 func rangeBoundariesToProtoOpt(rb *times.RangeBoundaries) *RangeBoundaries {
-	if rb == nil {
-		return nil
-	}
-	return rangeBoundariesToProto(*rb)
+	return conv.Opt(rb, rangeBoundariesToProto)
 }
 
 func rangeBoundariesToProto(rb times.RangeBoundaries) *RangeBoundaries {
@@ -819,8 +857,8 @@ func boundariesToProto(bound *boundaries.Boundaries) *Boundaries {
 		airports = make(map[int64]*AirportsBoundaries, len(bound.Airports))
 		for i, airportsBoundaries := range bound.Airports {
 			airports[int64(i)] = &AirportsBoundaries{
-				Arrival:   convMap(airportsBoundaries.Arrival, convString[iata.LocationIATACode], same[float64]),
-				Departure: convMap(airportsBoundaries.Departure, convString[iata.LocationIATACode], same[float64]),
+				Arrival:   conv.Map(airportsBoundaries.Arrival, conv.String[iata.LocationIATACode], conv.Same[float64]),
+				Departure: conv.Map(airportsBoundaries.Departure, conv.String[iata.LocationIATACode], conv.Same[float64]),
 			}
 		}
 	}
@@ -830,7 +868,7 @@ func boundariesToProto(bound *boundaries.Boundaries) *Boundaries {
 		departureArrivalTime = make(map[int64]*TimeBoundaries, len(bound.DepartureArrivalTime))
 		for i, timeBoundaries := range bound.DepartureArrivalTime {
 			departureArrivalTime[int64(i)] = &TimeBoundaries{
-				ArrivalDate:   convMap(timeBoundaries.ArrivalDate, convStringer[datetime.Date], same[float64]),
+				ArrivalDate:   conv.Map(timeBoundaries.ArrivalDate, conv.Stringer[datetime.Date], conv.Same[float64]),
 				ArrivalTime:   dateTimeRangeBoundariesToProto(timeBoundaries.ArrivalTime),
 				DepartureTime: dateTimeRangeBoundariesToProto(timeBoundaries.DepartureTime),
 				TripDuration:  rangeBoundariesToProto((times.RangeBoundaries)(timeBoundaries.TripDuration)),
@@ -839,13 +877,13 @@ func boundariesToProto(bound *boundaries.Boundaries) *Boundaries {
 	}
 
 	return &Boundaries{
-		Agents:                      convMap(bound.Agents, convNum[int, int64], same[float64]),
-		Airlines:                    convMap(bound.Airlines, convStringer[iata.AirlineID], same[float64]),
-		Alliances:                   convMap(bound.Alliances, convNum[int, int64], same[float64]),
+		Agents:                      conv.Map(bound.Agents, conv.Cast[int, int64], conv.Same[float64]),
+		Airlines:                    conv.Map(bound.Airlines, conv.Stringer[iata.AirlineID], conv.Same[float64]),
+		Alliances:                   conv.Map(bound.Alliances, conv.Cast[int, int64], conv.Same[float64]),
 		HasInterlines:               bound.HasInterlines,
 		HasLowcosts:                 bound.HasLowcosts,
 		Airports:                    airports,
-		SameDepartureArrivalAirport: convMap(bound.SameDepartureArrivalAirport, convStringer[iata.LocationIATACode], same[float64]),
+		SameDepartureArrivalAirport: conv.Map(bound.SameDepartureArrivalAirport, conv.Stringer[iata.LocationIATACode], conv.Same[float64]),
 		Baggage: &BaggageBoundaries{
 			FullBaggage:  bound.Baggage.FullBaggage,
 			NoBaggage:    bound.Baggage.NoBaggage,
@@ -865,7 +903,7 @@ func boundariesToProto(bound *boundaries.Boundaries) *Boundaries {
 		},
 		TransfersCount:                   bound.TransfersCount,
 		TransfersDuration:                transferDurationBoundariesToProto(bound.TransfersDuration),
-		TransfersAirports:                convMap(bound.TransfersAirports, convStringer[iata.LocationIATACode], same[float64]),
+		TransfersAirports:                conv.Map(bound.TransfersAirports, conv.Stringer[iata.LocationIATACode], conv.Same[float64]),
 		TransfersCountries:               bound.TransfersCountries,
 		HasTransfersWithAirportChange:    bound.HasTransfersWithAirportChange,
 		HasTransfersWithBaggageRecheck:   bound.HasTransfersWithBaggageRecheck,
@@ -884,21 +922,21 @@ func filterState(state *filter.State) *FilterState {
 		return nil
 	}
 	return &FilterState{
-		Agents:                          convArray(state.Agents, convNum[int, int64]),
+		Agents:                          conv.Array(state.Agents, conv.Cast[int, int64]),
 		Airlines:                        state.Airlines,
-		Alliances:                       convArray(state.Alliances, convNum[int, int64]),
+		Alliances:                       conv.Array(state.Alliances, conv.Cast[int, int64]),
 		WithoutInterlines:               state.WithoutInterlines,
 		WithoutLowcosts:                 state.WithoutLowcosts,
-		Segments:                        convMap(state.Segments, convNum[int, int64], segmentFilterToProto),
+		Segments:                        conv.Map(state.Segments, conv.Cast[int, int64], segmentFilterToProto),
 		WithSameDepartureArrivalAirport: state.WithSameDepartureArrivalAirport,
 		Equipments:                      state.Equipments,
 		PaymentMethods:                  state.PaymentMethods,
 		PinFlightSignatures:             state.PinFlightSignatures,
-		Price: convArray(state.Price, func(v1 filter.FloatRange) *FloatRange {
+		Price: conv.Array(state.Price, func(v1 filter.FloatRange) *FloatRange {
 			return (*FloatRange)(floatRangeToProto(v1))
 		}),
-		TransfersCount:                   convArray(state.TransfersCount, convNum[int, int64]),
-		TransfersDuration:                convArray(state.TransfersDuration, rangeToProto),
+		TransfersCount:                   conv.Array(state.TransfersCount, conv.Cast[int, int64]),
+		TransfersDuration:                conv.Array(state.TransfersDuration, rangeToProto),
 		TransfersWithoutAirportChange:    state.TransfersWithoutAirportChange,
 		TransfersWithoutBaggageRecheck:   state.TransfersWithoutBaggageRecheck,
 		TransfersWithoutVisa:             state.TransfersWithoutVisa,
@@ -921,10 +959,10 @@ func segmentFilterToProto(f filter.SegmentFilter) *SegmentFilter {
 	return &SegmentFilter{
 		AirportsArrival:   f.AirportsArrival,
 		AirportsDeparture: f.AirportsDeparture,
-		ArrivalTime:       convArray(f.ArrivalTime, dateTimeOrTimeRangeToProto),
-		ArrivalDate:       convArray(f.ArrivalDate, convStringer[datetime.Date]),
-		DepartureTime:     convArray(f.DepartureTime, dateTimeRangeToProto),
-		TripDuration:      convArray(f.TripDuration, rangeToProto),
+		ArrivalTime:       conv.Array(f.ArrivalTime, dateTimeOrTimeRangeToProto),
+		ArrivalDate:       conv.Array(f.ArrivalDate, conv.Stringer[datetime.Date]),
+		DepartureTime:     conv.Array(f.DepartureTime, dateTimeRangeToProto),
+		TripDuration:      conv.Array(f.TripDuration, rangeToProto),
 	}
 }
 
@@ -936,18 +974,22 @@ func dateTimeOrTimeRangeToProto(d filter.DateTimeOrTimeRange) *DateTimeOrTimeRan
 }
 
 func dateTimeRangeToProto(d filter.DateTimeRange) *DateTimeRange {
-	var min, max int64
-	if d.Min != nil {
-		min = d.Min.Unix()
-	}
-	if d.Max != nil {
-		max = d.Max.Unix()
+	return &DateTimeRange{
+		Min: unixToProto(d.Min),
+		Max: unixToProto(d.Max),
 	}
+}
 
-	return &DateTimeRange{
-		Min: min,
-		Max: max,
+func unixToProto(t *time.Time) *int64 {
+	return conv.OptPtr(t, time.Time.Unix)
+}
+
+// timeToProto keeps the zero time unset
+func timeToProto(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
 	}
+	return timestamppb.New(t)
 }
 
 func rangeToProto(r filter.Range) *Range {
@@ -967,45 +1009,3 @@ func timeBucketsToProto(buckets *filter.TimeBuckets) *TimeBuckets {
 		TripDurationTimeBucketWidth: int64(buckets.TripDurationTimeBucketWidth),
 	}
 }
-
-func convMap[K1 comparable, K2 comparable, V1 any, V2 any](from map[K1]V1, k func(K1) K2, v func(V1) V2) map[K2]V2 {
-	if from == nil {
-		return nil
-	}
-	result := make(map[K2]V2, len(from))
-	for k1, v1 := range from {
-		result[k(k1)] = v(v1)
-	}
-	return result
-}
-
-func convArray[V1 any, V2 any](from []V1, conv func(V1) V2) []V2 {
-	if from == nil {
-		return nil
-	}
-	result := make([]V2, len(from))
-	for i, v1 := range from {
-		result[i] = conv(v1)
-	}
-	return result
-}
-
-func same[A any](val A) A {
-	return val
-}
-
-func convString[V1 ~string](val V1) string {
-	return string(val)
-}
-
-func convStringer[V1 fmt.Stringer](val V1) string {
-	return val.String()
-}
-
-type Number interface {
-	constraints.Integer | constraints.Float
-}
-
-func convNum[A Number, B Number](a A) B {
-	return B(a)
-}
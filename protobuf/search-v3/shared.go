@@ -0,0 +1,278 @@
+package search_v3
+
+import (
+	"github.com/pkg/errors"
+	"golang.org/x/exp/constraints"
+	"golang.org/x/exp/slices"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Consecutive chunks of a search repeat almost the same reference data: places, airlines, agents, alliances
+// and equipments. SharedChunk of shared.proto carries only the entries the client hasn't received yet,
+// the entries sent before are referenced by their keys
+
+var ErrUnknownReference = errors.New("reference to an entry not received before")
+
+// ReferenceEncoder strips reference entries sent with previous chunks of the search, one encoder serves one search
+type ReferenceEncoder struct {
+	airports        map[string]string
+	cities          map[string]string
+	countries       map[string]string
+	metroAreas      map[string]string
+	airportsToMetro map[string]string
+	airlines        map[string]string
+	agents          map[int64]string
+	alliances       map[int64]string
+	equipments      map[string]string
+}
+
+func NewReferenceEncoder() *ReferenceEncoder {
+	return &ReferenceEncoder{
+		airports:        map[string]string{},
+		cities:          map[string]string{},
+		countries:       map[string]string{},
+		metroAreas:      map[string]string{},
+		airportsToMetro: map[string]string{},
+		airlines:        map[string]string{},
+		agents:          map[int64]string{},
+		alliances:       map[int64]string{},
+		equipments:      map[string]string{},
+	}
+}
+
+// Encode strips chunk of the entries sent before. The chunk is left intact, the result shares all the other fields with it
+func (e *ReferenceEncoder) Encode(chunk *Chunk) (*SharedChunk, error) {
+	stripped := shallowCopy(chunk)
+	result := &SharedChunk{Chunk: stripped, Reused: &References{}}
+	var err error
+	if stripped.Airlines, result.Reused.Airlines, err = share(chunk.Airlines, e.airlines, fingerprint[*AirlineInfo]); err != nil {
+		return nil, err
+	}
+	if stripped.Agents, result.Reused.Agents, err = share(chunk.Agents, e.agents, fingerprint[*AgentInfo]); err != nil {
+		return nil, err
+	}
+	if stripped.Alliances, result.Reused.Alliances, err = share(chunk.Alliances, e.alliances, fingerprint[*Alliance]); err != nil {
+		return nil, err
+	}
+	if stripped.Equipments, result.Reused.Equipments, err = share(chunk.Equipments, e.equipments, fingerprint[*Equipment]); err != nil {
+		return nil, err
+	}
+	if chunk.Places == nil {
+		return result, nil
+	}
+
+	places := &Places{}
+	stripped.Places = places
+	if places.Airports, result.Reused.Airports, err = share(chunk.Places.Airports, e.airports, fingerprint[*AirportInfo]); err != nil {
+		return nil, err
+	}
+	if places.Cities, result.Reused.Cities, err = share(chunk.Places.Cities, e.cities, fingerprint[*CityInfo]); err != nil {
+		return nil, err
+	}
+	if places.Countries, result.Reused.Countries, err = share(chunk.Places.Countries, e.countries, fingerprint[*CountryInfo]); err != nil {
+		return nil, err
+	}
+	if places.MetroAreas, result.Reused.MetroAreas, err = share(chunk.Places.MetroAreas, e.metroAreas, fingerprint[*MetroAreaInfo]); err != nil {
+		return nil, err
+	}
+	if places.AirportsToMetro, result.Reused.AirportsToMetro, err = share(chunk.Places.AirportsToMetro, e.airportsToMetro, stringFingerprint); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// shallowCopy copies fields of chunk without copying their values
+func shallowCopy(chunk *Chunk) *Chunk {
+	result := &Chunk{}
+	target := result.ProtoReflect()
+	chunk.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		target.Set(fd, value)
+		return true
+	})
+	return result
+}
+
+// share splits entries into the ones to send and the keys of the ones sent before with the same content
+func share[K constraints.Ordered, V any](entries map[K]V, sent map[K]string, fingerprint func(V) (string, error)) (map[K]V, []K, error) {
+	if entries == nil {
+		return nil, nil, nil
+	}
+	fresh := make(map[K]V)
+	var reused []K
+	for key, value := range entries {
+		current, err := fingerprint(value)
+		if err != nil {
+			return nil, nil, err
+		}
+		if previous, ok := sent[key]; ok && previous == current {
+			reused = append(reused, key)
+			continue
+		}
+		sent[key] = current
+		fresh[key] = value
+	}
+	slices.Sort(reused)
+	return fresh, reused, nil
+}
+
+func fingerprint[T proto.Message](value T) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(value)
+	return string(data), errors.WithStack(err)
+}
+
+func stringFingerprint(value string) (string, error) {
+	return value, nil
+}
+
+// ReferenceDecoder rebuilds complete chunks from the entries received with previous chunks of the search
+type ReferenceDecoder struct {
+	airports        map[string]*AirportInfo
+	cities          map[string]*CityInfo
+	countries       map[string]*CountryInfo
+	metroAreas      map[string]*MetroAreaInfo
+	airportsToMetro map[string]string
+	airlines        map[string]*AirlineInfo
+	agents          map[int64]*AgentInfo
+	alliances       map[int64]*Alliance
+	equipments      map[string]*Equipment
+}
+
+func NewReferenceDecoder() *ReferenceDecoder {
+	return &ReferenceDecoder{
+		airports:        map[string]*AirportInfo{},
+		cities:          map[string]*CityInfo{},
+		countries:       map[string]*CountryInfo{},
+		metroAreas:      map[string]*MetroAreaInfo{},
+		airportsToMetro: map[string]string{},
+		airlines:        map[string]*AirlineInfo{},
+		agents:          map[int64]*AgentInfo{},
+		alliances:       map[int64]*Alliance{},
+		equipments:      map[string]*Equipment{},
+	}
+}
+
+// Decode completes reference maps of the shared chunk in place and returns the chunk.
+// Chunks must be decoded in the order they are encoded. Entries are shared between the decoded chunks
+func (d *ReferenceDecoder) Decode(shared *SharedChunk) (*Chunk, error) {
+	chunk, reused := shared.GetChunk(), shared.GetReused()
+	if chunk == nil {
+		return nil, errors.New("shared chunk has no chunk")
+	}
+	var err error
+	if chunk.Airlines, err = restore("airlines", chunk.Airlines, reused.GetAirlines(), d.airlines); err != nil {
+		return nil, err
+	}
+	if chunk.Agents, err = restore("agents", chunk.Agents, reused.GetAgents(), d.agents); err != nil {
+		return nil, err
+	}
+	if chunk.Alliances, err = restore("alliances", chunk.Alliances, reused.GetAlliances(), d.alliances); err != nil {
+		return nil, err
+	}
+	if chunk.Equipments, err = restore("equipments", chunk.Equipments, reused.GetEquipments(), d.equipments); err != nil {
+		return nil, err
+	}
+
+	places := chunk.Places
+	if places == nil {
+		if !reused.hasPlaces() {
+			return chunk, nil
+		}
+		places = &Places{}
+		chunk.Places = places
+	}
+	if places.Airports, err = restore("places.airports", places.Airports, reused.GetAirports(), d.airports); err != nil {
+		return nil, err
+	}
+	if places.Cities, err = restore("places.cities", places.Cities, reused.GetCities(), d.cities); err != nil {
+		return nil, err
+	}
+	if places.Countries, err = restore("places.countries", places.Countries, reused.GetCountries(), d.countries); err != nil {
+		return nil, err
+	}
+	if places.MetroAreas, err = restore("places.metro_areas", places.MetroAreas, reused.GetMetroAreas(), d.metroAreas); err != nil {
+		return nil, err
+	}
+	if places.AirportsToMetro, err = restore("places.airports_to_metro", places.AirportsToMetro, reused.GetAirportsToMetro(), d.airportsToMetro); err != nil {
+		return nil, err
+	}
+	return chunk, nil
+}
+
+// restore remembers received entries and adds the reused ones
+func restore[K comparable, V any](name string, entries map[K]V, reused []K, received map[K]V) (map[K]V, error) {
+	for key, value := range entries {
+		received[key] = value
+	}
+	if len(reused) == 0 {
+		return entries, nil
+	}
+	if entries == nil {
+		entries = make(map[K]V, len(reused))
+	}
+	for _, key := range reused {
+		value, ok := received[key]
+		if !ok {
+			return nil, errors.Wrapf(ErrUnknownReference, "%s: %v", name, key)
+		}
+		entries[key] = value
+	}
+	return entries, nil
+}
+
+func (r *References) hasPlaces() bool {
+	return len(r.GetAirports())+len(r.GetCities())+len(r.GetCountries())+len(r.GetMetroAreas())+len(r.GetAirportsToMetro()) > 0
+}
+
+// EncodeShared encodes chunks of the search one by one
+func EncodeShared(results *SearchResults) ([]*SharedChunk, error) {
+	encoder := NewReferenceEncoder()
+	result := make([]*SharedChunk, 0, len(results.Chunks))
+	for _, chunk := range results.Chunks {
+		shared, err := encoder.Encode(chunk)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, shared)
+	}
+	return result, nil
+}
+
+// DecodeShared rebuilds complete chunks of the search
+func DecodeShared(chunks []*SharedChunk) (*SearchResults, error) {
+	decoder := NewReferenceDecoder()
+	result := &SearchResults{Chunks: make([]*Chunk, 0, len(chunks))}
+	for _, shared := range chunks {
+		chunk, err := decoder.Decode(shared)
+		if err != nil {
+			return nil, err
+		}
+		result.Chunks = append(result.Chunks, chunk)
+	}
+	return result, nil
+}
+
+// consumeFields calls field for every field of the message, value of a length-delimited field is its content
+func consumeFields(data []byte, field func(number protowire.Number, wireType protowire.Type, value []byte) error) error {
+	for len(data) > 0 {
+		number, wireType, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return errors.WithStack(protowire.ParseError(n))
+		}
+		data = data[n:]
+		m := protowire.ConsumeFieldValue(number, wireType, data)
+		if m < 0 {
+			return errors.WithStack(protowire.ParseError(m))
+		}
+		value := data[:m]
+		if wireType == protowire.BytesType {
+			value, _ = protowire.ConsumeBytes(value)
+		}
+		if err := field(number, wireType, value); err != nil {
+			return err
+		}
+		data = data[m:]
+	}
+	return nil
+}
@@ -0,0 +1,305 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.12
+// source: protobuf/search-v3/shared.proto
+
+package search_v3
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Chunk of a search without the reference entries sent with its previous chunks, see ReferenceEncoder
+type SharedChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chunk  *Chunk      `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"` // reference maps hold only new and changed entries
+	Reused *References `protobuf:"bytes,2,opt,name=reused,proto3" json:"reused,omitempty"`
+}
+
+func (x *SharedChunk) Reset() {
+	*x = SharedChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_search_v3_shared_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SharedChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedChunk) ProtoMessage() {}
+
+func (x *SharedChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_search_v3_shared_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedChunk.ProtoReflect.Descriptor instead.
+func (*SharedChunk) Descriptor() ([]byte, []int) {
+	return file_protobuf_search_v3_shared_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SharedChunk) GetChunk() *Chunk {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+func (x *SharedChunk) GetReused() *References {
+	if x != nil {
+		return x.Reused
+	}
+	return nil
+}
+
+// Keys of the reference entries sent before, sorted
+type References struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Airports        []string `protobuf:"bytes,1,rep,name=airports,proto3" json:"airports,omitempty"`
+	Cities          []string `protobuf:"bytes,2,rep,name=cities,proto3" json:"cities,omitempty"`
+	Countries       []string `protobuf:"bytes,3,rep,name=countries,proto3" json:"countries,omitempty"`
+	MetroAreas      []string `protobuf:"bytes,4,rep,name=metro_areas,json=metroAreas,proto3" json:"metro_areas,omitempty"`
+	AirportsToMetro []string `protobuf:"bytes,5,rep,name=airports_to_metro,json=airportsToMetro,proto3" json:"airports_to_metro,omitempty"`
+	Airlines        []string `protobuf:"bytes,6,rep,name=airlines,proto3" json:"airlines,omitempty"`
+	Agents          []int64  `protobuf:"varint,7,rep,packed,name=agents,proto3" json:"agents,omitempty"`
+	Alliances       []int64  `protobuf:"varint,8,rep,packed,name=alliances,proto3" json:"alliances,omitempty"`
+	Equipments      []string `protobuf:"bytes,9,rep,name=equipments,proto3" json:"equipments,omitempty"`
+}
+
+func (x *References) Reset() {
+	*x = References{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_search_v3_shared_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *References) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*References) ProtoMessage() {}
+
+func (x *References) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_search_v3_shared_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use References.ProtoReflect.Descriptor instead.
+func (*References) Descriptor() ([]byte, []int) {
+	return file_protobuf_search_v3_shared_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *References) GetAirports() []string {
+	if x != nil {
+		return x.Airports
+	}
+	return nil
+}
+
+func (x *References) GetCities() []string {
+	if x != nil {
+		return x.Cities
+	}
+	return nil
+}
+
+func (x *References) GetCountries() []string {
+	if x != nil {
+		return x.Countries
+	}
+	return nil
+}
+
+func (x *References) GetMetroAreas() []string {
+	if x != nil {
+		return x.MetroAreas
+	}
+	return nil
+}
+
+func (x *References) GetAirportsToMetro() []string {
+	if x != nil {
+		return x.AirportsToMetro
+	}
+	return nil
+}
+
+func (x *References) GetAirlines() []string {
+	if x != nil {
+		return x.Airlines
+	}
+	return nil
+}
+
+func (x *References) GetAgents() []int64 {
+	if x != nil {
+		return x.Agents
+	}
+	return nil
+}
+
+func (x *References) GetAlliances() []int64 {
+	if x != nil {
+		return x.Alliances
+	}
+	return nil
+}
+
+func (x *References) GetEquipments() []string {
+	if x != nil {
+		return x.Equipments
+	}
+	return nil
+}
+
+var File_protobuf_search_v3_shared_proto protoreflect.FileDescriptor
+
+var file_protobuf_search_v3_shared_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x2d, 0x76, 0x33, 0x2f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x09, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x1a, 0x20, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x2d, 0x76, 0x33,
+	0x2f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x64,
+	0x0a, 0x0b, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x26, 0x0a,
+	0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76, 0x33, 0x2e, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x52, 0x05,
+	0x63, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x2d, 0x0a, 0x06, 0x72, 0x65, 0x75, 0x73, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x76,
+	0x33, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x06, 0x72, 0x65,
+	0x75, 0x73, 0x65, 0x64, 0x22, 0x9d, 0x02, 0x0a, 0x0a, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x06, 0x63, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x74, 0x72, 0x6f, 0x5f, 0x61,
+	0x72, 0x65, 0x61, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x74, 0x72,
+	0x6f, 0x41, 0x72, 0x65, 0x61, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x6f, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0f, 0x61, 0x69, 0x72, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x54, 0x6f, 0x4d, 0x65, 0x74,
+	0x72, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x69, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x61, 0x69, 0x72, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x03, 0x52, 0x06,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x69, 0x61, 0x6e,
+	0x63, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x03, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x69, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x71, 0x75, 0x69, 0x70, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x42, 0x0e, 0x5a, 0x0c, 0x2e, 0x2e, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x2d, 0x76, 0x33, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_protobuf_search_v3_shared_proto_rawDescOnce sync.Once
+	file_protobuf_search_v3_shared_proto_rawDescData = file_protobuf_search_v3_shared_proto_rawDesc
+)
+
+func file_protobuf_search_v3_shared_proto_rawDescGZIP() []byte {
+	file_protobuf_search_v3_shared_proto_rawDescOnce.Do(func() {
+		file_protobuf_search_v3_shared_proto_rawDescData = protoimpl.X.CompressGZIP(file_protobuf_search_v3_shared_proto_rawDescData)
+	})
+	return file_protobuf_search_v3_shared_proto_rawDescData
+}
+
+var file_protobuf_search_v3_shared_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_protobuf_search_v3_shared_proto_goTypes = []interface{}{
+	(*SharedChunk)(nil), // 0: search_v3.SharedChunk
+	(*References)(nil),  // 1: search_v3.References
+	(*Chunk)(nil),       // 2: search_v3.Chunk
+}
+var file_protobuf_search_v3_shared_proto_depIdxs = []int32{
+	2, // 0: search_v3.SharedChunk.chunk:type_name -> search_v3.Chunk
+	1, // 1: search_v3.SharedChunk.reused:type_name -> search_v3.References
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_protobuf_search_v3_shared_proto_init() }
+func file_protobuf_search_v3_shared_proto_init() {
+	if File_protobuf_search_v3_shared_proto != nil {
+		return
+	}
+	file_protobuf_search_v3_results_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_protobuf_search_v3_shared_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SharedChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_search_v3_shared_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*References); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_protobuf_search_v3_shared_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_protobuf_search_v3_shared_proto_goTypes,
+		DependencyIndexes: file_protobuf_search_v3_shared_proto_depIdxs,
+		MessageInfos:      file_protobuf_search_v3_shared_proto_msgTypes,
+	}.Build()
+	File_protobuf_search_v3_shared_proto = out.File
+	file_protobuf_search_v3_shared_proto_rawDesc = nil
+	file_protobuf_search_v3_shared_proto_goTypes = nil
+	file_protobuf_search_v3_shared_proto_depIdxs = nil
+}
@@ -0,0 +1,143 @@
+package search_v3
+
+import (
+	"compress/gzip"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	"go-playground/protobuf/conv"
+	"go-playground/protobuf/utils"
+	"testing"
+)
+
+// sharedRoundTrip encodes chunks, passes them through the wire and decodes them back, returning total encoded size
+func sharedRoundTrip(t *testing.T, results *SearchResults) int {
+	original := proto.Clone(results)
+	shared, err := EncodeShared(results)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(original, results), "encoding leaves chunks intact")
+
+	size := 0
+	received := make([]*SharedChunk, len(shared))
+	for i, chunk := range shared {
+		data, err := chunk.MarshalVT()
+		require.NoError(t, err)
+		size += len(data)
+		received[i] = &SharedChunk{}
+		require.NoError(t, received[i].UnmarshalVT(data))
+		require.True(t, proto.Equal(chunk.Reused, received[i].Reused))
+	}
+
+	decoded, err := DecodeShared(received)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(results, decoded))
+	return size
+}
+
+func TestSharedRoundTrip(t *testing.T) {
+	for name, results := range map[string]*SearchResults{
+		"dump":      resultsToProto(readDumpStruct()),
+		"generated": resultsToProto(GenerateResults(generatorConfig)),
+	} {
+		full := 0
+		for _, chunk := range results.Chunks {
+			full += chunk.SizeVT()
+		}
+		shared := sharedRoundTrip(t, results)
+		t.Logf("%s: %d bytes of chunks, %d bytes of shared chunks", name, full, shared)
+		// The first chunk of the dump has no places and airlines, so there is next to nothing to share
+		if name == "generated" {
+			require.Less(t, shared, full, name)
+		}
+	}
+}
+
+func TestSharedSecondChunk(t *testing.T) {
+	results := resultsToProto(GenerateResults(generatorConfig))
+	shared, err := EncodeShared(results)
+	require.NoError(t, err)
+
+	first, second := shared[0], shared[1]
+	require.True(t, proto.Equal(&References{}, first.Reused))
+	require.True(t, proto.Equal(results.Chunks[0].Places, first.Chunk.Places))
+
+	// The generator repeats the same places, airlines, alliances and equipments in every chunk
+	require.Empty(t, second.Chunk.Places.Airports)
+	require.Empty(t, second.Chunk.Airlines)
+	require.Len(t, second.Reused.Airports, len(results.Chunks[1].Places.Airports))
+	require.Len(t, second.Reused.Airlines, len(results.Chunks[1].Airlines))
+	require.IsIncreasing(t, second.Reused.Airports)
+	// Agents get random payment methods per chunk, the changed ones are sent again
+	require.Equal(t, len(results.Chunks[1].Agents), len(second.Chunk.Agents)+len(second.Reused.Agents))
+	require.NotEmpty(t, second.Chunk.Agents)
+}
+
+func TestSharedChangedAndMissingEntries(t *testing.T) {
+	results := resultsToProto(GenerateResults(generatorConfig))
+	third := proto.Clone(results.Chunks[0]).(*Chunk)
+	for code, airport := range third.Places.Airports {
+		airport.Code = "changed"
+		third.Places.Airports = map[string]*AirportInfo{code: airport}
+		break
+	}
+	third.Airlines = nil
+	third.Places.Cities = nil
+	results.Chunks = append(results.Chunks[:2], third)
+
+	shared, err := EncodeShared(results)
+	require.NoError(t, err)
+	require.Len(t, shared[2].Chunk.Places.Airports, 1)
+	require.Empty(t, shared[2].Reused.Airports)
+	sharedRoundTrip(t, results)
+}
+
+func TestSharedUnknownReference(t *testing.T) {
+	results := resultsToProto(GenerateResults(generatorConfig))
+	shared, err := EncodeShared(results)
+	require.NoError(t, err)
+
+	_, err = NewReferenceDecoder().Decode(shared[1])
+	require.ErrorIs(t, err, ErrUnknownReference)
+}
+
+func BenchmarkShared(b *testing.B) {
+	benchmarkChunkSizes(b, resultsToProto(GenerateResults(DefaultGeneratorConfig())), "shared", func(results *SearchResults) ([][]byte, error) {
+		shared, err := EncodeShared(results)
+		if err != nil {
+			return nil, err
+		}
+		return conv.ArrayE(shared, (*SharedChunk).MarshalVT)
+	})
+}
+
+// benchmarkChunkSizes times encode and reports raw and gzip sizes of the chunks it encodes, named after format,
+// next to the sizes of the chunks marshaled as they are
+func benchmarkChunkSizes(b *testing.B, results *SearchResults, format string, encode func(results *SearchResults) ([][]byte, error)) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	var encoded [][]byte
+	for i := 0; i < b.N; i++ {
+		encoded = utils.Must2(encode(results))
+	}
+	b.StopTimer()
+
+	var full, size, fullGzip, sizeGzip int
+	for i, data := range encoded {
+		original := utils.Must2(results.Chunks[i].MarshalVT())
+		full += len(original)
+		fullGzip += len(utils.Must2(utils.CompressGZIP(original, gzip.DefaultCompression)))
+		size += len(data)
+		sizeGzip += len(utils.Must2(utils.CompressGZIP(data, gzip.DefaultCompression)))
+	}
+	b.ReportMetric(float64(full), "chunks-bytes")
+	b.ReportMetric(float64(size), format+"-bytes")
+	b.ReportMetric(float64(fullGzip), "chunks-gzip-bytes")
+	b.ReportMetric(float64(sizeGzip), format+"-gzip-bytes")
+}
+
+// eachChunk encodes chunks one by one for benchmarkChunkSizes
+func eachChunk(marshal func(chunk *Chunk) ([]byte, error)) func(results *SearchResults) ([][]byte, error) {
+	return func(results *SearchResults) ([][]byte, error) {
+		return conv.ArrayE(results.Chunks, marshal)
+	}
+}
@@ -0,0 +1,217 @@
+package search_v3
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/binary"
+	"github.com/pkg/errors"
+	"go-playground/protobuf/utils"
+	"io"
+)
+
+// Chunk stream is a sequence of frames, so a client can render chunks as they arrive:
+//
+//	uvarint(len(compression) + len(body)) | compression byte | body
+//
+// where body is a Chunk marshaled with MarshalVT and compressed according to the compression byte
+
+type FrameCompression byte
+
+const (
+	FrameUncompressed FrameCompression = iota
+	FrameGZIP
+)
+
+// DefaultMaxFrameSize limits both the frame on the wire and the decompressed chunk unless ChunkReaderOptions.MaxFrameSize is set
+const DefaultMaxFrameSize = 64 << 20
+
+var (
+	ErrFrameTooLarge           = errors.New("chunk frame exceeds the size limit")
+	ErrFrameTruncated          = errors.New("chunk frame is truncated")
+	ErrUnknownFrameCompression = errors.New("unknown chunk frame compression")
+)
+
+type ChunkWriterOptions struct {
+	Compression FrameCompression
+	// Level of gzip compression, gzip.DefaultCompression when zero
+	Level int
+	// MinCompressSize leaves smaller chunks uncompressed, compression doesn't pay off for them
+	MinCompressSize int
+}
+
+type ChunkWriter struct {
+	w       io.Writer
+	opts    ChunkWriterOptions
+	encoded []byte
+	frame   []byte
+}
+
+func NewChunkWriter(w io.Writer, opts ChunkWriterOptions) *ChunkWriter {
+	if opts.Level == 0 {
+		opts.Level = gzip.DefaultCompression
+	}
+	return &ChunkWriter{w: w, opts: opts}
+}
+
+// Write marshals chunk into a single frame
+func (w *ChunkWriter) Write(chunk *Chunk) error {
+	size := chunk.SizeVT()
+	if cap(w.encoded) < size {
+		w.encoded = make([]byte, size)
+	}
+	w.encoded = w.encoded[:size]
+	if _, err := chunk.MarshalToSizedBufferVT(w.encoded); err != nil {
+		return errors.WithStack(err)
+	}
+
+	compression := w.opts.Compression
+	if size < w.opts.MinCompressSize {
+		compression = FrameUncompressed
+	}
+
+	// Reserve the longest length prefix, so the body is written in place and the prefix is moved right before it
+	body := append(w.frame[:0], make([]byte, binary.MaxVarintLen64)...)
+	body = append(body, byte(compression))
+	switch compression {
+	case FrameUncompressed:
+		body = append(body, w.encoded...)
+	case FrameGZIP:
+		var err error
+		if body, err = utils.AppendCompressedGZIP(body, w.encoded, w.opts.Level); err != nil {
+			return err
+		}
+	default:
+		return errors.Wrapf(ErrUnknownFrameCompression, "compression %d", compression)
+	}
+	w.frame = body
+
+	length := len(body) - binary.MaxVarintLen64
+	var prefix [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(prefix[:], uint64(length))
+	start := binary.MaxVarintLen64 - n
+	copy(body[start:], prefix[:n])
+
+	_, err := w.w.Write(body[start:])
+	return errors.WithStack(err)
+}
+
+// WriteChunks writes every chunk of results as a separate frame
+func WriteChunks(w io.Writer, results *SearchResults, opts ChunkWriterOptions) error {
+	writer := NewChunkWriter(w, opts)
+	for _, chunk := range results.Chunks {
+		if err := writer.Write(chunk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type ChunkReaderOptions struct {
+	// MaxFrameSize of both the frame and the decompressed chunk, DefaultMaxFrameSize when zero
+	MaxFrameSize int
+}
+
+// ChunkReader reads chunk frames one by one:
+//
+//	for reader.Next() {
+//		render(reader.Chunk())
+//	}
+//	if err := reader.Err(); err != nil {
+//		...
+//	}
+type ChunkReader struct {
+	r            *bufio.Reader
+	maxFrameSize int
+	frame        []byte
+	decompressed []byte
+	chunk        *Chunk
+	err          error
+}
+
+func NewChunkReader(r io.Reader, opts ChunkReaderOptions) *ChunkReader {
+	if opts.MaxFrameSize == 0 {
+		opts.MaxFrameSize = DefaultMaxFrameSize
+	}
+	return &ChunkReader{
+		r:            bufio.NewReader(r),
+		maxFrameSize: opts.MaxFrameSize,
+	}
+}
+
+// Next reads the next chunk, it returns false at the end of the stream or on error
+func (r *ChunkReader) Next() bool {
+	if r.err != nil {
+		return false
+	}
+	r.chunk, r.err = r.next()
+	return r.err == nil
+}
+
+// Chunk returns the chunk read by the last Next call, it is never reused by the reader
+func (r *ChunkReader) Chunk() *Chunk {
+	return r.chunk
+}
+
+// Err returns the first error of the stream, the end of the stream is not an error
+func (r *ChunkReader) Err() error {
+	if r.err == io.EOF {
+		return nil
+	}
+	return r.err
+}
+
+func (r *ChunkReader) next() (*Chunk, error) {
+	length, err := binary.ReadUvarint(r.r)
+	if err == io.EOF {
+		return nil, io.EOF
+	}
+	if err != nil {
+		return nil, errors.Wrap(ErrFrameTruncated, err.Error())
+	}
+	if length > uint64(r.maxFrameSize) {
+		return nil, errors.Wrapf(ErrFrameTooLarge, "frame of %d bytes", length)
+	}
+	if length == 0 {
+		return nil, errors.Wrap(ErrFrameTruncated, "empty frame")
+	}
+
+	if cap(r.frame) < int(length) {
+		r.frame = make([]byte, length)
+	}
+	r.frame = r.frame[:length]
+	if _, err := io.ReadFull(r.r, r.frame); err != nil {
+		return nil, errors.Wrap(ErrFrameTruncated, err.Error())
+	}
+
+	body := r.frame[1:]
+	switch FrameCompression(r.frame[0]) {
+	case FrameUncompressed:
+	case FrameGZIP:
+		r.decompressed, err = utils.AppendDecompressedGZIP(r.decompressed[:0], body, utils.DecompressOptions{MaxSize: int64(r.maxFrameSize)})
+		if errors.Is(err, utils.ErrTooLarge) {
+			return nil, errors.Wrap(ErrFrameTooLarge, err.Error())
+		}
+		if err != nil {
+			return nil, err
+		}
+		body = r.decompressed
+	default:
+		return nil, errors.Wrapf(ErrUnknownFrameCompression, "compression %d", r.frame[0])
+	}
+
+	chunk := &Chunk{}
+	if err := chunk.UnmarshalVT(body); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return chunk, nil
+}
+
+// ReadChunks reads the whole stream back into results
+func ReadChunks(r io.Reader, opts ChunkReaderOptions) (*SearchResults, error) {
+	reader := NewChunkReader(r, opts)
+	results := &SearchResults{}
+	for reader.Next() {
+		results.Chunks = append(results.Chunks, reader.Chunk())
+	}
+	return results, reader.Err()
+}
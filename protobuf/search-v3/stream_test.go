@@ -0,0 +1,110 @@
+package search_v3
+
+import (
+	"bytes"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	"go-playground/protobuf/utils"
+	"io"
+	"testing"
+)
+
+func TestChunkStreamRoundTrip(t *testing.T) {
+	results := resultsToProto(readDumpStruct())
+	for name, opts := range map[string]ChunkWriterOptions{
+		"uncompressed": {},
+		"gzip":         {Compression: FrameGZIP},
+		// The first chunk of the dump is small enough to stay uncompressed
+		"mixed": {Compression: FrameGZIP, MinCompressSize: 100_000},
+	} {
+		var buf bytes.Buffer
+		require.NoError(t, WriteChunks(&buf, results, opts), name)
+
+		reader := NewChunkReader(bytes.NewReader(buf.Bytes()), ChunkReaderOptions{})
+		i := 0
+		for reader.Next() {
+			require.True(t, proto.Equal(reader.Chunk(), results.Chunks[i]), name)
+			i++
+		}
+		require.NoError(t, reader.Err(), name)
+		require.Equal(t, len(results.Chunks), i, name)
+	}
+}
+
+func TestChunkStreamEmpty(t *testing.T) {
+	results, err := ReadChunks(bytes.NewReader(nil), ChunkReaderOptions{})
+	require.NoError(t, err)
+	require.Empty(t, results.Chunks)
+}
+
+func TestChunkStreamErrors(t *testing.T) {
+	results := resultsToProto(GenerateResults(generatorConfig))
+	var buf bytes.Buffer
+	require.NoError(t, WriteChunks(&buf, results, ChunkWriterOptions{Compression: FrameGZIP}))
+	stream := buf.Bytes()
+
+	reader := NewChunkReader(bytes.NewReader(stream[:len(stream)-10]), ChunkReaderOptions{})
+	for reader.Next() {
+	}
+	require.ErrorIs(t, reader.Err(), ErrFrameTruncated)
+	require.False(t, reader.Next())
+
+	// Compressed frames fit, but decompressed chunks don't
+	maxCompressed := 0
+	for _, chunk := range results.Chunks {
+		var frame bytes.Buffer
+		require.NoError(t, NewChunkWriter(&frame, ChunkWriterOptions{Compression: FrameGZIP}).Write(chunk))
+		if frame.Len() > maxCompressed {
+			maxCompressed = frame.Len()
+		}
+	}
+	_, err := ReadChunks(bytes.NewReader(stream), ChunkReaderOptions{MaxFrameSize: maxCompressed})
+	require.ErrorIs(t, err, ErrFrameTooLarge)
+
+	_, err = ReadChunks(bytes.NewReader(stream), ChunkReaderOptions{MaxFrameSize: 100})
+	require.ErrorIs(t, err, ErrFrameTooLarge)
+
+	// Frame of 2 bytes with unknown compression
+	_, err = ReadChunks(bytes.NewReader([]byte{2, 42, 0}), ChunkReaderOptions{})
+	require.ErrorIs(t, err, ErrUnknownFrameCompression)
+	require.ErrorIs(t, NewChunkWriter(io.Discard, ChunkWriterOptions{Compression: 42}).Write(results.Chunks[0]), ErrUnknownFrameCompression)
+
+	// Gzip body with a wrong checksum
+	body := utils.Must2(utils.AppendCompressedGZIP([]byte{byte(FrameGZIP)}, []byte("chunk"), 6))
+	body[len(body)-8] ^= 0xff
+	_, err = ReadChunks(bytes.NewReader(append([]byte{byte(len(body))}, body...)), ChunkReaderOptions{})
+	require.ErrorIs(t, err, utils.ErrCorrupt)
+}
+
+func BenchmarkChunkStream_Write(b *testing.B) {
+	results := resultsToProto(readDumpStruct())
+	for name, opts := range map[string]ChunkWriterOptions{
+		"uncompressed": {},
+		"gzip":         {Compression: FrameGZIP},
+	} {
+		opts := opts
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				WriteChunks(io.Discard, results, opts)
+			}
+		})
+	}
+}
+
+func BenchmarkChunkStream_Read(b *testing.B) {
+	results := resultsToProto(readDumpStruct())
+	for name, opts := range map[string]ChunkWriterOptions{
+		"uncompressed": {},
+		"gzip":         {Compression: FrameGZIP},
+	} {
+		var buf bytes.Buffer
+		utils.Must(WriteChunks(&buf, results, opts))
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				ReadChunks(bytes.NewReader(buf.Bytes()), ChunkReaderOptions{})
+			}
+		})
+	}
+}
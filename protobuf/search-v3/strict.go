@@ -0,0 +1,232 @@
+package search_v3
+
+import (
+	"fmt"
+	"github.com/KosyanMedia/delta/pkg/currency"
+	v3 "github.com/KosyanMedia/delta/search/cmd/results-api/api/v3"
+	"github.com/fatih/structtag"
+	"github.com/pkg/errors"
+	"go-playground/protobuf/conv"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+var (
+	ErrEnumRange = errors.New("enum value out of range")
+	// ErrTruncated is reported for integers which don't fit their proto fields
+	ErrTruncated = conv.ErrOverflow
+)
+
+// ConvertError is a value lost by the conversion, Path is made of json names of the fields like
+// `chunks[0].tickets[3].proposals[1].flight_terms[0].seats_available`
+type ConvertError struct {
+	Path string
+	Err  error
+}
+
+func (e *ConvertError) Error() string {
+	return e.Path + ": " + e.Err.Error()
+}
+
+func (e *ConvertError) Unwrap() error {
+	return e.Err
+}
+
+type ConvertErrors []*ConvertError
+
+func (e ConvertErrors) Error() string {
+	lines := make([]string, len(e))
+	for i, err := range e {
+		lines[i] = err.Error()
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (e ConvertErrors) Is(target error) bool {
+	for _, err := range e {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// ToProtoE converts search results like ToProtoWith and reports the values the proto representation can't hold
+// as ConvertErrors. The results are returned along with the errors unless opts.Strict is set
+func ToProtoE(results v3.SearchResults, opts ConvertOptions) (*SearchResults, error) {
+	converted := ToProtoWith(results, opts)
+	var errs ConvertErrors
+	checkConverted(reflect.ValueOf(results), reflect.ValueOf(converted.Chunks), "chunks", &errs)
+	if len(errs) == 0 {
+		return converted, nil
+	}
+	sort.Slice(errs, func(i, j int) bool { return errs[i].Path < errs[j].Path })
+	if opts.Strict {
+		return nil, errs
+	}
+	return converted, errs
+}
+
+func resultsToProtoE(results v3.SearchResults) (*SearchResults, error) {
+	return ToProtoE(results, ConvertOptions{Strict: true})
+}
+
+// checkConverted walks the original and the converted values side by side like requireDeepEqual,
+// matching struct fields by json names
+func checkConverted(from, to reflect.Value, path string, errs *ConvertErrors) {
+	if !from.IsValid() || !to.IsValid() {
+		return
+	}
+	if from.Kind() == reflect.Ptr || from.Kind() == reflect.Interface {
+		if !from.IsNil() {
+			checkConverted(from.Elem(), to, path, errs)
+		}
+		return
+	}
+	if to.Kind() == reflect.Ptr {
+		if !to.IsNil() {
+			checkConverted(from, to.Elem(), path, errs)
+		}
+		return
+	}
+
+	fail := func(err error, format string, args ...any) {
+		*errs = append(*errs, &ConvertError{Path: path, Err: errors.Wrapf(err, format, args...)})
+	}
+	switch {
+	case from.Type() == currencyAmountType:
+		// Every currency code survives the conversion, the ones missing from Currency in currency_iso_code
+		return
+	case from.Kind() == reflect.Map && to.Kind() == reflect.Struct && to.Type().Name() == "MapStringString":
+		checkConverted(from, to.FieldByName("Map"), path, errs)
+		return
+	case from.Kind() == reflect.Slice && to.Kind() == reflect.Struct:
+		// Nested arrays are wrapped into messages with a single field
+		checkConverted(from, onlyJSONField(to), path, errs)
+		return
+	}
+
+	switch from.Kind() {
+	case reflect.Slice, reflect.Array:
+		if to.Kind() != reflect.Slice && to.Kind() != reflect.Array {
+			return
+		}
+		for i := 0; i < from.Len() && i < to.Len(); i++ {
+			checkConverted(from.Index(i), to.Index(i), fmt.Sprintf("%s[%d]", path, i), errs)
+		}
+	case reflect.Map:
+		if to.Kind() != reflect.Map {
+			return
+		}
+		// Keys are converted to strings and wider integers, which print the same
+		converted := make(map[string]reflect.Value, to.Len())
+		for iter := to.MapRange(); iter.Next(); {
+			converted[fmt.Sprint(iter.Key().Interface())] = iter.Value()
+		}
+		for iter := from.MapRange(); iter.Next(); {
+			key := fmt.Sprint(iter.Key().Interface())
+			if value, ok := converted[key]; ok {
+				checkConverted(iter.Value(), value, fmt.Sprintf("%s[%s]", path, key), errs)
+			}
+		}
+	case reflect.Struct:
+		if to.Kind() != reflect.Struct {
+			return
+		}
+		toFields := jsonFields(to.Type())
+		for name, i := range jsonFields(from.Type()) {
+			if j, ok := toFields[name]; ok {
+				checkConverted(from.Field(i), to.Field(j), path+"."+name, errs)
+			}
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		same, ok := sameInteger(from, to)
+		if !ok {
+			return
+		}
+		if !same {
+			fail(ErrTruncated, "%v became %v", from.Interface(), to.Interface())
+			return
+		}
+		if enum, ok := to.Interface().(protoreflect.Enum); ok {
+			descriptor := enum.Descriptor()
+			if descriptor.Values().ByNumber(enum.Number()) == nil {
+				fail(ErrEnumRange, "%d is not a %s", enum.Number(), descriptor.Name())
+			}
+		}
+	}
+}
+
+// sameInteger reports whether integers from and to are equal, ok is false when to isn't an integer
+func sameInteger(from, to reflect.Value) (same bool, ok bool) {
+	signed := func(v reflect.Value) (int64, bool) {
+		switch v.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return v.Int(), true
+		}
+		return 0, false
+	}
+	unsigned := func(v reflect.Value) (uint64, bool) {
+		switch v.Kind() {
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return v.Uint(), true
+		}
+		return 0, false
+	}
+	if a, ok := signed(from); ok {
+		if b, ok := signed(to); ok {
+			return a == b, true
+		}
+		if b, ok := unsigned(to); ok {
+			return a >= 0 && uint64(a) == b, true
+		}
+		return false, false
+	}
+	a, _ := unsigned(from)
+	if b, ok := signed(to); ok {
+		return b >= 0 && uint64(b) == a, true
+	}
+	if b, ok := unsigned(to); ok {
+		return a == b, true
+	}
+	return false, false
+}
+
+var currencyAmountType = reflect.TypeOf(currency.Amount{})
+
+var jsonFieldsCache sync.Map
+
+// jsonFields maps json names of the fields of struct type t to their indexes
+func jsonFields(t reflect.Type) map[string]int {
+	if cached, ok := jsonFieldsCache.Load(t); ok {
+		return cached.(map[string]int)
+	}
+	result := make(map[string]int)
+	for i := 0; i < t.NumField(); i++ {
+		tags, err := structtag.Parse(string(t.Field(i).Tag))
+		if err != nil {
+			continue
+		}
+		if jsonTag, err := tags.Get("json"); err == nil && jsonTag.Name != "" && jsonTag.Name != "-" {
+			result[jsonTag.Name] = i
+		}
+	}
+	jsonFieldsCache.Store(t, result)
+	return result
+}
+
+// onlyJSONField is the field of a wrapper message
+func onlyJSONField(value reflect.Value) reflect.Value {
+	fields := jsonFields(value.Type())
+	if len(fields) != 1 {
+		return reflect.Value{}
+	}
+	for _, i := range fields {
+		return value.Field(i)
+	}
+	return reflect.Value{}
+}
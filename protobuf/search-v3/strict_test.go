@@ -0,0 +1,96 @@
+package search_v3
+
+import (
+	"github.com/KosyanMedia/delta/pkg/currency"
+	"github.com/KosyanMedia/delta/pkg/types/search/base"
+	v3 "github.com/KosyanMedia/delta/search/cmd/results-api/api/v3"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"go-playground/protobuf/conv"
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestToProtoEValid(t *testing.T) {
+	for name, results := range map[string]v3.SearchResults{
+		"dump":      readDumpStruct(),
+		"generated": GenerateResults(generatorConfig),
+	} {
+		converted, err := resultsToProtoE(results)
+		require.NoError(t, err, name)
+		require.True(t, proto.Equal(resultsToProto(results), converted), name)
+	}
+}
+
+func TestToProtoELostValues(t *testing.T) {
+	results := GenerateResults(generatorConfig)
+	chunk := results[0]
+	var unknown currency.Code
+	require.NoError(t, unknown.UnmarshalJSON([]byte(`"ZZZ"`)))
+	chunk.Tickets[1].Proposals[0].Price.CurrencyCode = unknown
+	chunk.SearchParams.TripClass = base.TripClass(100)
+	chunk.SearchParams.SourceKind = base.SourceKind(100)
+	chunk.Order = v3.Order(100)
+	chunk.Brand = v3.Brand(100)
+	// The cheapest tickets share proposals with the first one, so they lose the same value
+	var leg int
+	terms := map[int]v3.FlightTerm{}
+	for index, term := range chunk.Tickets[0].Proposals[0].FlightTerms {
+		terms[index] = term
+		leg = index
+	}
+	term := terms[leg]
+	term.SeatsAvailable = math.MaxInt32 + 1
+	terms[leg] = term
+	chunk.Tickets[0].Proposals[0].FlightTerms = terms
+	var gate, proposal string
+	var agent int
+found:
+	for gate = range chunk.DebugInfo.Gates {
+		for agent = range chunk.DebugInfo.Gates[gate].Agents {
+			for proposal = range chunk.DebugInfo.Gates[gate].Agents[agent].Proposals {
+				break found
+			}
+		}
+	}
+	require.NotEmpty(t, proposal, "the generator adds debug info of some proposals")
+	agentInfo := chunk.DebugInfo.Gates[gate].Agents[agent]
+	debug := agentInfo.Proposals[proposal]
+	debug.FlightTerms = map[v3.FlightLegIndex]v3.FlightTermDebugInfo{0: {HandbagsSource: v3.TermSource(100)}}
+	agentInfo.Proposals[proposal] = debug
+
+	seats := "proposals[0].flight_terms[" + strconv.Itoa(leg) + "].seats_available"
+	expected := map[string]error{
+		"chunks[0].search_params.trip_class":                   ErrEnumRange,
+		"chunks[0].search_params.source_kind":                  ErrEnumRange,
+		"chunks[0].order":                                      ErrEnumRange,
+		"chunks[0].brand":                                      ErrEnumRange,
+		"chunks[0].tickets[0]." + seats:                        ErrTruncated,
+		"chunks[0].cheapest_ticket." + seats:                   ErrTruncated,
+		"chunks[0].filtered_cheapest_ticket." + seats:          ErrTruncated,
+		"chunks[0].direct_flights[0].cheapest_ticket." + seats: ErrTruncated,
+		"chunks[0].debug_info.gates[" + gate + "].agents[" + strconv.Itoa(agent) + "].proposals[" + proposal + "].flight_terms[0].handbags_source": ErrEnumRange,
+	}
+
+	converted, err := ToProtoE(results, ConvertOptions{})
+	require.NotNil(t, converted, "results are returned along with errors")
+	var errs ConvertErrors
+	require.ErrorAs(t, err, &errs)
+	actual := map[string]error{}
+	for _, e := range errs {
+		actual[e.Path] = errors.Cause(e.Err)
+	}
+	require.Equal(t, expected, actual, err.Error())
+	// Currencies missing from the enum are kept as strings, so ZZZ is not lost
+	require.Equal(t, "ZZZ", converted.Chunks[0].Tickets[1].Proposals[0].Price.CurrencyIsoCode)
+	require.ErrorIs(t, err, ErrTruncated)
+	require.ErrorIs(t, err, conv.ErrOverflow)
+	require.Contains(t, err.Error(), "2147483648 became 0")
+	require.Zero(t, converted.Chunks[0].Tickets[0].Proposals[0].FlightTerms[int64(leg)].SeatsAvailable, "overflown seats are left zero")
+
+	converted, err = resultsToProtoE(results)
+	require.Nil(t, converted)
+	require.ErrorIs(t, err, ErrEnumRange)
+}
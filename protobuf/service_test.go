@@ -1,82 +1,72 @@
 package protobuf
 
 import (
-	"encoding/json"
-	"fmt"
 	"github.com/golang/protobuf/proto"
-	jsoniter "github.com/json-iterator/go"
-	"github.com/mailru/easyjson"
 	"github.com/stretchr/testify/require"
+	"go-playground/protobuf/codec"
+	"go-playground/protobuf/codec/codectest"
 	"go-playground/protobuf/utils"
 	"testing"
 	"time"
 )
 
 const (
+	seed          = 1
 	longStringLen = 5000
 	objectsCount  = 100
 )
 
-var jsonIter = jsoniter.ConfigFastest
-
-// 5014
-func Test_Json_LongString(t *testing.T) {
-	body := JsonLongString{
-		Payload: utils.RandomString(longStringLen),
-	}
-	bodyBytes := utils.Must2(json.Marshal(body))
-
-	fmt.Printf("Json body length: %d\n", len(bodyBytes))
-	utils.GzipAndPrint(bodyBytes)
-	var bodyStruct JsonLongString
-	utils.Must(json.Unmarshal(bodyBytes, &bodyStruct))
-
-	require.Equal(t, longStringLen, len(bodyStruct.Payload))
-}
-
-// 15660
-func Test_Json_LargeResponse(t *testing.T) {
-	body := JsonLargeResponse{
-		Data: genObjects(objectsCount),
+func Test_LongString(t *testing.T) {
+	payload := utils.NewRandom(seed).String(longStringLen)
+	results := codectest.RoundTrip(t, codec.Payload{
+		JSON:     &JsonLongString{Payload: payload},
+		Proto:    &LongString{Payload: payload},
+		NewJSON:  func() any { return &JsonLongString{} },
+		NewProto: func() any { return &LongString{} },
+	})
+	codectest.RequireSizes(t, results, map[string]int{
+		"json":     5014,
+		"easyjson": 5014,
+		"jsoniter": 5014,
+		"proto":    5003,
+		"vtproto":  5003,
+	})
+
+	for name, result := range results {
+		switch decoded := result.Decoded.(type) {
+		case *JsonLongString:
+			require.Equal(t, longStringLen, len(decoded.Payload), name)
+		case *LongString:
+			require.Equal(t, longStringLen, len(decoded.Payload), name)
+		}
+	}
+}
+
+func Test_LargeResponse(t *testing.T) {
+	objects := genObjects(utils.NewRandom(seed), objectsCount)
+	results := codectest.RoundTrip(t, codec.Payload{
+		JSON:     &JsonLargeResponse{Data: objects},
+		Proto:    &LargeResponse{Data: toProto(objects)},
+		NewJSON:  func() any { return &JsonLargeResponse{} },
+		NewProto: func() any { return &LargeResponse{} },
+	})
+	// jsoniter writes float32 with a different precision
+	codectest.RequireSizes(t, results, map[string]int{
+		"json":     15660,
+		"easyjson": 15660,
+		"jsoniter": 15666,
+		"proto":    11893,
+		"vtproto":  11893,
+	})
+
+	for name, result := range results {
+		switch decoded := result.Decoded.(type) {
+		case *JsonLargeResponse:
+			require.Equal(t, objectsCount, len(decoded.Data), name)
+		case *LargeResponse:
+			require.Equal(t, objectsCount, len(decoded.Data), name)
+		}
 	}
-	bodyBytes := utils.Must2(json.Marshal(body))
-
-	fmt.Printf("Json body length: %d\n", len(bodyBytes))
-	utils.GzipAndPrint(bodyBytes)
-	var bodyStruct JsonLargeResponse
-	utils.Must(json.Unmarshal(bodyBytes, &bodyStruct))
-
-	require.Equal(t, objectsCount, len(bodyStruct.Data))
-}
-
-// 5003
-func Test_Protobuf_LongString(t *testing.T) {
-	body := LongString{
-		Payload: utils.RandomString(longStringLen),
-	}
-	bodyBytes := utils.Must2(proto.Marshal(&body))
-
-	fmt.Printf("Protobuf body length: %d\n", len(bodyBytes))
-	utils.GzipAndPrint(bodyBytes)
-	var bodyStruct LongString
-	utils.Must(proto.Unmarshal(bodyBytes, &bodyStruct))
-
-	require.Equal(t, longStringLen, len(bodyStruct.Payload))
-}
-
-// 11893
-func Test_Protobuf_LargeResponse(t *testing.T) {
-	body := LargeResponse{
-		Data: toProto(genObjects(objectsCount)),
-	}
-	bodyBytes := utils.Must2(proto.Marshal(&body))
-
-	fmt.Printf("Protobuf body length: %d\n", len(bodyBytes))
-	utils.GzipAndPrint(bodyBytes)
-	var bodyStruct LargeResponse
-	utils.Must(proto.Unmarshal(bodyBytes, &bodyStruct))
-
-	require.Equal(t, objectsCount, len(bodyStruct.Data))
 }
 
 func Test_Protobuf_Optional(t *testing.T) {
@@ -98,16 +88,18 @@ func Test_Protobuf_Optional(t *testing.T) {
 }
 
 var (
+	random = utils.NewRandom(seed)
+
 	jsonObject = &JsonObject{
 		Id:       15123,
 		Price:    0.412,
 		Datetime: utils.Ptr(time.Date(2022, 12, 23, 4, 51, 24, 0, time.UTC).Unix()),
-		Data:     utils.RandomString(10),
+		Data:     random.String(10),
 	}
 	protoObject = objToProto(jsonObject)
 
 	jsonLargeObject = &JsonLargeResponse{
-		Data: genObjects(150),
+		Data: genObjects(random, 150),
 	}
 	protoLargeObject = &LargeResponse{
 		Data: toProto(jsonLargeObject.Data),
@@ -132,220 +124,71 @@ var (
 			},
 		},
 	}
-)
-
-// JSON: 68 bytes
-// Protobuf: 28 bytes
-// Large JSON: 31046 bytes
-// Large Protobuf: 25665 bytes
-func Test_Bench_Obj_Size(t *testing.T) {
-	bytes := utils.Must2(json.Marshal(jsonObject))
-	fmt.Printf("Json object size: %d\n", len(bytes))
-
-	bytes = utils.Must2(proto.Marshal(protoObject))
-	fmt.Printf("Proto object size: %d\n", len(bytes))
 
-	vtBytes := utils.Must2(protoObject.MarshalVT())
-	require.Equal(t, bytes, vtBytes)
-
-	largeBytes := utils.Must2(json.Marshal(jsonLargeObject))
-	fmt.Printf("Large Json object size: %d\n", len(largeBytes))
-
-	largeBytes = utils.Must2(proto.Marshal(protoLargeObject))
-	fmt.Printf("Large proto object size: %d\n", len(largeBytes))
-}
-
-func BenchmarkObject_MarshalJSON(b *testing.B) {
-	for i := 0; i < b.N; i++ {
-		json.Marshal(jsonObject)
-	}
-}
-
-func BenchmarkObject_UnmarshalJSON(b *testing.B) {
-	bytes := utils.Must2(json.Marshal(jsonObject))
-	b.ResetTimer()
-
-	for i := 0; i < b.N; i++ {
-		var target JsonObject
-		json.Unmarshal(bytes, &target)
-	}
-}
-
-func BenchmarkObject_MarshalEasyJSON(b *testing.B) {
-	for i := 0; i < b.N; i++ {
-		easyjson.Marshal(jsonObject)
+	objectPayload = codec.Payload{
+		JSON:     jsonObject,
+		Proto:    protoObject,
+		NewJSON:  func() any { return &JsonObject{} },
+		NewProto: func() any { return &Object{} },
 	}
-}
-
-func BenchmarkObject_UnmarshalEasyJSON(b *testing.B) {
-	bytes := utils.Must2(easyjson.Marshal(jsonObject))
-	b.ResetTimer()
-
-	for i := 0; i < b.N; i++ {
-		var target JsonObject
-		easyjson.Unmarshal(bytes, &target)
-	}
-}
-
-// Iter
-func BenchmarkObject_MarshalIterJSON(b *testing.B) {
-	for i := 0; i < b.N; i++ {
-		jsonIter.Marshal(jsonObject)
+	largeObjectPayload = codec.Payload{
+		JSON:     jsonLargeObject,
+		Proto:    protoLargeObject,
+		NewJSON:  func() any { return &JsonLargeResponse{} },
+		NewProto: func() any { return &LargeResponse{} },
 	}
-}
-
-func BenchmarkObject_UnmarshalIterJSON(b *testing.B) {
-	bytes := utils.Must2(jsonIter.Marshal(jsonObject))
-	b.ResetTimer()
-
-	for i := 0; i < b.N; i++ {
-		var target JsonObject
-		jsonIter.Unmarshal(bytes, &target)
-	}
-}
-
-func BenchmarkObject_MarshalProto(b *testing.B) {
-	for i := 0; i < b.N; i++ {
-		proto.Marshal(protoObject)
+	simpleObjectPayload = codec.Payload{
+		Proto:    protoSimpleObject,
+		NewProto: func() any { return &SimpleObject{} },
 	}
-}
+)
 
-func BenchmarkObject_UnmarshalProto(b *testing.B) {
-	bytes := utils.Must2(proto.Marshal(protoObject))
-	b.ResetTimer()
+func Test_Bench_Obj_Size(t *testing.T) {
+	results := codectest.RoundTrip(t, objectPayload)
+	require.Equal(t, results[codec.Proto.Name()].Encoded, results[codec.VTProto.Name()].Encoded)
+	codectest.RequireSizes(t, results, map[string]int{
+		"json":  68,
+		"proto": 28,
+	})
 
-	for i := 0; i < b.N; i++ {
-		var target Object
-		proto.Unmarshal(bytes, &target)
-	}
+	results = codectest.RoundTrip(t, largeObjectPayload)
+	codectest.RequireSizes(t, results, map[string]int{
+		"json":     31046,
+		"jsoniter": 31154,
+		"proto":    25665,
+	})
 }
 
-func BenchmarkObject_MarshalVTProto(b *testing.B) {
-	for i := 0; i < b.N; i++ {
-		protoObject.MarshalVT()
-	}
+func BenchmarkObject_Marshal(b *testing.B) {
+	codectest.Marshal(b, objectPayload)
 }
 
-func BenchmarkObject_UnmarshalVTProto(b *testing.B) {
-	bytes := utils.Must2(protoObject.MarshalVT())
-	b.ResetTimer()
-
-	for i := 0; i < b.N; i++ {
-		var target Object
-		target.UnmarshalVT(bytes)
-	}
+func BenchmarkObject_Unmarshal(b *testing.B) {
+	codectest.Unmarshal(b, objectPayload)
 }
 
 // Large object
-func BenchmarkLargeObject_MarshalJSON(b *testing.B) {
-	for i := 0; i < b.N; i++ {
-		json.Marshal(jsonLargeObject)
-	}
+func BenchmarkLargeObject_Marshal(b *testing.B) {
+	codectest.Marshal(b, largeObjectPayload)
 }
 
-func BenchmarkLargeObject_UnmarshalJSON(b *testing.B) {
-	bytes := utils.Must2(json.Marshal(jsonLargeObject))
-	b.ResetTimer()
-
-	for i := 0; i < b.N; i++ {
-		var target JsonLargeResponse
-		json.Unmarshal(bytes, &target)
-	}
+func BenchmarkLargeObject_Unmarshal(b *testing.B) {
+	codectest.Unmarshal(b, largeObjectPayload)
 }
 
-func BenchmarkLargeObject_MarshalEasyJSON(b *testing.B) {
-	for i := 0; i < b.N; i++ {
-		easyjson.Marshal(jsonLargeObject)
-	}
+func BenchmarkLargeObject_Compress(b *testing.B) {
+	codectest.Compress(b, largeObjectPayload)
 }
 
-func BenchmarkLargeObject_UnmarshalEasyJSON(b *testing.B) {
-	bytes := utils.Must2(easyjson.Marshal(jsonLargeObject))
-	b.ResetTimer()
-
-	for i := 0; i < b.N; i++ {
-		var target JsonLargeResponse
-		easyjson.Unmarshal(bytes, &target)
-	}
-}
-
-func BenchmarkLargeObject_MarshalIterJSON(b *testing.B) {
-	for i := 0; i < b.N; i++ {
-		jsonIter.Marshal(jsonLargeObject)
-	}
-}
-
-func BenchmarkLargeObject_UnmarshalIterJSON(b *testing.B) {
-	bytes := utils.Must2(jsonIter.Marshal(jsonLargeObject))
-	b.ResetTimer()
-
-	for i := 0; i < b.N; i++ {
-		var target JsonLargeResponse
-		jsonIter.Unmarshal(bytes, &target)
-	}
-}
-
-func BenchmarkLargeObject_MarshalProto(b *testing.B) {
-	for i := 0; i < b.N; i++ {
-		proto.Marshal(protoLargeObject)
-	}
-}
-
-func BenchmarkLargeObject_UnmarshalProto(b *testing.B) {
-	bytes := utils.Must2(proto.Marshal(protoLargeObject))
-	b.ResetTimer()
-
-	for i := 0; i < b.N; i++ {
-		var target LargeResponse
-		proto.Unmarshal(bytes, &target)
-	}
-}
-
-func BenchmarkLargeObject_MarshalVTProto(b *testing.B) {
-	for i := 0; i < b.N; i++ {
-		protoLargeObject.MarshalVT()
-	}
-}
-
-func BenchmarkLargeObject_UnmarshalVTProto(b *testing.B) {
-	bytes := utils.Must2(protoLargeObject.MarshalVT())
-	b.ResetTimer()
-
-	for i := 0; i < b.N; i++ {
-		var target LargeResponse
-		target.UnmarshalVT(bytes)
-	}
+func BenchmarkLargeObject_Decompress(b *testing.B) {
+	codectest.Decompress(b, largeObjectPayload)
 }
 
 // Simple object
-func BenchmarkSimpleObject_MarshalProto(b *testing.B) {
-	for i := 0; i < b.N; i++ {
-		proto.Marshal(protoSimpleObject)
-	}
-}
-
-func BenchmarkSimpleObject_UnmarshalProto(b *testing.B) {
-	bytes := utils.Must2(proto.Marshal(protoSimpleObject))
-	b.ResetTimer()
-
-	for i := 0; i < b.N; i++ {
-		var target SimpleObject
-		proto.Unmarshal(bytes, &target)
-	}
+func BenchmarkSimpleObject_Marshal(b *testing.B) {
+	codectest.Marshal(b, simpleObjectPayload)
 }
 
-func BenchmarkSimpleObject_MarshalVTProto(b *testing.B) {
-	for i := 0; i < b.N; i++ {
-		protoSimpleObject.MarshalVT()
-	}
-}
-
-func BenchmarkSimpleObject_UnmarshalVTProto(b *testing.B) {
-	bytes := utils.Must2(protoSimpleObject.MarshalVT())
-	b.ResetTimer()
-
-	for i := 0; i < b.N; i++ {
-		var target SimpleObject
-		target.UnmarshalVT(bytes)
-	}
+func BenchmarkSimpleObject_Unmarshal(b *testing.B) {
+	codectest.Unmarshal(b, simpleObjectPayload)
 }
@@ -6,15 +6,16 @@ import (
 	"time"
 )
 
-func genObjects(n int) []*JsonObject {
+var objectsEpoch = time.Date(2022, 12, 23, 0, 0, 0, 0, time.UTC)
+
+func genObjects(random *utils.Random, n int) []*JsonObject {
 	result := make([]*JsonObject, 0, n)
 	for i := 0; i < n; i++ {
-		now := time.Now().Unix()
 		result = append(result, &JsonObject{
 			Id:       int32(i),
 			Price:    float32(i) * 0.32,
-			Datetime: &now,
-			Data:     utils.RandomString(n),
+			Datetime: utils.Ptr(random.Timestamp(objectsEpoch, 24*time.Hour).Unix()),
+			Data:     random.String(n),
 		})
 	}
 	return result
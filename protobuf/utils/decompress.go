@@ -0,0 +1,108 @@
+package utils
+
+import (
+	"bytes"
+	"github.com/pkg/errors"
+	"io"
+)
+
+// DefaultMaxDecompressedSize limits decompressed data unless DecompressOptions.MaxSize is set
+const DefaultMaxDecompressedSize = 256 << 20
+
+var (
+	ErrTruncated = errors.New("compressed data is truncated")
+	ErrCorrupt   = errors.New("compressed data is corrupt")
+	ErrTooLarge  = errors.New("decompressed data exceeds the size limit")
+)
+
+type DecompressOptions struct {
+	// MaxSize of decompressed data, DefaultMaxDecompressedSize when zero and unlimited when negative
+	MaxSize int64
+	// AllowUncompressed returns data without a compression header as is. Formats without a header can't tell
+	// uncompressed data from corrupt one and ignore this option
+	AllowUncompressed bool
+}
+
+func (o DecompressOptions) maxSize() int64 {
+	if o.MaxSize == 0 {
+		return DefaultMaxDecompressedSize
+	}
+	return o.MaxSize
+}
+
+// DecompressError keeps the original error of a decompressor, while matching one of ErrTruncated, ErrCorrupt, ErrTooLarge
+type DecompressError struct {
+	Kind error
+	Err  error
+}
+
+func (e *DecompressError) Error() string {
+	if e.Err == nil {
+		return e.Kind.Error()
+	}
+	return e.Kind.Error() + ": " + e.Err.Error()
+}
+
+func (e *DecompressError) Is(target error) bool {
+	return target == e.Kind
+}
+
+func (e *DecompressError) Unwrap() error {
+	return e.Err
+}
+
+// DecompressionError classifies error returned by a decompressor
+func DecompressionError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var decompressErr *DecompressError
+	if errors.As(err, &decompressErr) {
+		return err
+	}
+
+	kind := ErrCorrupt
+	if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) {
+		kind = ErrTruncated
+	}
+	return errors.WithStack(&DecompressError{Kind: kind, Err: err})
+}
+
+// ReadAllDecompressed reads decompressor r, failing with ErrTooLarge once more than opts.MaxSize bytes are read
+func ReadAllDecompressed(r io.Reader, opts DecompressOptions) ([]byte, error) {
+	maxSize := opts.maxSize()
+	if maxSize < 0 {
+		data, err := io.ReadAll(r)
+		return data, DecompressionError(err)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(r, maxSize+1))
+	if err != nil {
+		return nil, DecompressionError(err)
+	}
+	if int64(len(data)) > maxSize {
+		return nil, errors.WithStack(&DecompressError{Kind: ErrTooLarge})
+	}
+	return data, nil
+}
+
+// IsGZIP checks gzip magic bytes
+func IsGZIP(data []byte) bool {
+	return len(data) >= 2 && data[0] == 0x1f && data[1] == 0x8b
+}
+
+// Decompress reads the whole decompressor created by newReader over data with opts limits
+func Decompress(data []byte, opts DecompressOptions, newReader func(r io.Reader) (io.ReadCloser, error)) ([]byte, error) {
+	r, err := newReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, DecompressionError(err)
+	}
+	result, err := ReadAllDecompressed(r, opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.Close(); err != nil {
+		return nil, DecompressionError(err)
+	}
+	return result, nil
+}
@@ -3,10 +3,8 @@ package utils
 import (
 	"bytes"
 	"compress/gzip"
-	"fmt"
 	"github.com/pkg/errors"
 	"io"
-	"io/ioutil"
 )
 
 func CompressGZIP(data []byte, level int) ([]byte, error) {
@@ -15,7 +13,7 @@ func CompressGZIP(data []byte, level int) ([]byte, error) {
 	var err error
 	w, err = gzip.NewWriterLevel(&buf, level)
 	if err != nil {
-		return nil, nil
+		return nil, errors.WithStack(err)
 	}
 	if _, err := w.Write(data); err != nil {
 		return nil, errors.WithStack(err)
@@ -26,26 +24,17 @@ func CompressGZIP(data []byte, level int) ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+// DecompressGZIP strictly decompresses gzip data with the default size limit, see DecompressGZIPWith
 func DecompressGZIP(data []byte) ([]byte, error) {
-	gr, err := gzip.NewReader(bytes.NewBuffer(data))
-	if err != nil {
-		if err == gzip.ErrHeader || err == io.ErrUnexpectedEOF {
-			return data, nil
-		}
-		return nil, errors.WithStack(err)
-	}
-	data, err = ioutil.ReadAll(gr)
-	if err != nil {
-		return nil, errors.WithStack(err)
-	}
-	if err := gr.Close(); err != nil {
-		return nil, errors.WithStack(err)
-	}
-	return data, nil
+	return DecompressGZIPWith(data, DecompressOptions{})
 }
 
-func GzipAndPrint(body []byte) {
-	fmt.Printf("Best speed: %d\n", len(Must2(CompressGZIP(body, gzip.BestSpeed))))
-	fmt.Printf("Best compression: %d\n", len(Must2(CompressGZIP(body, gzip.BestCompression))))
-	fmt.Printf("Default compression: %d\n", len(Must2(CompressGZIP(body, gzip.DefaultCompression))))
+// DecompressGZIPWith decompresses gzip data, failing with ErrTruncated, ErrCorrupt or ErrTooLarge
+func DecompressGZIPWith(data []byte, opts DecompressOptions) ([]byte, error) {
+	if opts.AllowUncompressed && !IsGZIP(data) {
+		return data, nil
+	}
+	return Decompress(data, opts, func(r io.Reader) (io.ReadCloser, error) {
+		return gzip.NewReader(r)
+	})
 }
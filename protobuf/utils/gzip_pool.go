@@ -0,0 +1,279 @@
+package utils
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"github.com/pkg/errors"
+	"io"
+	"sync"
+)
+
+// gzipWriters are pools of writers by compression level, from gzip.HuffmanOnly to gzip.BestCompression
+var gzipWriters [gzip.BestCompression - gzip.HuffmanOnly + 1]sync.Pool
+
+var gzipReaders = sync.Pool{
+	New: func() any { return new(pooledGZIPReader) },
+}
+
+var errClosed = errors.New("use of closed gzip stream")
+
+// pooledGZIPWriter returns itself into the pool on Close. appendWriter lives in the same allocation,
+// so compressing into a byte slice doesn't allocate at all
+type pooledGZIPWriter struct {
+	w      *gzip.Writer
+	out    appendWriter
+	level  int
+	closed bool
+}
+
+type appendWriter struct {
+	buf []byte
+}
+
+func (w *appendWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	return len(p), nil
+}
+
+func getGZIPWriter(w io.Writer, level int) (*pooledGZIPWriter, error) {
+	if level < gzip.HuffmanOnly || level > gzip.BestCompression {
+		return nil, errors.Errorf("gzip: invalid compression level: %d", level)
+	}
+	pw, _ := gzipWriters[level-gzip.HuffmanOnly].Get().(*pooledGZIPWriter)
+	if pw == nil {
+		pw = &pooledGZIPWriter{level: level}
+		pw.w = Must2(gzip.NewWriterLevel(w, level))
+	} else {
+		pw.w.Reset(w)
+	}
+	pw.closed = false
+	return pw, nil
+}
+
+func (w *pooledGZIPWriter) Write(p []byte) (int, error) {
+	if w.closed {
+		return 0, errors.WithStack(errClosed)
+	}
+	n, err := w.w.Write(p)
+	return n, errors.WithStack(err)
+}
+
+func (w *pooledGZIPWriter) Flush() error {
+	if w.closed {
+		return errors.WithStack(errClosed)
+	}
+	return errors.WithStack(w.w.Flush())
+}
+
+// Close flushes the stream and puts the writer back into the pool, the writer must not be used afterwards
+func (w *pooledGZIPWriter) Close() error {
+	if w.closed {
+		return nil
+	}
+	err := w.w.Close()
+	w.w.Reset(io.Discard)
+	w.out.buf = nil
+	w.closed = true
+	gzipWriters[w.level-gzip.HuffmanOnly].Put(w)
+	return errors.WithStack(err)
+}
+
+// gzipWriter is handed out by NewGZIPWriter instead of the pooled writer, so a second Close or a late Write
+// fails with errClosed rather than reaching the writer of the next owner
+type gzipWriter struct {
+	pw *pooledGZIPWriter
+}
+
+func (w *gzipWriter) Write(p []byte) (int, error) {
+	if w.pw == nil {
+		return 0, errors.WithStack(errClosed)
+	}
+	return w.pw.Write(p)
+}
+
+func (w *gzipWriter) Flush() error {
+	if w.pw == nil {
+		return errors.WithStack(errClosed)
+	}
+	return w.pw.Flush()
+}
+
+func (w *gzipWriter) Close() error {
+	if w.pw == nil {
+		return nil
+	}
+	pw := w.pw
+	w.pw = nil
+	return pw.Close()
+}
+
+// NewGZIPWriter returns a pooled gzip writer compressing into w, Close returns it into the pool
+func NewGZIPWriter(w io.Writer, level int) (io.WriteCloser, error) {
+	pw, err := getGZIPWriter(w, level)
+	if err != nil {
+		return nil, err
+	}
+	return &gzipWriter{pw: pw}, nil
+}
+
+// AppendCompressedGZIP appends data compressed with a pooled writer to dst
+func AppendCompressedGZIP(dst, data []byte, level int) ([]byte, error) {
+	pw, err := getGZIPWriter(nil, level)
+	if err != nil {
+		return dst, err
+	}
+	pw.out.buf = dst
+	pw.w.Reset(&pw.out)
+	if _, err := pw.w.Write(data); err != nil {
+		pw.Close()
+		return dst, errors.WithStack(err)
+	}
+	if err := pw.w.Close(); err != nil {
+		pw.Close()
+		return dst, errors.WithStack(err)
+	}
+	result := pw.out.buf
+	return result, pw.Close()
+}
+
+// pooledGZIPReader applies DecompressOptions to the stream and returns itself into the pool on Close
+type pooledGZIPReader struct {
+	r       gzip.Reader
+	src     bytes.Reader
+	peek    *bufio.Reader
+	plain   io.Reader
+	read    int64
+	maxSize int64
+	closed  bool
+}
+
+func (r *pooledGZIPReader) reset(src io.Reader, opts DecompressOptions) error {
+	r.read, r.maxSize, r.closed, r.plain = 0, opts.maxSize(), false, nil
+
+	if opts.AllowUncompressed {
+		if r.peek == nil {
+			r.peek = bufio.NewReader(src)
+		} else {
+			r.peek.Reset(src)
+		}
+		src = r.peek
+		if header, _ := r.peek.Peek(2); !IsGZIP(header) {
+			r.plain = src
+			return nil
+		}
+	}
+
+	if err := r.r.Reset(src); err != nil {
+		r.release()
+		return DecompressionError(err)
+	}
+	return nil
+}
+
+func (r *pooledGZIPReader) Read(p []byte) (int, error) {
+	if r.closed {
+		return 0, errors.WithStack(errClosed)
+	}
+	var n int
+	var err error
+	if r.plain != nil {
+		n, err = r.plain.Read(p)
+	} else {
+		n, err = r.r.Read(p)
+	}
+	r.read += int64(n)
+	if r.maxSize >= 0 && r.read > r.maxSize {
+		return n, errors.WithStack(&DecompressError{Kind: ErrTooLarge})
+	}
+	if err != nil && err != io.EOF {
+		return n, DecompressionError(err)
+	}
+	return n, err
+}
+
+// Close puts the reader back into the pool, the reader must not be used afterwards
+func (r *pooledGZIPReader) Close() error {
+	if r.closed {
+		return nil
+	}
+	var err error
+	if r.plain == nil {
+		err = DecompressionError(r.r.Close())
+	}
+	r.release()
+	return err
+}
+
+func (r *pooledGZIPReader) release() {
+	r.closed = true
+	r.plain = nil
+	r.src.Reset(nil)
+	if r.peek != nil {
+		r.peek.Reset(nil)
+	}
+	gzipReaders.Put(r)
+}
+
+// gzipReader is handed out by NewGZIPReader instead of the pooled reader, like gzipWriter
+type gzipReader struct {
+	pr *pooledGZIPReader
+}
+
+func (r *gzipReader) Read(p []byte) (int, error) {
+	if r.pr == nil {
+		return 0, errors.WithStack(errClosed)
+	}
+	return r.pr.Read(p)
+}
+
+func (r *gzipReader) Close() error {
+	if r.pr == nil {
+		return nil
+	}
+	pr := r.pr
+	r.pr = nil
+	return pr.Close()
+}
+
+// NewGZIPReader returns a pooled gzip reader of r bounded by opts, Close returns it into the pool
+func NewGZIPReader(r io.Reader, opts DecompressOptions) (io.ReadCloser, error) {
+	pr := gzipReaders.Get().(*pooledGZIPReader)
+	if err := pr.reset(r, opts); err != nil {
+		return nil, err
+	}
+	return &gzipReader{pr: pr}, nil
+}
+
+// AppendDecompressedGZIP appends data decompressed with a pooled reader to dst
+func AppendDecompressedGZIP(dst, data []byte, opts DecompressOptions) ([]byte, error) {
+	if opts.AllowUncompressed && !IsGZIP(data) {
+		return append(dst, data...), nil
+	}
+	opts.AllowUncompressed = false
+
+	pr := gzipReaders.Get().(*pooledGZIPReader)
+	pr.src.Reset(data)
+	if err := pr.reset(&pr.src, opts); err != nil {
+		return dst, err
+	}
+	start := len(dst)
+	for {
+		if len(dst) == cap(dst) {
+			dst = append(dst, 0)[:len(dst)]
+		}
+		n, err := pr.Read(dst[len(dst):cap(dst)])
+		dst = dst[:len(dst)+n]
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			pr.release()
+			return dst[:start], err
+		}
+	}
+	if err := pr.Close(); err != nil {
+		return dst[:start], err
+	}
+	return dst, nil
+}
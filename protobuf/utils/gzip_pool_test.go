@@ -0,0 +1,178 @@
+package utils
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"io"
+	"strings"
+	"testing"
+)
+
+func gzipSample(seed int64) []byte {
+	random := NewRandom(seed)
+	var sb strings.Builder
+	for i := 0; i < 2000; i++ {
+		fmt.Fprintf(&sb, `{"id":%d,"origin":"%s","signature":"%s"},`, i, Choice(random, []string{"MOW", "LED", "IST"}), random.StringOf(16, Hex))
+	}
+	return []byte(sb.String())
+}
+
+func TestGZIPStream(t *testing.T) {
+	for seed := int64(1); seed <= 3; seed++ {
+		data := gzipSample(seed)
+
+		var buf bytes.Buffer
+		w := Must2(NewGZIPWriter(&buf, gzip.BestSpeed))
+		Must2(w.Write(data[:len(data)/2]))
+		Must2(w.Write(data[len(data)/2:]))
+		require.NoError(t, w.Close())
+		require.NoError(t, w.Close())
+		_, err := w.Write(data)
+		require.Error(t, err)
+		require.Equal(t, data, Must2(DecompressGZIP(buf.Bytes())))
+
+		r := Must2(NewGZIPReader(bytes.NewReader(buf.Bytes()), DecompressOptions{}))
+		require.Equal(t, data, Must2(io.ReadAll(r)))
+		require.NoError(t, r.Close())
+	}
+}
+
+// Run with -race, a writer or a reader closed twice must not reach the one handed to the next owner
+func TestGZIPStreamReuse(t *testing.T) {
+	data := gzipSample(1)
+	for i := 0; i < 100; i++ {
+		w := Must2(NewGZIPWriter(io.Discard, gzip.BestSpeed))
+		Must2(w.Write(data))
+		require.NoError(t, w.Close())
+
+		var buf bytes.Buffer
+		done := make(chan error)
+		go func() {
+			next := Must2(NewGZIPWriter(&buf, gzip.BestSpeed))
+			if _, err := next.Write(data); err != nil {
+				done <- err
+				return
+			}
+			done <- next.Close()
+		}()
+		require.NoError(t, w.Close())
+		_, err := w.Write(data)
+		require.ErrorIs(t, err, errClosed)
+		require.NoError(t, <-done)
+		require.Equal(t, data, Must2(DecompressGZIP(buf.Bytes())))
+	}
+
+	compressed := Must2(CompressGZIP(data, gzip.BestSpeed))
+	for i := 0; i < 100; i++ {
+		r := Must2(NewGZIPReader(bytes.NewReader(compressed), DecompressOptions{}))
+		require.NoError(t, r.Close())
+
+		done := make(chan []byte)
+		go func() {
+			next := Must2(NewGZIPReader(bytes.NewReader(compressed), DecompressOptions{}))
+			defer next.Close()
+			done <- Must2(io.ReadAll(next))
+		}()
+		require.NoError(t, r.Close())
+		_, err := r.Read(make([]byte, 1))
+		require.ErrorIs(t, err, errClosed)
+		require.Equal(t, data, <-done)
+	}
+}
+
+func TestGZIPAppend(t *testing.T) {
+	prefix := []byte("prefix")
+	for seed := int64(1); seed <= 3; seed++ {
+		data := gzipSample(seed)
+		compressed := Must2(AppendCompressedGZIP(append([]byte(nil), prefix...), data, gzip.DefaultCompression))
+		require.Equal(t, prefix, compressed[:len(prefix)])
+		require.Equal(t, data, Must2(DecompressGZIP(compressed[len(prefix):])))
+
+		decompressed := Must2(AppendDecompressedGZIP(append([]byte(nil), prefix...), compressed[len(prefix):], DecompressOptions{}))
+		require.Equal(t, append(append([]byte(nil), prefix...), data...), decompressed)
+	}
+
+	_, err := AppendCompressedGZIP(nil, prefix, 42)
+	require.Error(t, err)
+}
+
+func TestGZIPPooledStrict(t *testing.T) {
+	data := gzipSample(1)
+	compressed := Must2(AppendCompressedGZIP(nil, data, gzip.DefaultCompression))
+
+	_, err := AppendDecompressedGZIP(nil, compressed[:len(compressed)/2], DecompressOptions{})
+	require.ErrorIs(t, err, ErrTruncated)
+	_, err = AppendDecompressedGZIP(nil, data, DecompressOptions{})
+	require.ErrorIs(t, err, ErrCorrupt)
+	_, err = AppendDecompressedGZIP(nil, compressed, DecompressOptions{MaxSize: 100})
+	require.ErrorIs(t, err, ErrTooLarge)
+	require.Equal(t, data, Must2(AppendDecompressedGZIP(nil, data, DecompressOptions{AllowUncompressed: true})))
+
+	r := Must2(NewGZIPReader(bytes.NewReader(compressed), DecompressOptions{MaxSize: 100}))
+	_, err = io.ReadAll(r)
+	require.ErrorIs(t, err, ErrTooLarge)
+	require.NoError(t, r.Close())
+
+	_, err = NewGZIPReader(bytes.NewReader(data), DecompressOptions{})
+	require.ErrorIs(t, err, ErrCorrupt)
+	for _, input := range [][]byte{data, compressed} {
+		r = Must2(NewGZIPReader(bytes.NewReader(input), DecompressOptions{AllowUncompressed: true}))
+		require.Equal(t, data, Must2(io.ReadAll(r)))
+		require.NoError(t, r.Close())
+	}
+}
+
+func BenchmarkGZIPCompress(b *testing.B) {
+	data := gzipSample(1)
+	b.Run("CompressGZIP", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			CompressGZIP(data, gzip.DefaultCompression)
+		}
+	})
+	b.Run("AppendCompressedGZIP", func(b *testing.B) {
+		var buf []byte
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			buf, _ = AppendCompressedGZIP(buf[:0], data, gzip.DefaultCompression)
+		}
+	})
+	b.Run("NewGZIPWriter", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			w, _ := NewGZIPWriter(io.Discard, gzip.DefaultCompression)
+			w.Write(data)
+			w.Close()
+		}
+	})
+}
+
+func BenchmarkGZIPDecompress(b *testing.B) {
+	data := gzipSample(1)
+	compressed := Must2(CompressGZIP(data, gzip.DefaultCompression))
+	b.Run("DecompressGZIP", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			DecompressGZIP(compressed)
+		}
+	})
+	b.Run("AppendDecompressedGZIP", func(b *testing.B) {
+		var buf []byte
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			buf, _ = AppendDecompressedGZIP(buf[:0], compressed, DecompressOptions{})
+		}
+	})
+	b.Run("NewGZIPReader", func(b *testing.B) {
+		src := bytes.NewReader(compressed)
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			src.Reset(compressed)
+			r, _ := NewGZIPReader(src, DecompressOptions{})
+			io.Copy(io.Discard, r)
+			r.Close()
+		}
+	})
+}
@@ -0,0 +1,52 @@
+package utils
+
+import (
+	"compress/gzip"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+func TestDecompressGZIP(t *testing.T) {
+	data := []byte(strings.Repeat("search results ", 100))
+	compressed := Must2(CompressGZIP(data, gzip.DefaultCompression))
+	require.Equal(t, data, Must2(DecompressGZIP(compressed)))
+
+	_, err := DecompressGZIP(compressed[:len(compressed)-4])
+	require.ErrorIs(t, err, ErrTruncated)
+	_, err = DecompressGZIP(compressed[:1])
+	require.ErrorIs(t, err, ErrTruncated)
+	_, err = DecompressGZIP(nil)
+	require.ErrorIs(t, err, ErrTruncated)
+
+	corrupt := append([]byte(nil), compressed...)
+	corrupt[len(corrupt)-5] ^= 0xff
+	_, err = DecompressGZIP(corrupt)
+	require.ErrorIs(t, err, ErrCorrupt)
+
+	_, err = DecompressGZIP(data)
+	require.ErrorIs(t, err, ErrCorrupt)
+	var decompressErr *DecompressError
+	require.ErrorAs(t, err, &decompressErr)
+	require.ErrorIs(t, decompressErr, gzip.ErrHeader)
+}
+
+func TestDecompressGZIPWith(t *testing.T) {
+	data := []byte(strings.Repeat("search results ", 100))
+	compressed := Must2(CompressGZIP(data, gzip.DefaultCompression))
+
+	_, err := DecompressGZIPWith(compressed, DecompressOptions{MaxSize: int64(len(data) - 1)})
+	require.ErrorIs(t, err, ErrTooLarge)
+	require.Equal(t, data, Must2(DecompressGZIPWith(compressed, DecompressOptions{MaxSize: int64(len(data))})))
+	require.Equal(t, data, Must2(DecompressGZIPWith(compressed, DecompressOptions{MaxSize: -1})))
+
+	allowUncompressed := DecompressOptions{AllowUncompressed: true}
+	require.Equal(t, data, Must2(DecompressGZIPWith(data, allowUncompressed)))
+	require.Equal(t, data, Must2(DecompressGZIPWith(compressed, allowUncompressed)))
+	// Truncated gzip still has the header, so it is never mistaken for uncompressed data
+	_, err = DecompressGZIPWith(compressed[:len(compressed)-4], allowUncompressed)
+	require.ErrorIs(t, err, ErrTruncated)
+
+	_, err = CompressGZIP(data, 42)
+	require.Error(t, err)
+}
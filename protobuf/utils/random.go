@@ -0,0 +1,90 @@
+package utils
+
+import (
+	"math/rand"
+	"time"
+)
+
+const (
+	Letters   = "abcdefghijklmnouvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+	Uppercase = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	Hex       = "0123456789abcdef"
+)
+
+// Random is a seeded source of test data, the same seed always produces the same sequence of values
+type Random struct {
+	rnd *rand.Rand
+}
+
+func NewRandom(seed int64) *Random {
+	return &Random{rnd: rand.New(rand.NewSource(seed))}
+}
+
+// String returns n random letters and digits
+func (r *Random) String(n int) string {
+	return r.StringOf(n, Letters)
+}
+
+// StringOf returns n random characters of alphabet
+func (r *Random) StringOf(n int, alphabet string) string {
+	letters := []rune(alphabet)
+	s := make([]rune, n)
+	for i := range s {
+		s[i] = letters[r.rnd.Intn(len(letters))]
+	}
+	return string(s)
+}
+
+// Intn returns a number in [0, n)
+func (r *Random) Intn(n int) int {
+	return r.rnd.Intn(n)
+}
+
+// Int returns a number in [min, max]
+func (r *Random) Int(min, max int) int {
+	return min + r.rnd.Intn(max-min+1)
+}
+
+// Float returns a number in [min, max)
+func (r *Random) Float(min, max float64) float64 {
+	return min + r.rnd.Float64()*(max-min)
+}
+
+// Chance returns true with probability p
+func (r *Random) Chance(p float64) bool {
+	return r.rnd.Float64() < p
+}
+
+// Timestamp returns a moment in [from, from+span) with a second precision
+func (r *Random) Timestamp(from time.Time, span time.Duration) time.Time {
+	seconds := int64(span / time.Second)
+	if seconds <= 0 {
+		return from
+	}
+	return from.Add(time.Duration(r.rnd.Int63n(seconds)) * time.Second)
+}
+
+// Choice returns a random element of values
+func Choice[T any](r *Random, values []T) T {
+	return values[r.rnd.Intn(len(values))]
+}
+
+// WeightedChoice returns a random element of values, each one is picked proportionally to its weight
+func WeightedChoice[T any](r *Random, values []T, weights []float64) T {
+	if len(values) != len(weights) {
+		panic("values and weights must have the same length")
+	}
+
+	var total float64
+	for _, weight := range weights {
+		total += weight
+	}
+	point := r.rnd.Float64() * total
+	for i, weight := range weights {
+		if point < weight {
+			return values[i]
+		}
+		point -= weight
+	}
+	return values[len(values)-1]
+}
@@ -0,0 +1,51 @@
+package utils
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestRandomSameSeed(t *testing.T) {
+	first, second := NewRandom(7), NewRandom(7)
+	from := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 100; i++ {
+		require.Equal(t, first.String(10), second.String(10))
+		require.Equal(t, first.Int(-5, 5), second.Int(-5, 5))
+		require.Equal(t, first.Float(0, 1), second.Float(0, 1))
+		require.Equal(t, first.Timestamp(from, time.Hour), second.Timestamp(from, time.Hour))
+		require.Equal(t, Choice(first, []string{"a", "b", "c"}), Choice(second, []string{"a", "b", "c"}))
+	}
+}
+
+func TestRandomRanges(t *testing.T) {
+	random := NewRandom(1)
+	from := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 1000; i++ {
+		n := random.Int(-5, 5)
+		require.GreaterOrEqual(t, n, -5)
+		require.LessOrEqual(t, n, 5)
+
+		f := random.Float(1, 2)
+		require.GreaterOrEqual(t, f, 1.0)
+		require.Less(t, f, 2.0)
+
+		ts := random.Timestamp(from, time.Hour)
+		require.False(t, ts.Before(from))
+		require.True(t, ts.Before(from.Add(time.Hour)))
+		require.Zero(t, ts.Nanosecond())
+
+		require.Regexp(t, "^[0-9a-f]{8}$", random.StringOf(8, Hex))
+	}
+}
+
+func TestWeightedChoice(t *testing.T) {
+	random := NewRandom(1)
+	counts := map[string]int{}
+	for i := 0; i < 10000; i++ {
+		counts[WeightedChoice(random, []string{"never", "rare", "often"}, []float64{0, 1, 9})]++
+	}
+	require.Zero(t, counts["never"])
+	require.InDelta(t, 1000, counts["rare"], 200)
+	require.InDelta(t, 9000, counts["often"], 200)
+}
@@ -17,8 +17,9 @@ func Must2[T any](val T, err error) T {
 	return val
 }
 
+// RandomString uses the global source, see Random for reproducible data
 func RandomString(n int) string {
-	var letters = []rune("abcdefghijklmnouvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789")
+	var letters = []rune(Letters)
 
 	s := make([]rune, n)
 	for i := range s {